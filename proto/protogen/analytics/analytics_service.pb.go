@@ -127,9 +127,11 @@ func (x *PaginationResponse) GetTotalCount() int32 {
 }
 
 type DateRange struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StartDate string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// IANA zone used for day boundaries; defaults to the store timezone.
+	Timezone      *string `protobuf:"bytes,3,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -178,6 +180,13 @@ func (x *DateRange) GetEndDate() string {
 	return ""
 }
 
+func (x *DateRange) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
 type SalesSummaryDaily struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -193,8 +202,10 @@ type SalesSummaryDaily struct {
 	GrossProfit       string                 `protobuf:"bytes,11,opt,name=gross_profit,json=grossProfit,proto3" json:"gross_profit,omitempty"`
 	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Per-rate split of total_tax, summed from the orders' tax lines.
+	TaxTotals     []*TaxRateTotal `protobuf:"bytes,14,rep,name=tax_totals,json=taxTotals,proto3" json:"tax_totals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SalesSummaryDaily) Reset() {
@@ -318,6 +329,73 @@ func (x *SalesSummaryDaily) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *SalesSummaryDaily) GetTaxTotals() []*TaxRateTotal {
+	if x != nil {
+		return x.TaxTotals
+	}
+	return nil
+}
+
+type TaxRateTotal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaxRate       string                 `protobuf:"bytes,1,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
+	TaxableAmount string                 `protobuf:"bytes,2,opt,name=taxable_amount,json=taxableAmount,proto3" json:"taxable_amount,omitempty"`
+	TaxAmount     string                 `protobuf:"bytes,3,opt,name=tax_amount,json=taxAmount,proto3" json:"tax_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaxRateTotal) Reset() {
+	*x = TaxRateTotal{}
+	mi := &file_analytics_analytics_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaxRateTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxRateTotal) ProtoMessage() {}
+
+func (x *TaxRateTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_analytics_analytics_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxRateTotal.ProtoReflect.Descriptor instead.
+func (*TaxRateTotal) Descriptor() ([]byte, []int) {
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TaxRateTotal) GetTaxRate() string {
+	if x != nil {
+		return x.TaxRate
+	}
+	return ""
+}
+
+func (x *TaxRateTotal) GetTaxableAmount() string {
+	if x != nil {
+		return x.TaxableAmount
+	}
+	return ""
+}
+
+func (x *TaxRateTotal) GetTaxAmount() string {
+	if x != nil {
+		return x.TaxAmount
+	}
+	return ""
+}
+
 type ProductSalesSummary struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -338,7 +416,7 @@ type ProductSalesSummary struct {
 
 func (x *ProductSalesSummary) Reset() {
 	*x = ProductSalesSummary{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[4]
+	mi := &file_analytics_analytics_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -350,7 +428,7 @@ func (x *ProductSalesSummary) String() string {
 func (*ProductSalesSummary) ProtoMessage() {}
 
 func (x *ProductSalesSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[4]
+	mi := &file_analytics_analytics_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -363,7 +441,7 @@ func (x *ProductSalesSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductSalesSummary.ProtoReflect.Descriptor instead.
 func (*ProductSalesSummary) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{4}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ProductSalesSummary) GetId() int64 {
@@ -468,7 +546,7 @@ type EmployeePerformance struct {
 
 func (x *EmployeePerformance) Reset() {
 	*x = EmployeePerformance{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[5]
+	mi := &file_analytics_analytics_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -480,7 +558,7 @@ func (x *EmployeePerformance) String() string {
 func (*EmployeePerformance) ProtoMessage() {}
 
 func (x *EmployeePerformance) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[5]
+	mi := &file_analytics_analytics_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -493,7 +571,7 @@ func (x *EmployeePerformance) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmployeePerformance.ProtoReflect.Descriptor instead.
 func (*EmployeePerformance) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{5}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *EmployeePerformance) GetId() int64 {
@@ -583,7 +661,7 @@ type CustomerAnalytics struct {
 
 func (x *CustomerAnalytics) Reset() {
 	*x = CustomerAnalytics{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[6]
+	mi := &file_analytics_analytics_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -595,7 +673,7 @@ func (x *CustomerAnalytics) String() string {
 func (*CustomerAnalytics) ProtoMessage() {}
 
 func (x *CustomerAnalytics) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[6]
+	mi := &file_analytics_analytics_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -608,7 +686,7 @@ func (x *CustomerAnalytics) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CustomerAnalytics.ProtoReflect.Descriptor instead.
 func (*CustomerAnalytics) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{6}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CustomerAnalytics) GetId() int64 {
@@ -693,7 +771,7 @@ type SalesReport struct {
 
 func (x *SalesReport) Reset() {
 	*x = SalesReport{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[7]
+	mi := &file_analytics_analytics_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -705,7 +783,7 @@ func (x *SalesReport) String() string {
 func (*SalesReport) ProtoMessage() {}
 
 func (x *SalesReport) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[7]
+	mi := &file_analytics_analytics_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -718,7 +796,7 @@ func (x *SalesReport) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SalesReport.ProtoReflect.Descriptor instead.
 func (*SalesReport) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{7}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SalesReport) GetPeriod() *DateRange {
@@ -804,7 +882,7 @@ type PerformanceReport struct {
 
 func (x *PerformanceReport) Reset() {
 	*x = PerformanceReport{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[8]
+	mi := &file_analytics_analytics_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -816,7 +894,7 @@ func (x *PerformanceReport) String() string {
 func (*PerformanceReport) ProtoMessage() {}
 
 func (x *PerformanceReport) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[8]
+	mi := &file_analytics_analytics_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -829,7 +907,7 @@ func (x *PerformanceReport) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PerformanceReport.ProtoReflect.Descriptor instead.
 func (*PerformanceReport) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{8}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *PerformanceReport) GetPeriod() *DateRange {
@@ -881,7 +959,7 @@ type GetSalesReportRequest struct {
 
 func (x *GetSalesReportRequest) Reset() {
 	*x = GetSalesReportRequest{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[9]
+	mi := &file_analytics_analytics_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -893,7 +971,7 @@ func (x *GetSalesReportRequest) String() string {
 func (*GetSalesReportRequest) ProtoMessage() {}
 
 func (x *GetSalesReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[9]
+	mi := &file_analytics_analytics_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -906,7 +984,7 @@ func (x *GetSalesReportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSalesReportRequest.ProtoReflect.Descriptor instead.
 func (*GetSalesReportRequest) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{9}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetSalesReportRequest) GetDateRange() *DateRange {
@@ -953,7 +1031,7 @@ type GetSalesReportResponse struct {
 
 func (x *GetSalesReportResponse) Reset() {
 	*x = GetSalesReportResponse{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[10]
+	mi := &file_analytics_analytics_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -965,7 +1043,7 @@ func (x *GetSalesReportResponse) String() string {
 func (*GetSalesReportResponse) ProtoMessage() {}
 
 func (x *GetSalesReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[10]
+	mi := &file_analytics_analytics_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -978,7 +1056,7 @@ func (x *GetSalesReportResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSalesReportResponse.ProtoReflect.Descriptor instead.
 func (*GetSalesReportResponse) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{10}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetSalesReportResponse) GetSalesReport() *SalesReport {
@@ -989,16 +1067,18 @@ func (x *GetSalesReportResponse) GetSalesReport() *SalesReport {
 }
 
 type GetDailySummaryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	CashierId     *int64                 `protobuf:"varint,2,opt,name=cashier_id,json=cashierId,proto3,oneof" json:"cashier_id,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Date      string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	CashierId *int64                 `protobuf:"varint,2,opt,name=cashier_id,json=cashierId,proto3,oneof" json:"cashier_id,omitempty"`
+	// Zone that date is interpreted in; see DateRange.timezone.
+	Timezone      *string `protobuf:"bytes,3,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetDailySummaryRequest) Reset() {
 	*x = GetDailySummaryRequest{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[11]
+	mi := &file_analytics_analytics_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1010,7 +1090,7 @@ func (x *GetDailySummaryRequest) String() string {
 func (*GetDailySummaryRequest) ProtoMessage() {}
 
 func (x *GetDailySummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[11]
+	mi := &file_analytics_analytics_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1023,7 +1103,7 @@ func (x *GetDailySummaryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDailySummaryRequest.ProtoReflect.Descriptor instead.
 func (*GetDailySummaryRequest) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{11}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetDailySummaryRequest) GetDate() string {
@@ -1040,6 +1120,13 @@ func (x *GetDailySummaryRequest) GetCashierId() int64 {
 	return 0
 }
 
+func (x *GetDailySummaryRequest) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
 type GetDailySummaryResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	DailySummaries []*SalesSummaryDaily   `protobuf:"bytes,1,rep,name=daily_summaries,json=dailySummaries,proto3" json:"daily_summaries,omitempty"`
@@ -1049,7 +1136,7 @@ type GetDailySummaryResponse struct {
 
 func (x *GetDailySummaryResponse) Reset() {
 	*x = GetDailySummaryResponse{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[12]
+	mi := &file_analytics_analytics_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1061,7 +1148,7 @@ func (x *GetDailySummaryResponse) String() string {
 func (*GetDailySummaryResponse) ProtoMessage() {}
 
 func (x *GetDailySummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[12]
+	mi := &file_analytics_analytics_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1074,7 +1161,7 @@ func (x *GetDailySummaryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDailySummaryResponse.ProtoReflect.Descriptor instead.
 func (*GetDailySummaryResponse) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{12}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetDailySummaryResponse) GetDailySummaries() []*SalesSummaryDaily {
@@ -1085,16 +1172,18 @@ func (x *GetDailySummaryResponse) GetDailySummaries() []*SalesSummaryDaily {
 }
 
 type GenerateDailySummaryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	CashierId     *int64                 `protobuf:"varint,2,opt,name=cashier_id,json=cashierId,proto3,oneof" json:"cashier_id,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Date      string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	CashierId *int64                 `protobuf:"varint,2,opt,name=cashier_id,json=cashierId,proto3,oneof" json:"cashier_id,omitempty"`
+	// Zone that date is interpreted in; see DateRange.timezone.
+	Timezone      *string `protobuf:"bytes,3,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GenerateDailySummaryRequest) Reset() {
 	*x = GenerateDailySummaryRequest{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[13]
+	mi := &file_analytics_analytics_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1106,7 +1195,7 @@ func (x *GenerateDailySummaryRequest) String() string {
 func (*GenerateDailySummaryRequest) ProtoMessage() {}
 
 func (x *GenerateDailySummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[13]
+	mi := &file_analytics_analytics_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1119,7 +1208,7 @@ func (x *GenerateDailySummaryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateDailySummaryRequest.ProtoReflect.Descriptor instead.
 func (*GenerateDailySummaryRequest) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{13}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GenerateDailySummaryRequest) GetDate() string {
@@ -1136,6 +1225,13 @@ func (x *GenerateDailySummaryRequest) GetCashierId() int64 {
 	return 0
 }
 
+func (x *GenerateDailySummaryRequest) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
 type GenerateDailySummaryResponse struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	GeneratedSummaries []*SalesSummaryDaily   `protobuf:"bytes,1,rep,name=generated_summaries,json=generatedSummaries,proto3" json:"generated_summaries,omitempty"`
@@ -1147,7 +1243,7 @@ type GenerateDailySummaryResponse struct {
 
 func (x *GenerateDailySummaryResponse) Reset() {
 	*x = GenerateDailySummaryResponse{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[14]
+	mi := &file_analytics_analytics_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1159,7 +1255,7 @@ func (x *GenerateDailySummaryResponse) String() string {
 func (*GenerateDailySummaryResponse) ProtoMessage() {}
 
 func (x *GenerateDailySummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[14]
+	mi := &file_analytics_analytics_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1172,7 +1268,7 @@ func (x *GenerateDailySummaryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateDailySummaryResponse.ProtoReflect.Descriptor instead.
 func (*GenerateDailySummaryResponse) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{14}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GenerateDailySummaryResponse) GetGeneratedSummaries() []*SalesSummaryDaily {
@@ -1209,7 +1305,7 @@ type GetProductSalesRequest struct {
 
 func (x *GetProductSalesRequest) Reset() {
 	*x = GetProductSalesRequest{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[15]
+	mi := &file_analytics_analytics_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1221,7 +1317,7 @@ func (x *GetProductSalesRequest) String() string {
 func (*GetProductSalesRequest) ProtoMessage() {}
 
 func (x *GetProductSalesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[15]
+	mi := &file_analytics_analytics_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1234,7 +1330,7 @@ func (x *GetProductSalesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductSalesRequest.ProtoReflect.Descriptor instead.
 func (*GetProductSalesRequest) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{15}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetProductSalesRequest) GetDateRange() *DateRange {
@@ -1275,7 +1371,7 @@ type GetProductSalesResponse struct {
 
 func (x *GetProductSalesResponse) Reset() {
 	*x = GetProductSalesResponse{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[16]
+	mi := &file_analytics_analytics_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1287,7 +1383,7 @@ func (x *GetProductSalesResponse) String() string {
 func (*GetProductSalesResponse) ProtoMessage() {}
 
 func (x *GetProductSalesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[16]
+	mi := &file_analytics_analytics_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1300,7 +1396,7 @@ func (x *GetProductSalesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductSalesResponse.ProtoReflect.Descriptor instead.
 func (*GetProductSalesResponse) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{16}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetProductSalesResponse) GetProductSales() []*ProductSalesSummary {
@@ -1328,7 +1424,7 @@ type GetTopSellingProductsRequest struct {
 
 func (x *GetTopSellingProductsRequest) Reset() {
 	*x = GetTopSellingProductsRequest{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[17]
+	mi := &file_analytics_analytics_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1340,7 +1436,7 @@ func (x *GetTopSellingProductsRequest) String() string {
 func (*GetTopSellingProductsRequest) ProtoMessage() {}
 
 func (x *GetTopSellingProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[17]
+	mi := &file_analytics_analytics_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1353,7 +1449,7 @@ func (x *GetTopSellingProductsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTopSellingProductsRequest.ProtoReflect.Descriptor instead.
 func (*GetTopSellingProductsRequest) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{17}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetTopSellingProductsRequest) GetDateRange() *DateRange {
@@ -1386,7 +1482,7 @@ type GetTopSellingProductsResponse struct {
 
 func (x *GetTopSellingProductsResponse) Reset() {
 	*x = GetTopSellingProductsResponse{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[18]
+	mi := &file_analytics_analytics_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1398,7 +1494,7 @@ func (x *GetTopSellingProductsResponse) String() string {
 func (*GetTopSellingProductsResponse) ProtoMessage() {}
 
 func (x *GetTopSellingProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[18]
+	mi := &file_analytics_analytics_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1411,7 +1507,7 @@ func (x *GetTopSellingProductsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTopSellingProductsResponse.ProtoReflect.Descriptor instead.
 func (*GetTopSellingProductsResponse) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{18}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetTopSellingProductsResponse) GetTopProducts() []*ProductSalesSummary {
@@ -1433,7 +1529,7 @@ type GetEmployeePerformanceRequest struct {
 
 func (x *GetEmployeePerformanceRequest) Reset() {
 	*x = GetEmployeePerformanceRequest{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[19]
+	mi := &file_analytics_analytics_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1445,7 +1541,7 @@ func (x *GetEmployeePerformanceRequest) String() string {
 func (*GetEmployeePerformanceRequest) ProtoMessage() {}
 
 func (x *GetEmployeePerformanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[19]
+	mi := &file_analytics_analytics_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1458,7 +1554,7 @@ func (x *GetEmployeePerformanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEmployeePerformanceRequest.ProtoReflect.Descriptor instead.
 func (*GetEmployeePerformanceRequest) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{19}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetEmployeePerformanceRequest) GetDateRange() *DateRange {
@@ -1492,7 +1588,7 @@ type GetEmployeePerformanceResponse struct {
 
 func (x *GetEmployeePerformanceResponse) Reset() {
 	*x = GetEmployeePerformanceResponse{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[20]
+	mi := &file_analytics_analytics_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1504,7 +1600,7 @@ func (x *GetEmployeePerformanceResponse) String() string {
 func (*GetEmployeePerformanceResponse) ProtoMessage() {}
 
 func (x *GetEmployeePerformanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[20]
+	mi := &file_analytics_analytics_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1517,7 +1613,7 @@ func (x *GetEmployeePerformanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEmployeePerformanceResponse.ProtoReflect.Descriptor instead.
 func (*GetEmployeePerformanceResponse) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{20}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetEmployeePerformanceResponse) GetPerformances() []*EmployeePerformance {
@@ -1544,7 +1640,7 @@ type GetPerformanceReportRequest struct {
 
 func (x *GetPerformanceReportRequest) Reset() {
 	*x = GetPerformanceReportRequest{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[21]
+	mi := &file_analytics_analytics_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1556,7 +1652,7 @@ func (x *GetPerformanceReportRequest) String() string {
 func (*GetPerformanceReportRequest) ProtoMessage() {}
 
 func (x *GetPerformanceReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[21]
+	mi := &file_analytics_analytics_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1569,7 +1665,7 @@ func (x *GetPerformanceReportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPerformanceReportRequest.ProtoReflect.Descriptor instead.
 func (*GetPerformanceReportRequest) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{21}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GetPerformanceReportRequest) GetDateRange() *DateRange {
@@ -1595,7 +1691,7 @@ type GetPerformanceReportResponse struct {
 
 func (x *GetPerformanceReportResponse) Reset() {
 	*x = GetPerformanceReportResponse{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[22]
+	mi := &file_analytics_analytics_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1607,7 +1703,7 @@ func (x *GetPerformanceReportResponse) String() string {
 func (*GetPerformanceReportResponse) ProtoMessage() {}
 
 func (x *GetPerformanceReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[22]
+	mi := &file_analytics_analytics_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1620,7 +1716,7 @@ func (x *GetPerformanceReportResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPerformanceReportResponse.ProtoReflect.Descriptor instead.
 func (*GetPerformanceReportResponse) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{22}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetPerformanceReportResponse) GetPerformanceReport() *PerformanceReport {
@@ -1642,7 +1738,7 @@ type GetCustomerAnalyticsRequest struct {
 
 func (x *GetCustomerAnalyticsRequest) Reset() {
 	*x = GetCustomerAnalyticsRequest{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[23]
+	mi := &file_analytics_analytics_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1654,7 +1750,7 @@ func (x *GetCustomerAnalyticsRequest) String() string {
 func (*GetCustomerAnalyticsRequest) ProtoMessage() {}
 
 func (x *GetCustomerAnalyticsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[23]
+	mi := &file_analytics_analytics_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1667,7 +1763,7 @@ func (x *GetCustomerAnalyticsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCustomerAnalyticsRequest.ProtoReflect.Descriptor instead.
 func (*GetCustomerAnalyticsRequest) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{23}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetCustomerAnalyticsRequest) GetDateRange() *DateRange {
@@ -1701,7 +1797,7 @@ type GetCustomerAnalyticsResponse struct {
 
 func (x *GetCustomerAnalyticsResponse) Reset() {
 	*x = GetCustomerAnalyticsResponse{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[24]
+	mi := &file_analytics_analytics_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1713,7 +1809,7 @@ func (x *GetCustomerAnalyticsResponse) String() string {
 func (*GetCustomerAnalyticsResponse) ProtoMessage() {}
 
 func (x *GetCustomerAnalyticsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[24]
+	mi := &file_analytics_analytics_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1726,7 +1822,7 @@ func (x *GetCustomerAnalyticsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCustomerAnalyticsResponse.ProtoReflect.Descriptor instead.
 func (*GetCustomerAnalyticsResponse) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{24}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetCustomerAnalyticsResponse) GetAnalytics() []*CustomerAnalytics {
@@ -1752,7 +1848,7 @@ type GetPeakHoursRequest struct {
 
 func (x *GetPeakHoursRequest) Reset() {
 	*x = GetPeakHoursRequest{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[25]
+	mi := &file_analytics_analytics_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1764,7 +1860,7 @@ func (x *GetPeakHoursRequest) String() string {
 func (*GetPeakHoursRequest) ProtoMessage() {}
 
 func (x *GetPeakHoursRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[25]
+	mi := &file_analytics_analytics_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1777,7 +1873,7 @@ func (x *GetPeakHoursRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPeakHoursRequest.ProtoReflect.Descriptor instead.
 func (*GetPeakHoursRequest) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{25}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetPeakHoursRequest) GetDateRange() *DateRange {
@@ -1796,7 +1892,7 @@ type GetPeakHoursResponse struct {
 
 func (x *GetPeakHoursResponse) Reset() {
 	*x = GetPeakHoursResponse{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[26]
+	mi := &file_analytics_analytics_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1808,7 +1904,7 @@ func (x *GetPeakHoursResponse) String() string {
 func (*GetPeakHoursResponse) ProtoMessage() {}
 
 func (x *GetPeakHoursResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[26]
+	mi := &file_analytics_analytics_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1821,7 +1917,7 @@ func (x *GetPeakHoursResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPeakHoursResponse.ProtoReflect.Descriptor instead.
 func (*GetPeakHoursResponse) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{26}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetPeakHoursResponse) GetPeakHours() []*PeakHourData {
@@ -1842,7 +1938,7 @@ type PeakHourData struct {
 
 func (x *PeakHourData) Reset() {
 	*x = PeakHourData{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[27]
+	mi := &file_analytics_analytics_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1854,7 +1950,7 @@ func (x *PeakHourData) String() string {
 func (*PeakHourData) ProtoMessage() {}
 
 func (x *PeakHourData) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[27]
+	mi := &file_analytics_analytics_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1867,7 +1963,7 @@ func (x *PeakHourData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PeakHourData.ProtoReflect.Descriptor instead.
 func (*PeakHourData) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{27}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *PeakHourData) GetHour() string {
@@ -1892,15 +1988,17 @@ func (x *PeakHourData) GetTotalRevenue() string {
 }
 
 type GetDashboardDataRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Date  string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// Zone that date is interpreted in; see DateRange.timezone.
+	Timezone      *string `protobuf:"bytes,2,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetDashboardDataRequest) Reset() {
 	*x = GetDashboardDataRequest{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[28]
+	mi := &file_analytics_analytics_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1912,7 +2010,7 @@ func (x *GetDashboardDataRequest) String() string {
 func (*GetDashboardDataRequest) ProtoMessage() {}
 
 func (x *GetDashboardDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[28]
+	mi := &file_analytics_analytics_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1925,7 +2023,7 @@ func (x *GetDashboardDataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDashboardDataRequest.ProtoReflect.Descriptor instead.
 func (*GetDashboardDataRequest) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{28}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetDashboardDataRequest) GetDate() string {
@@ -1935,6 +2033,13 @@ func (x *GetDashboardDataRequest) GetDate() string {
 	return ""
 }
 
+func (x *GetDashboardDataRequest) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
 type GetDashboardDataResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Dashboard     *DashboardData         `protobuf:"bytes,1,opt,name=dashboard,proto3" json:"dashboard,omitempty"`
@@ -1944,7 +2049,7 @@ type GetDashboardDataResponse struct {
 
 func (x *GetDashboardDataResponse) Reset() {
 	*x = GetDashboardDataResponse{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[29]
+	mi := &file_analytics_analytics_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1956,7 +2061,7 @@ func (x *GetDashboardDataResponse) String() string {
 func (*GetDashboardDataResponse) ProtoMessage() {}
 
 func (x *GetDashboardDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[29]
+	mi := &file_analytics_analytics_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1969,7 +2074,7 @@ func (x *GetDashboardDataResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDashboardDataResponse.ProtoReflect.Descriptor instead.
 func (*GetDashboardDataResponse) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{29}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GetDashboardDataResponse) GetDashboard() *DashboardData {
@@ -1997,7 +2102,7 @@ type DashboardData struct {
 
 func (x *DashboardData) Reset() {
 	*x = DashboardData{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[30]
+	mi := &file_analytics_analytics_service_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2009,7 +2114,7 @@ func (x *DashboardData) String() string {
 func (*DashboardData) ProtoMessage() {}
 
 func (x *DashboardData) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[30]
+	mi := &file_analytics_analytics_service_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2022,7 +2127,7 @@ func (x *DashboardData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DashboardData.ProtoReflect.Descriptor instead.
 func (*DashboardData) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{30}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *DashboardData) GetTodayRevenue() string {
@@ -2103,7 +2208,7 @@ type GetRealTimeMetricsRequest struct {
 
 func (x *GetRealTimeMetricsRequest) Reset() {
 	*x = GetRealTimeMetricsRequest{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[31]
+	mi := &file_analytics_analytics_service_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2115,7 +2220,7 @@ func (x *GetRealTimeMetricsRequest) String() string {
 func (*GetRealTimeMetricsRequest) ProtoMessage() {}
 
 func (x *GetRealTimeMetricsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[31]
+	mi := &file_analytics_analytics_service_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2128,7 +2233,7 @@ func (x *GetRealTimeMetricsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRealTimeMetricsRequest.ProtoReflect.Descriptor instead.
 func (*GetRealTimeMetricsRequest) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{31}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{32}
 }
 
 type GetRealTimeMetricsResponse struct {
@@ -2140,7 +2245,7 @@ type GetRealTimeMetricsResponse struct {
 
 func (x *GetRealTimeMetricsResponse) Reset() {
 	*x = GetRealTimeMetricsResponse{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[32]
+	mi := &file_analytics_analytics_service_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2152,7 +2257,7 @@ func (x *GetRealTimeMetricsResponse) String() string {
 func (*GetRealTimeMetricsResponse) ProtoMessage() {}
 
 func (x *GetRealTimeMetricsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[32]
+	mi := &file_analytics_analytics_service_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2165,7 +2270,7 @@ func (x *GetRealTimeMetricsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRealTimeMetricsResponse.ProtoReflect.Descriptor instead.
 func (*GetRealTimeMetricsResponse) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{32}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GetRealTimeMetricsResponse) GetMetrics() *RealTimeMetrics {
@@ -2189,7 +2294,7 @@ type RealTimeMetrics struct {
 
 func (x *RealTimeMetrics) Reset() {
 	*x = RealTimeMetrics{}
-	mi := &file_analytics_analytics_service_proto_msgTypes[33]
+	mi := &file_analytics_analytics_service_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2201,7 +2306,7 @@ func (x *RealTimeMetrics) String() string {
 func (*RealTimeMetrics) ProtoMessage() {}
 
 func (x *RealTimeMetrics) ProtoReflect() protoreflect.Message {
-	mi := &file_analytics_analytics_service_proto_msgTypes[33]
+	mi := &file_analytics_analytics_service_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2214,7 +2319,7 @@ func (x *RealTimeMetrics) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RealTimeMetrics.ProtoReflect.Descriptor instead.
 func (*RealTimeMetrics) Descriptor() ([]byte, []int) {
-	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{33}
+	return file_analytics_analytics_service_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *RealTimeMetrics) GetLastUpdated() *timestamppb.Timestamp {
@@ -2271,11 +2376,13 @@ const file_analytics_analytics_service_proto_rawDesc = "" +
 	"\x12PaginationResponse\x12&\n" +
 	"\x0fnext_page_token\x18\x01 \x01(\tR\rnextPageToken\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"E\n" +
+	"totalCount\"s\n" +
 	"\tDateRange\x12\x1d\n" +
 	"\n" +
 	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
-	"\bend_date\x18\x02 \x01(\tR\aendDate\"\xeb\x03\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12\x1f\n" +
+	"\btimezone\x18\x03 \x01(\tH\x00R\btimezone\x88\x01\x01B\v\n" +
+	"\t_timezone\"\xa3\x04\n" +
 	"\x11SalesSummaryDaily\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04date\x18\x02 \x01(\tR\x04date\x12\x1d\n" +
@@ -2295,7 +2402,14 @@ const file_analytics_analytics_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe0\x03\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x126\n" +
+	"\n" +
+	"tax_totals\x18\x0e \x03(\v2\x17.analytics.TaxRateTotalR\ttaxTotals\"o\n" +
+	"\fTaxRateTotal\x12\x19\n" +
+	"\btax_rate\x18\x01 \x01(\tR\ataxRate\x12%\n" +
+	"\x0etaxable_amount\x18\x02 \x01(\tR\rtaxableAmount\x12\x1d\n" +
+	"\n" +
+	"tax_amount\x18\x03 \x01(\tR\ttaxAmount\"\xe0\x03\n" +
 	"\x13ProductSalesSummary\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04date\x18\x02 \x01(\tR\x04date\x12\x1d\n" +
@@ -2379,19 +2493,23 @@ const file_analytics_analytics_service_proto_rawDesc = "" +
 	"\x18_include_daily_breakdownB\x1c\n" +
 	"\x1a_include_product_breakdown\"S\n" +
 	"\x16GetSalesReportResponse\x129\n" +
-	"\fsales_report\x18\x01 \x01(\v2\x16.analytics.SalesReportR\vsalesReport\"_\n" +
+	"\fsales_report\x18\x01 \x01(\v2\x16.analytics.SalesReportR\vsalesReport\"\x8d\x01\n" +
 	"\x16GetDailySummaryRequest\x12\x12\n" +
 	"\x04date\x18\x01 \x01(\tR\x04date\x12\"\n" +
 	"\n" +
-	"cashier_id\x18\x02 \x01(\x03H\x00R\tcashierId\x88\x01\x01B\r\n" +
-	"\v_cashier_id\"`\n" +
+	"cashier_id\x18\x02 \x01(\x03H\x00R\tcashierId\x88\x01\x01\x12\x1f\n" +
+	"\btimezone\x18\x03 \x01(\tH\x01R\btimezone\x88\x01\x01B\r\n" +
+	"\v_cashier_idB\v\n" +
+	"\t_timezone\"`\n" +
 	"\x17GetDailySummaryResponse\x12E\n" +
-	"\x0fdaily_summaries\x18\x01 \x03(\v2\x1c.analytics.SalesSummaryDailyR\x0edailySummaries\"d\n" +
+	"\x0fdaily_summaries\x18\x01 \x03(\v2\x1c.analytics.SalesSummaryDailyR\x0edailySummaries\"\x92\x01\n" +
 	"\x1bGenerateDailySummaryRequest\x12\x12\n" +
 	"\x04date\x18\x01 \x01(\tR\x04date\x12\"\n" +
 	"\n" +
-	"cashier_id\x18\x02 \x01(\x03H\x00R\tcashierId\x88\x01\x01B\r\n" +
-	"\v_cashier_id\"\xb2\x01\n" +
+	"cashier_id\x18\x02 \x01(\x03H\x00R\tcashierId\x88\x01\x01\x12\x1f\n" +
+	"\btimezone\x18\x03 \x01(\tH\x01R\btimezone\x88\x01\x01B\r\n" +
+	"\v_cashier_idB\v\n" +
+	"\t_timezone\"\xb2\x01\n" +
 	"\x1cGenerateDailySummaryResponse\x12M\n" +
 	"\x13generated_summaries\x18\x01 \x03(\v2\x1c.analytics.SalesSummaryDailyR\x12generatedSummaries\x12\x18\n" +
 	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x1d\n" +
@@ -2466,9 +2584,11 @@ const file_analytics_analytics_service_proto_rawDesc = "" +
 	"\fPeakHourData\x12\x12\n" +
 	"\x04hour\x18\x01 \x01(\tR\x04hour\x12+\n" +
 	"\x11transaction_count\x18\x02 \x01(\x05R\x10transactionCount\x12#\n" +
-	"\rtotal_revenue\x18\x03 \x01(\tR\ftotalRevenue\"-\n" +
+	"\rtotal_revenue\x18\x03 \x01(\tR\ftotalRevenue\"[\n" +
 	"\x17GetDashboardDataRequest\x12\x12\n" +
-	"\x04date\x18\x01 \x01(\tR\x04date\"R\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1f\n" +
+	"\btimezone\x18\x02 \x01(\tH\x00R\btimezone\x88\x01\x01B\v\n" +
+	"\t_timezone\"R\n" +
 	"\x18GetDashboardDataResponse\x126\n" +
 	"\tdashboard\x18\x01 \x01(\v2\x18.analytics.DashboardDataR\tdashboard\"\xb6\x04\n" +
 	"\rDashboardData\x12#\n" +
@@ -2518,112 +2638,114 @@ func file_analytics_analytics_service_proto_rawDescGZIP() []byte {
 	return file_analytics_analytics_service_proto_rawDescData
 }
 
-var file_analytics_analytics_service_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_analytics_analytics_service_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
 var file_analytics_analytics_service_proto_goTypes = []any{
 	(*PaginationRequest)(nil),              // 0: analytics.PaginationRequest
 	(*PaginationResponse)(nil),             // 1: analytics.PaginationResponse
 	(*DateRange)(nil),                      // 2: analytics.DateRange
 	(*SalesSummaryDaily)(nil),              // 3: analytics.SalesSummaryDaily
-	(*ProductSalesSummary)(nil),            // 4: analytics.ProductSalesSummary
-	(*EmployeePerformance)(nil),            // 5: analytics.EmployeePerformance
-	(*CustomerAnalytics)(nil),              // 6: analytics.CustomerAnalytics
-	(*SalesReport)(nil),                    // 7: analytics.SalesReport
-	(*PerformanceReport)(nil),              // 8: analytics.PerformanceReport
-	(*GetSalesReportRequest)(nil),          // 9: analytics.GetSalesReportRequest
-	(*GetSalesReportResponse)(nil),         // 10: analytics.GetSalesReportResponse
-	(*GetDailySummaryRequest)(nil),         // 11: analytics.GetDailySummaryRequest
-	(*GetDailySummaryResponse)(nil),        // 12: analytics.GetDailySummaryResponse
-	(*GenerateDailySummaryRequest)(nil),    // 13: analytics.GenerateDailySummaryRequest
-	(*GenerateDailySummaryResponse)(nil),   // 14: analytics.GenerateDailySummaryResponse
-	(*GetProductSalesRequest)(nil),         // 15: analytics.GetProductSalesRequest
-	(*GetProductSalesResponse)(nil),        // 16: analytics.GetProductSalesResponse
-	(*GetTopSellingProductsRequest)(nil),   // 17: analytics.GetTopSellingProductsRequest
-	(*GetTopSellingProductsResponse)(nil),  // 18: analytics.GetTopSellingProductsResponse
-	(*GetEmployeePerformanceRequest)(nil),  // 19: analytics.GetEmployeePerformanceRequest
-	(*GetEmployeePerformanceResponse)(nil), // 20: analytics.GetEmployeePerformanceResponse
-	(*GetPerformanceReportRequest)(nil),    // 21: analytics.GetPerformanceReportRequest
-	(*GetPerformanceReportResponse)(nil),   // 22: analytics.GetPerformanceReportResponse
-	(*GetCustomerAnalyticsRequest)(nil),    // 23: analytics.GetCustomerAnalyticsRequest
-	(*GetCustomerAnalyticsResponse)(nil),   // 24: analytics.GetCustomerAnalyticsResponse
-	(*GetPeakHoursRequest)(nil),            // 25: analytics.GetPeakHoursRequest
-	(*GetPeakHoursResponse)(nil),           // 26: analytics.GetPeakHoursResponse
-	(*PeakHourData)(nil),                   // 27: analytics.PeakHourData
-	(*GetDashboardDataRequest)(nil),        // 28: analytics.GetDashboardDataRequest
-	(*GetDashboardDataResponse)(nil),       // 29: analytics.GetDashboardDataResponse
-	(*DashboardData)(nil),                  // 30: analytics.DashboardData
-	(*GetRealTimeMetricsRequest)(nil),      // 31: analytics.GetRealTimeMetricsRequest
-	(*GetRealTimeMetricsResponse)(nil),     // 32: analytics.GetRealTimeMetricsResponse
-	(*RealTimeMetrics)(nil),                // 33: analytics.RealTimeMetrics
-	(*timestamppb.Timestamp)(nil),          // 34: google.protobuf.Timestamp
+	(*TaxRateTotal)(nil),                   // 4: analytics.TaxRateTotal
+	(*ProductSalesSummary)(nil),            // 5: analytics.ProductSalesSummary
+	(*EmployeePerformance)(nil),            // 6: analytics.EmployeePerformance
+	(*CustomerAnalytics)(nil),              // 7: analytics.CustomerAnalytics
+	(*SalesReport)(nil),                    // 8: analytics.SalesReport
+	(*PerformanceReport)(nil),              // 9: analytics.PerformanceReport
+	(*GetSalesReportRequest)(nil),          // 10: analytics.GetSalesReportRequest
+	(*GetSalesReportResponse)(nil),         // 11: analytics.GetSalesReportResponse
+	(*GetDailySummaryRequest)(nil),         // 12: analytics.GetDailySummaryRequest
+	(*GetDailySummaryResponse)(nil),        // 13: analytics.GetDailySummaryResponse
+	(*GenerateDailySummaryRequest)(nil),    // 14: analytics.GenerateDailySummaryRequest
+	(*GenerateDailySummaryResponse)(nil),   // 15: analytics.GenerateDailySummaryResponse
+	(*GetProductSalesRequest)(nil),         // 16: analytics.GetProductSalesRequest
+	(*GetProductSalesResponse)(nil),        // 17: analytics.GetProductSalesResponse
+	(*GetTopSellingProductsRequest)(nil),   // 18: analytics.GetTopSellingProductsRequest
+	(*GetTopSellingProductsResponse)(nil),  // 19: analytics.GetTopSellingProductsResponse
+	(*GetEmployeePerformanceRequest)(nil),  // 20: analytics.GetEmployeePerformanceRequest
+	(*GetEmployeePerformanceResponse)(nil), // 21: analytics.GetEmployeePerformanceResponse
+	(*GetPerformanceReportRequest)(nil),    // 22: analytics.GetPerformanceReportRequest
+	(*GetPerformanceReportResponse)(nil),   // 23: analytics.GetPerformanceReportResponse
+	(*GetCustomerAnalyticsRequest)(nil),    // 24: analytics.GetCustomerAnalyticsRequest
+	(*GetCustomerAnalyticsResponse)(nil),   // 25: analytics.GetCustomerAnalyticsResponse
+	(*GetPeakHoursRequest)(nil),            // 26: analytics.GetPeakHoursRequest
+	(*GetPeakHoursResponse)(nil),           // 27: analytics.GetPeakHoursResponse
+	(*PeakHourData)(nil),                   // 28: analytics.PeakHourData
+	(*GetDashboardDataRequest)(nil),        // 29: analytics.GetDashboardDataRequest
+	(*GetDashboardDataResponse)(nil),       // 30: analytics.GetDashboardDataResponse
+	(*DashboardData)(nil),                  // 31: analytics.DashboardData
+	(*GetRealTimeMetricsRequest)(nil),      // 32: analytics.GetRealTimeMetricsRequest
+	(*GetRealTimeMetricsResponse)(nil),     // 33: analytics.GetRealTimeMetricsResponse
+	(*RealTimeMetrics)(nil),                // 34: analytics.RealTimeMetrics
+	(*timestamppb.Timestamp)(nil),          // 35: google.protobuf.Timestamp
 }
 var file_analytics_analytics_service_proto_depIdxs = []int32{
-	34, // 0: analytics.SalesSummaryDaily.created_at:type_name -> google.protobuf.Timestamp
-	34, // 1: analytics.SalesSummaryDaily.updated_at:type_name -> google.protobuf.Timestamp
-	34, // 2: analytics.ProductSalesSummary.created_at:type_name -> google.protobuf.Timestamp
-	34, // 3: analytics.ProductSalesSummary.updated_at:type_name -> google.protobuf.Timestamp
-	34, // 4: analytics.EmployeePerformance.created_at:type_name -> google.protobuf.Timestamp
-	34, // 5: analytics.EmployeePerformance.updated_at:type_name -> google.protobuf.Timestamp
-	34, // 6: analytics.CustomerAnalytics.created_at:type_name -> google.protobuf.Timestamp
-	34, // 7: analytics.CustomerAnalytics.updated_at:type_name -> google.protobuf.Timestamp
-	2,  // 8: analytics.SalesReport.period:type_name -> analytics.DateRange
-	3,  // 9: analytics.SalesReport.daily_breakdowns:type_name -> analytics.SalesSummaryDaily
-	4,  // 10: analytics.SalesReport.top_products:type_name -> analytics.ProductSalesSummary
-	2,  // 11: analytics.PerformanceReport.period:type_name -> analytics.DateRange
-	5,  // 12: analytics.PerformanceReport.employee_performances:type_name -> analytics.EmployeePerformance
-	2,  // 13: analytics.GetSalesReportRequest.date_range:type_name -> analytics.DateRange
-	7,  // 14: analytics.GetSalesReportResponse.sales_report:type_name -> analytics.SalesReport
-	3,  // 15: analytics.GetDailySummaryResponse.daily_summaries:type_name -> analytics.SalesSummaryDaily
-	3,  // 16: analytics.GenerateDailySummaryResponse.generated_summaries:type_name -> analytics.SalesSummaryDaily
-	2,  // 17: analytics.GetProductSalesRequest.date_range:type_name -> analytics.DateRange
-	0,  // 18: analytics.GetProductSalesRequest.pagination:type_name -> analytics.PaginationRequest
-	4,  // 19: analytics.GetProductSalesResponse.product_sales:type_name -> analytics.ProductSalesSummary
-	1,  // 20: analytics.GetProductSalesResponse.pagination:type_name -> analytics.PaginationResponse
-	2,  // 21: analytics.GetTopSellingProductsRequest.date_range:type_name -> analytics.DateRange
-	4,  // 22: analytics.GetTopSellingProductsResponse.top_products:type_name -> analytics.ProductSalesSummary
-	2,  // 23: analytics.GetEmployeePerformanceRequest.date_range:type_name -> analytics.DateRange
-	0,  // 24: analytics.GetEmployeePerformanceRequest.pagination:type_name -> analytics.PaginationRequest
-	5,  // 25: analytics.GetEmployeePerformanceResponse.performances:type_name -> analytics.EmployeePerformance
-	1,  // 26: analytics.GetEmployeePerformanceResponse.pagination:type_name -> analytics.PaginationResponse
-	2,  // 27: analytics.GetPerformanceReportRequest.date_range:type_name -> analytics.DateRange
-	8,  // 28: analytics.GetPerformanceReportResponse.performance_report:type_name -> analytics.PerformanceReport
-	2,  // 29: analytics.GetCustomerAnalyticsRequest.date_range:type_name -> analytics.DateRange
-	0,  // 30: analytics.GetCustomerAnalyticsRequest.pagination:type_name -> analytics.PaginationRequest
-	6,  // 31: analytics.GetCustomerAnalyticsResponse.analytics:type_name -> analytics.CustomerAnalytics
-	1,  // 32: analytics.GetCustomerAnalyticsResponse.pagination:type_name -> analytics.PaginationResponse
-	2,  // 33: analytics.GetPeakHoursRequest.date_range:type_name -> analytics.DateRange
-	27, // 34: analytics.GetPeakHoursResponse.peak_hours:type_name -> analytics.PeakHourData
-	30, // 35: analytics.GetDashboardDataResponse.dashboard:type_name -> analytics.DashboardData
-	4,  // 36: analytics.DashboardData.top_products_today:type_name -> analytics.ProductSalesSummary
-	5,  // 37: analytics.DashboardData.top_performers_today:type_name -> analytics.EmployeePerformance
-	33, // 38: analytics.GetRealTimeMetricsResponse.metrics:type_name -> analytics.RealTimeMetrics
-	34, // 39: analytics.RealTimeMetrics.last_updated:type_name -> google.protobuf.Timestamp
-	9,  // 40: analytics.AnalyticsService.GetSalesReport:input_type -> analytics.GetSalesReportRequest
-	11, // 41: analytics.AnalyticsService.GetDailySummary:input_type -> analytics.GetDailySummaryRequest
-	13, // 42: analytics.AnalyticsService.GenerateDailySummary:input_type -> analytics.GenerateDailySummaryRequest
-	15, // 43: analytics.AnalyticsService.GetProductSales:input_type -> analytics.GetProductSalesRequest
-	17, // 44: analytics.AnalyticsService.GetTopSellingProducts:input_type -> analytics.GetTopSellingProductsRequest
-	19, // 45: analytics.AnalyticsService.GetEmployeePerformance:input_type -> analytics.GetEmployeePerformanceRequest
-	21, // 46: analytics.AnalyticsService.GetPerformanceReport:input_type -> analytics.GetPerformanceReportRequest
-	23, // 47: analytics.AnalyticsService.GetCustomerAnalytics:input_type -> analytics.GetCustomerAnalyticsRequest
-	25, // 48: analytics.AnalyticsService.GetPeakHours:input_type -> analytics.GetPeakHoursRequest
-	28, // 49: analytics.AnalyticsService.GetDashboardData:input_type -> analytics.GetDashboardDataRequest
-	31, // 50: analytics.AnalyticsService.GetRealTimeMetrics:input_type -> analytics.GetRealTimeMetricsRequest
-	10, // 51: analytics.AnalyticsService.GetSalesReport:output_type -> analytics.GetSalesReportResponse
-	12, // 52: analytics.AnalyticsService.GetDailySummary:output_type -> analytics.GetDailySummaryResponse
-	14, // 53: analytics.AnalyticsService.GenerateDailySummary:output_type -> analytics.GenerateDailySummaryResponse
-	16, // 54: analytics.AnalyticsService.GetProductSales:output_type -> analytics.GetProductSalesResponse
-	18, // 55: analytics.AnalyticsService.GetTopSellingProducts:output_type -> analytics.GetTopSellingProductsResponse
-	20, // 56: analytics.AnalyticsService.GetEmployeePerformance:output_type -> analytics.GetEmployeePerformanceResponse
-	22, // 57: analytics.AnalyticsService.GetPerformanceReport:output_type -> analytics.GetPerformanceReportResponse
-	24, // 58: analytics.AnalyticsService.GetCustomerAnalytics:output_type -> analytics.GetCustomerAnalyticsResponse
-	26, // 59: analytics.AnalyticsService.GetPeakHours:output_type -> analytics.GetPeakHoursResponse
-	29, // 60: analytics.AnalyticsService.GetDashboardData:output_type -> analytics.GetDashboardDataResponse
-	32, // 61: analytics.AnalyticsService.GetRealTimeMetrics:output_type -> analytics.GetRealTimeMetricsResponse
-	51, // [51:62] is the sub-list for method output_type
-	40, // [40:51] is the sub-list for method input_type
-	40, // [40:40] is the sub-list for extension type_name
-	40, // [40:40] is the sub-list for extension extendee
-	0,  // [0:40] is the sub-list for field type_name
+	35, // 0: analytics.SalesSummaryDaily.created_at:type_name -> google.protobuf.Timestamp
+	35, // 1: analytics.SalesSummaryDaily.updated_at:type_name -> google.protobuf.Timestamp
+	4,  // 2: analytics.SalesSummaryDaily.tax_totals:type_name -> analytics.TaxRateTotal
+	35, // 3: analytics.ProductSalesSummary.created_at:type_name -> google.protobuf.Timestamp
+	35, // 4: analytics.ProductSalesSummary.updated_at:type_name -> google.protobuf.Timestamp
+	35, // 5: analytics.EmployeePerformance.created_at:type_name -> google.protobuf.Timestamp
+	35, // 6: analytics.EmployeePerformance.updated_at:type_name -> google.protobuf.Timestamp
+	35, // 7: analytics.CustomerAnalytics.created_at:type_name -> google.protobuf.Timestamp
+	35, // 8: analytics.CustomerAnalytics.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 9: analytics.SalesReport.period:type_name -> analytics.DateRange
+	3,  // 10: analytics.SalesReport.daily_breakdowns:type_name -> analytics.SalesSummaryDaily
+	5,  // 11: analytics.SalesReport.top_products:type_name -> analytics.ProductSalesSummary
+	2,  // 12: analytics.PerformanceReport.period:type_name -> analytics.DateRange
+	6,  // 13: analytics.PerformanceReport.employee_performances:type_name -> analytics.EmployeePerformance
+	2,  // 14: analytics.GetSalesReportRequest.date_range:type_name -> analytics.DateRange
+	8,  // 15: analytics.GetSalesReportResponse.sales_report:type_name -> analytics.SalesReport
+	3,  // 16: analytics.GetDailySummaryResponse.daily_summaries:type_name -> analytics.SalesSummaryDaily
+	3,  // 17: analytics.GenerateDailySummaryResponse.generated_summaries:type_name -> analytics.SalesSummaryDaily
+	2,  // 18: analytics.GetProductSalesRequest.date_range:type_name -> analytics.DateRange
+	0,  // 19: analytics.GetProductSalesRequest.pagination:type_name -> analytics.PaginationRequest
+	5,  // 20: analytics.GetProductSalesResponse.product_sales:type_name -> analytics.ProductSalesSummary
+	1,  // 21: analytics.GetProductSalesResponse.pagination:type_name -> analytics.PaginationResponse
+	2,  // 22: analytics.GetTopSellingProductsRequest.date_range:type_name -> analytics.DateRange
+	5,  // 23: analytics.GetTopSellingProductsResponse.top_products:type_name -> analytics.ProductSalesSummary
+	2,  // 24: analytics.GetEmployeePerformanceRequest.date_range:type_name -> analytics.DateRange
+	0,  // 25: analytics.GetEmployeePerformanceRequest.pagination:type_name -> analytics.PaginationRequest
+	6,  // 26: analytics.GetEmployeePerformanceResponse.performances:type_name -> analytics.EmployeePerformance
+	1,  // 27: analytics.GetEmployeePerformanceResponse.pagination:type_name -> analytics.PaginationResponse
+	2,  // 28: analytics.GetPerformanceReportRequest.date_range:type_name -> analytics.DateRange
+	9,  // 29: analytics.GetPerformanceReportResponse.performance_report:type_name -> analytics.PerformanceReport
+	2,  // 30: analytics.GetCustomerAnalyticsRequest.date_range:type_name -> analytics.DateRange
+	0,  // 31: analytics.GetCustomerAnalyticsRequest.pagination:type_name -> analytics.PaginationRequest
+	7,  // 32: analytics.GetCustomerAnalyticsResponse.analytics:type_name -> analytics.CustomerAnalytics
+	1,  // 33: analytics.GetCustomerAnalyticsResponse.pagination:type_name -> analytics.PaginationResponse
+	2,  // 34: analytics.GetPeakHoursRequest.date_range:type_name -> analytics.DateRange
+	28, // 35: analytics.GetPeakHoursResponse.peak_hours:type_name -> analytics.PeakHourData
+	31, // 36: analytics.GetDashboardDataResponse.dashboard:type_name -> analytics.DashboardData
+	5,  // 37: analytics.DashboardData.top_products_today:type_name -> analytics.ProductSalesSummary
+	6,  // 38: analytics.DashboardData.top_performers_today:type_name -> analytics.EmployeePerformance
+	34, // 39: analytics.GetRealTimeMetricsResponse.metrics:type_name -> analytics.RealTimeMetrics
+	35, // 40: analytics.RealTimeMetrics.last_updated:type_name -> google.protobuf.Timestamp
+	10, // 41: analytics.AnalyticsService.GetSalesReport:input_type -> analytics.GetSalesReportRequest
+	12, // 42: analytics.AnalyticsService.GetDailySummary:input_type -> analytics.GetDailySummaryRequest
+	14, // 43: analytics.AnalyticsService.GenerateDailySummary:input_type -> analytics.GenerateDailySummaryRequest
+	16, // 44: analytics.AnalyticsService.GetProductSales:input_type -> analytics.GetProductSalesRequest
+	18, // 45: analytics.AnalyticsService.GetTopSellingProducts:input_type -> analytics.GetTopSellingProductsRequest
+	20, // 46: analytics.AnalyticsService.GetEmployeePerformance:input_type -> analytics.GetEmployeePerformanceRequest
+	22, // 47: analytics.AnalyticsService.GetPerformanceReport:input_type -> analytics.GetPerformanceReportRequest
+	24, // 48: analytics.AnalyticsService.GetCustomerAnalytics:input_type -> analytics.GetCustomerAnalyticsRequest
+	26, // 49: analytics.AnalyticsService.GetPeakHours:input_type -> analytics.GetPeakHoursRequest
+	29, // 50: analytics.AnalyticsService.GetDashboardData:input_type -> analytics.GetDashboardDataRequest
+	32, // 51: analytics.AnalyticsService.GetRealTimeMetrics:input_type -> analytics.GetRealTimeMetricsRequest
+	11, // 52: analytics.AnalyticsService.GetSalesReport:output_type -> analytics.GetSalesReportResponse
+	13, // 53: analytics.AnalyticsService.GetDailySummary:output_type -> analytics.GetDailySummaryResponse
+	15, // 54: analytics.AnalyticsService.GenerateDailySummary:output_type -> analytics.GenerateDailySummaryResponse
+	17, // 55: analytics.AnalyticsService.GetProductSales:output_type -> analytics.GetProductSalesResponse
+	19, // 56: analytics.AnalyticsService.GetTopSellingProducts:output_type -> analytics.GetTopSellingProductsResponse
+	21, // 57: analytics.AnalyticsService.GetEmployeePerformance:output_type -> analytics.GetEmployeePerformanceResponse
+	23, // 58: analytics.AnalyticsService.GetPerformanceReport:output_type -> analytics.GetPerformanceReportResponse
+	25, // 59: analytics.AnalyticsService.GetCustomerAnalytics:output_type -> analytics.GetCustomerAnalyticsResponse
+	27, // 60: analytics.AnalyticsService.GetPeakHours:output_type -> analytics.GetPeakHoursResponse
+	30, // 61: analytics.AnalyticsService.GetDashboardData:output_type -> analytics.GetDashboardDataResponse
+	33, // 62: analytics.AnalyticsService.GetRealTimeMetrics:output_type -> analytics.GetRealTimeMetricsResponse
+	52, // [52:63] is the sub-list for method output_type
+	41, // [41:52] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_analytics_analytics_service_proto_init() }
@@ -2631,24 +2753,26 @@ func file_analytics_analytics_service_proto_init() {
 	if File_analytics_analytics_service_proto != nil {
 		return
 	}
-	file_analytics_analytics_service_proto_msgTypes[4].OneofWrappers = []any{}
-	file_analytics_analytics_service_proto_msgTypes[6].OneofWrappers = []any{}
-	file_analytics_analytics_service_proto_msgTypes[9].OneofWrappers = []any{}
-	file_analytics_analytics_service_proto_msgTypes[11].OneofWrappers = []any{}
-	file_analytics_analytics_service_proto_msgTypes[13].OneofWrappers = []any{}
+	file_analytics_analytics_service_proto_msgTypes[2].OneofWrappers = []any{}
+	file_analytics_analytics_service_proto_msgTypes[5].OneofWrappers = []any{}
+	file_analytics_analytics_service_proto_msgTypes[7].OneofWrappers = []any{}
+	file_analytics_analytics_service_proto_msgTypes[10].OneofWrappers = []any{}
+	file_analytics_analytics_service_proto_msgTypes[12].OneofWrappers = []any{}
 	file_analytics_analytics_service_proto_msgTypes[14].OneofWrappers = []any{}
 	file_analytics_analytics_service_proto_msgTypes[15].OneofWrappers = []any{}
-	file_analytics_analytics_service_proto_msgTypes[17].OneofWrappers = []any{}
-	file_analytics_analytics_service_proto_msgTypes[19].OneofWrappers = []any{}
-	file_analytics_analytics_service_proto_msgTypes[21].OneofWrappers = []any{}
-	file_analytics_analytics_service_proto_msgTypes[23].OneofWrappers = []any{}
+	file_analytics_analytics_service_proto_msgTypes[16].OneofWrappers = []any{}
+	file_analytics_analytics_service_proto_msgTypes[18].OneofWrappers = []any{}
+	file_analytics_analytics_service_proto_msgTypes[20].OneofWrappers = []any{}
+	file_analytics_analytics_service_proto_msgTypes[22].OneofWrappers = []any{}
+	file_analytics_analytics_service_proto_msgTypes[24].OneofWrappers = []any{}
+	file_analytics_analytics_service_proto_msgTypes[29].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_analytics_analytics_service_proto_rawDesc), len(file_analytics_analytics_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   34,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -77,11 +77,12 @@ func (CommissionType) EnumDescriptor() ([]byte, []int) {
 type CommissionStatus int32
 
 const (
-	CommissionStatus_COMMISSION_STATUS_UNSPECIFIED CommissionStatus = 0
-	CommissionStatus_COMMISSION_STATUS_DRAFT       CommissionStatus = 1
-	CommissionStatus_COMMISSION_STATUS_CALCULATED  CommissionStatus = 2
-	CommissionStatus_COMMISSION_STATUS_APPROVED    CommissionStatus = 3
-	CommissionStatus_COMMISSION_STATUS_PAID        CommissionStatus = 4
+	CommissionStatus_COMMISSION_STATUS_UNSPECIFIED    CommissionStatus = 0
+	CommissionStatus_COMMISSION_STATUS_DRAFT          CommissionStatus = 1
+	CommissionStatus_COMMISSION_STATUS_CALCULATED     CommissionStatus = 2
+	CommissionStatus_COMMISSION_STATUS_APPROVED       CommissionStatus = 3
+	CommissionStatus_COMMISSION_STATUS_PAID           CommissionStatus = 4
+	CommissionStatus_COMMISSION_STATUS_PARTIALLY_PAID CommissionStatus = 5
 )
 
 // Enum value maps for CommissionStatus.
@@ -92,13 +93,15 @@ var (
 		2: "COMMISSION_STATUS_CALCULATED",
 		3: "COMMISSION_STATUS_APPROVED",
 		4: "COMMISSION_STATUS_PAID",
+		5: "COMMISSION_STATUS_PARTIALLY_PAID",
 	}
 	CommissionStatus_value = map[string]int32{
-		"COMMISSION_STATUS_UNSPECIFIED": 0,
-		"COMMISSION_STATUS_DRAFT":       1,
-		"COMMISSION_STATUS_CALCULATED":  2,
-		"COMMISSION_STATUS_APPROVED":    3,
-		"COMMISSION_STATUS_PAID":        4,
+		"COMMISSION_STATUS_UNSPECIFIED":    0,
+		"COMMISSION_STATUS_DRAFT":          1,
+		"COMMISSION_STATUS_CALCULATED":     2,
+		"COMMISSION_STATUS_APPROVED":       3,
+		"COMMISSION_STATUS_PAID":           4,
+		"COMMISSION_STATUS_PARTIALLY_PAID": 5,
 	}
 )
 
@@ -129,6 +132,57 @@ func (CommissionStatus) EnumDescriptor() ([]byte, []int) {
 	return file_commissions_commision_service_proto_rawDescGZIP(), []int{1}
 }
 
+type BonusRuleType int32
+
+const (
+	BonusRuleType_BONUS_RULE_TYPE_UNSPECIFIED BonusRuleType = 0
+	// Flat bonus_amount once total sales reach threshold_amount.
+	BonusRuleType_BONUS_RULE_TYPE_FLAT_ABOVE_THRESHOLD BonusRuleType = 1
+	// Extra bonus_rate applied to sales above threshold_amount.
+	BonusRuleType_BONUS_RULE_TYPE_RATE_ABOVE_THRESHOLD BonusRuleType = 2
+)
+
+// Enum value maps for BonusRuleType.
+var (
+	BonusRuleType_name = map[int32]string{
+		0: "BONUS_RULE_TYPE_UNSPECIFIED",
+		1: "BONUS_RULE_TYPE_FLAT_ABOVE_THRESHOLD",
+		2: "BONUS_RULE_TYPE_RATE_ABOVE_THRESHOLD",
+	}
+	BonusRuleType_value = map[string]int32{
+		"BONUS_RULE_TYPE_UNSPECIFIED":          0,
+		"BONUS_RULE_TYPE_FLAT_ABOVE_THRESHOLD": 1,
+		"BONUS_RULE_TYPE_RATE_ABOVE_THRESHOLD": 2,
+	}
+)
+
+func (x BonusRuleType) Enum() *BonusRuleType {
+	p := new(BonusRuleType)
+	*p = x
+	return p
+}
+
+func (x BonusRuleType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BonusRuleType) Descriptor() protoreflect.EnumDescriptor {
+	return file_commissions_commision_service_proto_enumTypes[2].Descriptor()
+}
+
+func (BonusRuleType) Type() protoreflect.EnumType {
+	return &file_commissions_commision_service_proto_enumTypes[2]
+}
+
+func (x BonusRuleType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BonusRuleType.Descriptor instead.
+func (BonusRuleType) EnumDescriptor() ([]byte, []int) {
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{2}
+}
+
 type PaginationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
@@ -234,9 +288,11 @@ func (x *PaginationResponse) GetTotalCount() int32 {
 }
 
 type DateRange struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StartDate string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// IANA zone used for day boundaries; defaults to the store timezone.
+	Timezone      *string `protobuf:"bytes,3,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -285,6 +341,13 @@ func (x *DateRange) GetEndDate() string {
 	return ""
 }
 
+func (x *DateRange) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
 type CommissionCalculation struct {
 	state                  protoimpl.MessageState `protogen:"open.v1"`
 	Id                     int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -302,10 +365,21 @@ type CommissionCalculation struct {
 	CreatedAt              *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt              *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	CommissionDetails      []*CommissionDetail    `protobuf:"bytes,15,rep,name=commission_details,json=commissionDetails,proto3" json:"commission_details,omitempty"`
-	CommissionPayment      *CommissionPayment     `protobuf:"bytes,16,opt,name=commission_payment,json=commissionPayment,proto3,oneof" json:"commission_payment,omitempty"`
-	Employee               *EmployeeSummary       `protobuf:"bytes,17,opt,name=employee,proto3,oneof" json:"employee,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	// Most recent non-voided installment; see commission_payments.
+	CommissionPayment *CommissionPayment `protobuf:"bytes,16,opt,name=commission_payment,json=commissionPayment,proto3,oneof" json:"commission_payment,omitempty"`
+	Employee          *EmployeeSummary   `protobuf:"bytes,17,opt,name=employee,proto3,oneof" json:"employee,omitempty"`
+	// Product-group filter the calculation was made with; empty means all
+	// commission-eligible sales. Reused by RecalculateCommission.
+	ProductGroupIds []int32 `protobuf:"varint,18,rep,packed,name=product_group_ids,json=productGroupIds,proto3" json:"product_group_ids,omitempty"`
+	Currency        string  `protobuf:"bytes,19,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Every installment, voided ones included, oldest first.
+	CommissionPayments []*CommissionPayment `protobuf:"bytes,20,rep,name=commission_payments,json=commissionPayments,proto3" json:"commission_payments,omitempty"`
+	// Sum of non-voided payments; remaining_balance is total_commission
+	// minus total_paid.
+	TotalPaid        string `protobuf:"bytes,21,opt,name=total_paid,json=totalPaid,proto3" json:"total_paid,omitempty"`
+	RemainingBalance string `protobuf:"bytes,22,opt,name=remaining_balance,json=remainingBalance,proto3" json:"remaining_balance,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *CommissionCalculation) Reset() {
@@ -457,6 +531,41 @@ func (x *CommissionCalculation) GetEmployee() *EmployeeSummary {
 	return nil
 }
 
+func (x *CommissionCalculation) GetProductGroupIds() []int32 {
+	if x != nil {
+		return x.ProductGroupIds
+	}
+	return nil
+}
+
+func (x *CommissionCalculation) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CommissionCalculation) GetCommissionPayments() []*CommissionPayment {
+	if x != nil {
+		return x.CommissionPayments
+	}
+	return nil
+}
+
+func (x *CommissionCalculation) GetTotalPaid() string {
+	if x != nil {
+		return x.TotalPaid
+	}
+	return ""
+}
+
+func (x *CommissionCalculation) GetRemainingBalance() string {
+	if x != nil {
+		return x.RemainingBalance
+	}
+	return ""
+}
+
 type CommissionDetail struct {
 	state                   protoimpl.MessageState `protogen:"open.v1"`
 	Id                      int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -586,6 +695,10 @@ type CommissionPayment struct {
 	Notes                   *string                `protobuf:"bytes,9,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
 	CreatedAt               *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	PaymentType             *PaymentTypeSummary    `protobuf:"bytes,11,opt,name=payment_type,json=paymentType,proto3,oneof" json:"payment_type,omitempty"`
+	IsVoided                bool                   `protobuf:"varint,12,opt,name=is_voided,json=isVoided,proto3" json:"is_voided,omitempty"`
+	VoidedBy                *int64                 `protobuf:"varint,13,opt,name=voided_by,json=voidedBy,proto3,oneof" json:"voided_by,omitempty"`
+	VoidReason              *string                `protobuf:"bytes,14,opt,name=void_reason,json=voidReason,proto3,oneof" json:"void_reason,omitempty"`
+	VoidedAt                *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=voided_at,json=voidedAt,proto3,oneof" json:"voided_at,omitempty"`
 	unknownFields           protoimpl.UnknownFields
 	sizeCache               protoimpl.SizeCache
 }
@@ -697,6 +810,126 @@ func (x *CommissionPayment) GetPaymentType() *PaymentTypeSummary {
 	return nil
 }
 
+func (x *CommissionPayment) GetIsVoided() bool {
+	if x != nil {
+		return x.IsVoided
+	}
+	return false
+}
+
+func (x *CommissionPayment) GetVoidedBy() int64 {
+	if x != nil && x.VoidedBy != nil {
+		return *x.VoidedBy
+	}
+	return 0
+}
+
+func (x *CommissionPayment) GetVoidReason() string {
+	if x != nil && x.VoidReason != nil {
+		return *x.VoidReason
+	}
+	return ""
+}
+
+func (x *CommissionPayment) GetVoidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VoidedAt
+	}
+	return nil
+}
+
+type CommissionStatusHistory struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Id                      int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CommissionCalculationId int64                  `protobuf:"varint,2,opt,name=commission_calculation_id,json=commissionCalculationId,proto3" json:"commission_calculation_id,omitempty"`
+	FromStatus              CommissionStatus       `protobuf:"varint,3,opt,name=from_status,json=fromStatus,proto3,enum=commission.CommissionStatus" json:"from_status,omitempty"`
+	ToStatus                CommissionStatus       `protobuf:"varint,4,opt,name=to_status,json=toStatus,proto3,enum=commission.CommissionStatus" json:"to_status,omitempty"`
+	ActorId                 int64                  `protobuf:"varint,5,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Reason                  *string                `protobuf:"bytes,6,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	CreatedAt               *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *CommissionStatusHistory) Reset() {
+	*x = CommissionStatusHistory{}
+	mi := &file_commissions_commision_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommissionStatusHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommissionStatusHistory) ProtoMessage() {}
+
+func (x *CommissionStatusHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_commissions_commision_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommissionStatusHistory.ProtoReflect.Descriptor instead.
+func (*CommissionStatusHistory) Descriptor() ([]byte, []int) {
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CommissionStatusHistory) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CommissionStatusHistory) GetCommissionCalculationId() int64 {
+	if x != nil {
+		return x.CommissionCalculationId
+	}
+	return 0
+}
+
+func (x *CommissionStatusHistory) GetFromStatus() CommissionStatus {
+	if x != nil {
+		return x.FromStatus
+	}
+	return CommissionStatus_COMMISSION_STATUS_UNSPECIFIED
+}
+
+func (x *CommissionStatusHistory) GetToStatus() CommissionStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return CommissionStatus_COMMISSION_STATUS_UNSPECIFIED
+}
+
+func (x *CommissionStatusHistory) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *CommissionStatusHistory) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *CommissionStatusHistory) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
 // Summary models for cross-service references
 type EmployeeSummary struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -711,7 +944,7 @@ type EmployeeSummary struct {
 
 func (x *EmployeeSummary) Reset() {
 	*x = EmployeeSummary{}
-	mi := &file_commissions_commision_service_proto_msgTypes[6]
+	mi := &file_commissions_commision_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -723,7 +956,7 @@ func (x *EmployeeSummary) String() string {
 func (*EmployeeSummary) ProtoMessage() {}
 
 func (x *EmployeeSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[6]
+	mi := &file_commissions_commision_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -736,7 +969,7 @@ func (x *EmployeeSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmployeeSummary.ProtoReflect.Descriptor instead.
 func (*EmployeeSummary) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{6}
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *EmployeeSummary) GetId() int64 {
@@ -785,7 +1018,7 @@ type PaymentTypeSummary struct {
 
 func (x *PaymentTypeSummary) Reset() {
 	*x = PaymentTypeSummary{}
-	mi := &file_commissions_commision_service_proto_msgTypes[7]
+	mi := &file_commissions_commision_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -797,7 +1030,7 @@ func (x *PaymentTypeSummary) String() string {
 func (*PaymentTypeSummary) ProtoMessage() {}
 
 func (x *PaymentTypeSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[7]
+	mi := &file_commissions_commision_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -810,7 +1043,7 @@ func (x *PaymentTypeSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentTypeSummary.ProtoReflect.Descriptor instead.
 func (*PaymentTypeSummary) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{7}
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *PaymentTypeSummary) GetId() int32 {
@@ -850,7 +1083,7 @@ type CommissionBreakdown struct {
 
 func (x *CommissionBreakdown) Reset() {
 	*x = CommissionBreakdown{}
-	mi := &file_commissions_commision_service_proto_msgTypes[8]
+	mi := &file_commissions_commision_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -862,7 +1095,7 @@ func (x *CommissionBreakdown) String() string {
 func (*CommissionBreakdown) ProtoMessage() {}
 
 func (x *CommissionBreakdown) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[8]
+	mi := &file_commissions_commision_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -875,7 +1108,7 @@ func (x *CommissionBreakdown) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommissionBreakdown.ProtoReflect.Descriptor instead.
 func (*CommissionBreakdown) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{8}
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CommissionBreakdown) GetTotalSales() string {
@@ -940,7 +1173,7 @@ type TierCommission struct {
 
 func (x *TierCommission) Reset() {
 	*x = TierCommission{}
-	mi := &file_commissions_commision_service_proto_msgTypes[9]
+	mi := &file_commissions_commision_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -952,7 +1185,7 @@ func (x *TierCommission) String() string {
 func (*TierCommission) ProtoMessage() {}
 
 func (x *TierCommission) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[9]
+	mi := &file_commissions_commision_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -965,7 +1198,7 @@ func (x *TierCommission) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TierCommission.ProtoReflect.Descriptor instead.
 func (*TierCommission) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{9}
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *TierCommission) GetTierMinAmount() string {
@@ -1011,13 +1244,16 @@ type CalculateCommissionRequest struct {
 	PeriodEnd       string                 `protobuf:"bytes,3,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
 	CalculatedBy    int64                  `protobuf:"varint,4,opt,name=calculated_by,json=calculatedBy,proto3" json:"calculated_by,omitempty"`
 	SaveCalculation *bool                  `protobuf:"varint,5,opt,name=save_calculation,json=saveCalculation,proto3,oneof" json:"save_calculation,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	ProductGroupIds []int32                `protobuf:"varint,6,rep,packed,name=product_group_ids,json=productGroupIds,proto3" json:"product_group_ids,omitempty"`
+	// Zone for the period boundaries; see DateRange.timezone.
+	Timezone      *string `protobuf:"bytes,7,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CalculateCommissionRequest) Reset() {
 	*x = CalculateCommissionRequest{}
-	mi := &file_commissions_commision_service_proto_msgTypes[10]
+	mi := &file_commissions_commision_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1029,7 +1265,7 @@ func (x *CalculateCommissionRequest) String() string {
 func (*CalculateCommissionRequest) ProtoMessage() {}
 
 func (x *CalculateCommissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[10]
+	mi := &file_commissions_commision_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1042,7 +1278,7 @@ func (x *CalculateCommissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CalculateCommissionRequest.ProtoReflect.Descriptor instead.
 func (*CalculateCommissionRequest) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{10}
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CalculateCommissionRequest) GetEmployeeId() int64 {
@@ -1080,18 +1316,34 @@ func (x *CalculateCommissionRequest) GetSaveCalculation() bool {
 	return false
 }
 
+func (x *CalculateCommissionRequest) GetProductGroupIds() []int32 {
+	if x != nil {
+		return x.ProductGroupIds
+	}
+	return nil
+}
+
+func (x *CalculateCommissionRequest) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
 type CalculateCommissionResponse struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
 	CommissionCalculation *CommissionCalculation `protobuf:"bytes,1,opt,name=commission_calculation,json=commissionCalculation,proto3" json:"commission_calculation,omitempty"`
 	Breakdown             *CommissionBreakdown   `protobuf:"bytes,2,opt,name=breakdown,proto3" json:"breakdown,omitempty"`
 	IsPreview             bool                   `protobuf:"varint,3,opt,name=is_preview,json=isPreview,proto3" json:"is_preview,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// Non-fatal issues, such as a saved calculation overlapping this period.
+	Warnings      []string `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CalculateCommissionResponse) Reset() {
 	*x = CalculateCommissionResponse{}
-	mi := &file_commissions_commision_service_proto_msgTypes[11]
+	mi := &file_commissions_commision_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1103,7 +1355,7 @@ func (x *CalculateCommissionResponse) String() string {
 func (*CalculateCommissionResponse) ProtoMessage() {}
 
 func (x *CalculateCommissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[11]
+	mi := &file_commissions_commision_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1116,7 +1368,7 @@ func (x *CalculateCommissionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CalculateCommissionResponse.ProtoReflect.Descriptor instead.
 func (*CalculateCommissionResponse) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{11}
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *CalculateCommissionResponse) GetCommissionCalculation() *CommissionCalculation {
@@ -1140,30 +1392,38 @@ func (x *CalculateCommissionResponse) GetIsPreview() bool {
 	return false
 }
 
-type RecalculateCommissionRequest struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	CommissionCalculationId int64                  `protobuf:"varint,1,opt,name=commission_calculation_id,json=commissionCalculationId,proto3" json:"commission_calculation_id,omitempty"`
-	RecalculatedBy          int64                  `protobuf:"varint,2,opt,name=recalculated_by,json=recalculatedBy,proto3" json:"recalculated_by,omitempty"`
-	Notes                   *string                `protobuf:"bytes,3,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+func (x *CalculateCommissionResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
 }
 
-func (x *RecalculateCommissionRequest) Reset() {
-	*x = RecalculateCommissionRequest{}
-	mi := &file_commissions_commision_service_proto_msgTypes[12]
+// Live, unsaved preview for the period containing today.
+type GetCurrentPeriodSalesRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId int64                  `protobuf:"varint,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	// Zone that decides "today"; see DateRange.timezone.
+	Timezone      *string `protobuf:"bytes,2,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrentPeriodSalesRequest) Reset() {
+	*x = GetCurrentPeriodSalesRequest{}
+	mi := &file_commissions_commision_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecalculateCommissionRequest) String() string {
+func (x *GetCurrentPeriodSalesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecalculateCommissionRequest) ProtoMessage() {}
+func (*GetCurrentPeriodSalesRequest) ProtoMessage() {}
 
-func (x *RecalculateCommissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[12]
+func (x *GetCurrentPeriodSalesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commissions_commision_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1174,55 +1434,51 @@ func (x *RecalculateCommissionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecalculateCommissionRequest.ProtoReflect.Descriptor instead.
-func (*RecalculateCommissionRequest) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *RecalculateCommissionRequest) GetCommissionCalculationId() int64 {
-	if x != nil {
-		return x.CommissionCalculationId
-	}
-	return 0
+// Deprecated: Use GetCurrentPeriodSalesRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrentPeriodSalesRequest) Descriptor() ([]byte, []int) {
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *RecalculateCommissionRequest) GetRecalculatedBy() int64 {
+func (x *GetCurrentPeriodSalesRequest) GetEmployeeId() int64 {
 	if x != nil {
-		return x.RecalculatedBy
+		return x.EmployeeId
 	}
 	return 0
 }
 
-func (x *RecalculateCommissionRequest) GetNotes() string {
-	if x != nil && x.Notes != nil {
-		return *x.Notes
+func (x *GetCurrentPeriodSalesRequest) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
 	}
 	return ""
 }
 
-type RecalculateCommissionResponse struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	CommissionCalculation *CommissionCalculation `protobuf:"bytes,1,opt,name=commission_calculation,json=commissionCalculation,proto3" json:"commission_calculation,omitempty"`
-	Breakdown             *CommissionBreakdown   `protobuf:"bytes,2,opt,name=breakdown,proto3" json:"breakdown,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type GetCurrentPeriodSalesResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId          int64                  `protobuf:"varint,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	Period              *DateRange             `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	CommissionableSales string                 `protobuf:"bytes,3,opt,name=commissionable_sales,json=commissionableSales,proto3" json:"commissionable_sales,omitempty"`
+	ProjectedCommission string                 `protobuf:"bytes,4,opt,name=projected_commission,json=projectedCommission,proto3" json:"projected_commission,omitempty"`
+	Breakdown           *CommissionBreakdown   `protobuf:"bytes,5,opt,name=breakdown,proto3" json:"breakdown,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *RecalculateCommissionResponse) Reset() {
-	*x = RecalculateCommissionResponse{}
-	mi := &file_commissions_commision_service_proto_msgTypes[13]
+func (x *GetCurrentPeriodSalesResponse) Reset() {
+	*x = GetCurrentPeriodSalesResponse{}
+	mi := &file_commissions_commision_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecalculateCommissionResponse) String() string {
+func (x *GetCurrentPeriodSalesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecalculateCommissionResponse) ProtoMessage() {}
+func (*GetCurrentPeriodSalesResponse) ProtoMessage() {}
 
-func (x *RecalculateCommissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[13]
+func (x *GetCurrentPeriodSalesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commissions_commision_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1233,12 +1489,145 @@ func (x *RecalculateCommissionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecalculateCommissionResponse.ProtoReflect.Descriptor instead.
-func (*RecalculateCommissionResponse) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetCurrentPeriodSalesResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrentPeriodSalesResponse) Descriptor() ([]byte, []int) {
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *RecalculateCommissionResponse) GetCommissionCalculation() *CommissionCalculation {
+func (x *GetCurrentPeriodSalesResponse) GetEmployeeId() int64 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *GetCurrentPeriodSalesResponse) GetPeriod() *DateRange {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *GetCurrentPeriodSalesResponse) GetCommissionableSales() string {
+	if x != nil {
+		return x.CommissionableSales
+	}
+	return ""
+}
+
+func (x *GetCurrentPeriodSalesResponse) GetProjectedCommission() string {
+	if x != nil {
+		return x.ProjectedCommission
+	}
+	return ""
+}
+
+func (x *GetCurrentPeriodSalesResponse) GetBreakdown() *CommissionBreakdown {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
+type RecalculateCommissionRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	CommissionCalculationId int64                  `protobuf:"varint,1,opt,name=commission_calculation_id,json=commissionCalculationId,proto3" json:"commission_calculation_id,omitempty"`
+	RecalculatedBy          int64                  `protobuf:"varint,2,opt,name=recalculated_by,json=recalculatedBy,proto3" json:"recalculated_by,omitempty"`
+	Notes                   *string                `protobuf:"bytes,3,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *RecalculateCommissionRequest) Reset() {
+	*x = RecalculateCommissionRequest{}
+	mi := &file_commissions_commision_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecalculateCommissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecalculateCommissionRequest) ProtoMessage() {}
+
+func (x *RecalculateCommissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commissions_commision_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecalculateCommissionRequest.ProtoReflect.Descriptor instead.
+func (*RecalculateCommissionRequest) Descriptor() ([]byte, []int) {
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RecalculateCommissionRequest) GetCommissionCalculationId() int64 {
+	if x != nil {
+		return x.CommissionCalculationId
+	}
+	return 0
+}
+
+func (x *RecalculateCommissionRequest) GetRecalculatedBy() int64 {
+	if x != nil {
+		return x.RecalculatedBy
+	}
+	return 0
+}
+
+func (x *RecalculateCommissionRequest) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+type RecalculateCommissionResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	CommissionCalculation *CommissionCalculation `protobuf:"bytes,1,opt,name=commission_calculation,json=commissionCalculation,proto3" json:"commission_calculation,omitempty"`
+	Breakdown             *CommissionBreakdown   `protobuf:"bytes,2,opt,name=breakdown,proto3" json:"breakdown,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *RecalculateCommissionResponse) Reset() {
+	*x = RecalculateCommissionResponse{}
+	mi := &file_commissions_commision_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecalculateCommissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecalculateCommissionResponse) ProtoMessage() {}
+
+func (x *RecalculateCommissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commissions_commision_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecalculateCommissionResponse.ProtoReflect.Descriptor instead.
+func (*RecalculateCommissionResponse) Descriptor() ([]byte, []int) {
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RecalculateCommissionResponse) GetCommissionCalculation() *CommissionCalculation {
 	if x != nil {
 		return x.CommissionCalculation
 	}
@@ -1262,7 +1651,7 @@ type GetCommissionCalculationRequest struct {
 
 func (x *GetCommissionCalculationRequest) Reset() {
 	*x = GetCommissionCalculationRequest{}
-	mi := &file_commissions_commision_service_proto_msgTypes[14]
+	mi := &file_commissions_commision_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1274,7 +1663,7 @@ func (x *GetCommissionCalculationRequest) String() string {
 func (*GetCommissionCalculationRequest) ProtoMessage() {}
 
 func (x *GetCommissionCalculationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[14]
+	mi := &file_commissions_commision_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1287,7 +1676,7 @@ func (x *GetCommissionCalculationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommissionCalculationRequest.ProtoReflect.Descriptor instead.
 func (*GetCommissionCalculationRequest) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{14}
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetCommissionCalculationRequest) GetId() int64 {
@@ -1306,7 +1695,7 @@ type GetCommissionCalculationResponse struct {
 
 func (x *GetCommissionCalculationResponse) Reset() {
 	*x = GetCommissionCalculationResponse{}
-	mi := &file_commissions_commision_service_proto_msgTypes[15]
+	mi := &file_commissions_commision_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1318,7 +1707,7 @@ func (x *GetCommissionCalculationResponse) String() string {
 func (*GetCommissionCalculationResponse) ProtoMessage() {}
 
 func (x *GetCommissionCalculationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[15]
+	mi := &file_commissions_commision_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1331,7 +1720,7 @@ func (x *GetCommissionCalculationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommissionCalculationResponse.ProtoReflect.Descriptor instead.
 func (*GetCommissionCalculationResponse) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{15}
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetCommissionCalculationResponse) GetCommissionCalculation() *CommissionCalculation {
@@ -1347,13 +1736,16 @@ type ListCommissionCalculationsRequest struct {
 	EmployeeId        *int64                 `protobuf:"varint,2,opt,name=employee_id,json=employeeId,proto3,oneof" json:"employee_id,omitempty"`
 	Status            *CommissionStatus      `protobuf:"varint,3,opt,name=status,proto3,enum=commission.CommissionStatus,oneof" json:"status,omitempty"`
 	CalculationPeriod *DateRange             `protobuf:"bytes,4,opt,name=calculation_period,json=calculationPeriod,proto3,oneof" json:"calculation_period,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Embeds commission_details for each row; the server caps page_size
+	// when set.
+	IncludeDetails *bool `protobuf:"varint,5,opt,name=include_details,json=includeDetails,proto3,oneof" json:"include_details,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ListCommissionCalculationsRequest) Reset() {
 	*x = ListCommissionCalculationsRequest{}
-	mi := &file_commissions_commision_service_proto_msgTypes[16]
+	mi := &file_commissions_commision_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1365,7 +1757,7 @@ func (x *ListCommissionCalculationsRequest) String() string {
 func (*ListCommissionCalculationsRequest) ProtoMessage() {}
 
 func (x *ListCommissionCalculationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[16]
+	mi := &file_commissions_commision_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1378,7 +1770,7 @@ func (x *ListCommissionCalculationsRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListCommissionCalculationsRequest.ProtoReflect.Descriptor instead.
 func (*ListCommissionCalculationsRequest) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{16}
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ListCommissionCalculationsRequest) GetPagination() *PaginationRequest {
@@ -1409,6 +1801,13 @@ func (x *ListCommissionCalculationsRequest) GetCalculationPeriod() *DateRange {
 	return nil
 }
 
+func (x *ListCommissionCalculationsRequest) GetIncludeDetails() bool {
+	if x != nil && x.IncludeDetails != nil {
+		return *x.IncludeDetails
+	}
+	return false
+}
+
 type ListCommissionCalculationsResponse struct {
 	state                  protoimpl.MessageState   `protogen:"open.v1"`
 	CommissionCalculations []*CommissionCalculation `protobuf:"bytes,1,rep,name=commission_calculations,json=commissionCalculations,proto3" json:"commission_calculations,omitempty"`
@@ -1419,7 +1818,7 @@ type ListCommissionCalculationsResponse struct {
 
 func (x *ListCommissionCalculationsResponse) Reset() {
 	*x = ListCommissionCalculationsResponse{}
-	mi := &file_commissions_commision_service_proto_msgTypes[17]
+	mi := &file_commissions_commision_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1431,7 +1830,7 @@ func (x *ListCommissionCalculationsResponse) String() string {
 func (*ListCommissionCalculationsResponse) ProtoMessage() {}
 
 func (x *ListCommissionCalculationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[17]
+	mi := &file_commissions_commision_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1444,7 +1843,7 @@ func (x *ListCommissionCalculationsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListCommissionCalculationsResponse.ProtoReflect.Descriptor instead.
 func (*ListCommissionCalculationsResponse) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{17}
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ListCommissionCalculationsResponse) GetCommissionCalculations() []*CommissionCalculation {
@@ -1461,30 +1860,30 @@ func (x *ListCommissionCalculationsResponse) GetPagination() *PaginationResponse
 	return nil
 }
 
-type ApproveCommissionRequest struct {
+type ListCommissionDetailsRequest struct {
 	state                   protoimpl.MessageState `protogen:"open.v1"`
 	CommissionCalculationId int64                  `protobuf:"varint,1,opt,name=commission_calculation_id,json=commissionCalculationId,proto3" json:"commission_calculation_id,omitempty"`
-	ApprovedBy              int64                  `protobuf:"varint,2,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
-	ApprovalNotes           *string                `protobuf:"bytes,3,opt,name=approval_notes,json=approvalNotes,proto3,oneof" json:"approval_notes,omitempty"`
+	Pagination              *PaginationRequest     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ProductId               *int32                 `protobuf:"varint,3,opt,name=product_id,json=productId,proto3,oneof" json:"product_id,omitempty"`
 	unknownFields           protoimpl.UnknownFields
 	sizeCache               protoimpl.SizeCache
 }
 
-func (x *ApproveCommissionRequest) Reset() {
-	*x = ApproveCommissionRequest{}
-	mi := &file_commissions_commision_service_proto_msgTypes[18]
+func (x *ListCommissionDetailsRequest) Reset() {
+	*x = ListCommissionDetailsRequest{}
+	mi := &file_commissions_commision_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApproveCommissionRequest) String() string {
+func (x *ListCommissionDetailsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApproveCommissionRequest) ProtoMessage() {}
+func (*ListCommissionDetailsRequest) ProtoMessage() {}
 
-func (x *ApproveCommissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[18]
+func (x *ListCommissionDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commissions_commision_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1495,54 +1894,55 @@ func (x *ApproveCommissionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApproveCommissionRequest.ProtoReflect.Descriptor instead.
-func (*ApproveCommissionRequest) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ListCommissionDetailsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommissionDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ApproveCommissionRequest) GetCommissionCalculationId() int64 {
+func (x *ListCommissionDetailsRequest) GetCommissionCalculationId() int64 {
 	if x != nil {
 		return x.CommissionCalculationId
 	}
 	return 0
 }
 
-func (x *ApproveCommissionRequest) GetApprovedBy() int64 {
+func (x *ListCommissionDetailsRequest) GetPagination() *PaginationRequest {
 	if x != nil {
-		return x.ApprovedBy
+		return x.Pagination
 	}
-	return 0
+	return nil
 }
 
-func (x *ApproveCommissionRequest) GetApprovalNotes() string {
-	if x != nil && x.ApprovalNotes != nil {
-		return *x.ApprovalNotes
+func (x *ListCommissionDetailsRequest) GetProductId() int32 {
+	if x != nil && x.ProductId != nil {
+		return *x.ProductId
 	}
-	return ""
+	return 0
 }
 
-type ApproveCommissionResponse struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	CommissionCalculation *CommissionCalculation `protobuf:"bytes,1,opt,name=commission_calculation,json=commissionCalculation,proto3" json:"commission_calculation,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type ListCommissionDetailsResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CommissionDetails []*CommissionDetail    `protobuf:"bytes,1,rep,name=commission_details,json=commissionDetails,proto3" json:"commission_details,omitempty"`
+	Pagination        *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *ApproveCommissionResponse) Reset() {
-	*x = ApproveCommissionResponse{}
-	mi := &file_commissions_commision_service_proto_msgTypes[19]
+func (x *ListCommissionDetailsResponse) Reset() {
+	*x = ListCommissionDetailsResponse{}
+	mi := &file_commissions_commision_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApproveCommissionResponse) String() string {
+func (x *ListCommissionDetailsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApproveCommissionResponse) ProtoMessage() {}
+func (*ListCommissionDetailsResponse) ProtoMessage() {}
 
-func (x *ApproveCommissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[19]
+func (x *ListCommissionDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commissions_commision_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1553,42 +1953,49 @@ func (x *ApproveCommissionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApproveCommissionResponse.ProtoReflect.Descriptor instead.
-func (*ApproveCommissionResponse) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ListCommissionDetailsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommissionDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ApproveCommissionResponse) GetCommissionCalculation() *CommissionCalculation {
+func (x *ListCommissionDetailsResponse) GetCommissionDetails() []*CommissionDetail {
 	if x != nil {
-		return x.CommissionCalculation
+		return x.CommissionDetails
 	}
 	return nil
 }
 
-type RejectCommissionRequest struct {
+func (x *ListCommissionDetailsResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ApproveCommissionRequest struct {
 	state                   protoimpl.MessageState `protogen:"open.v1"`
 	CommissionCalculationId int64                  `protobuf:"varint,1,opt,name=commission_calculation_id,json=commissionCalculationId,proto3" json:"commission_calculation_id,omitempty"`
-	RejectedBy              int64                  `protobuf:"varint,2,opt,name=rejected_by,json=rejectedBy,proto3" json:"rejected_by,omitempty"`
-	RejectionReason         string                 `protobuf:"bytes,3,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	ApprovedBy              int64                  `protobuf:"varint,2,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	ApprovalNotes           *string                `protobuf:"bytes,3,opt,name=approval_notes,json=approvalNotes,proto3,oneof" json:"approval_notes,omitempty"`
 	unknownFields           protoimpl.UnknownFields
 	sizeCache               protoimpl.SizeCache
 }
 
-func (x *RejectCommissionRequest) Reset() {
-	*x = RejectCommissionRequest{}
-	mi := &file_commissions_commision_service_proto_msgTypes[20]
+func (x *ApproveCommissionRequest) Reset() {
+	*x = ApproveCommissionRequest{}
+	mi := &file_commissions_commision_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RejectCommissionRequest) String() string {
+func (x *ApproveCommissionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RejectCommissionRequest) ProtoMessage() {}
+func (*ApproveCommissionRequest) ProtoMessage() {}
 
-func (x *RejectCommissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[20]
+func (x *ApproveCommissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commissions_commision_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1599,33 +2006,137 @@ func (x *RejectCommissionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RejectCommissionRequest.ProtoReflect.Descriptor instead.
-func (*RejectCommissionRequest) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ApproveCommissionRequest.ProtoReflect.Descriptor instead.
+func (*ApproveCommissionRequest) Descriptor() ([]byte, []int) {
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *RejectCommissionRequest) GetCommissionCalculationId() int64 {
+func (x *ApproveCommissionRequest) GetCommissionCalculationId() int64 {
 	if x != nil {
 		return x.CommissionCalculationId
 	}
 	return 0
 }
 
-func (x *RejectCommissionRequest) GetRejectedBy() int64 {
+func (x *ApproveCommissionRequest) GetApprovedBy() int64 {
 	if x != nil {
-		return x.RejectedBy
+		return x.ApprovedBy
 	}
 	return 0
 }
 
-func (x *RejectCommissionRequest) GetRejectionReason() string {
-	if x != nil {
-		return x.RejectionReason
+func (x *ApproveCommissionRequest) GetApprovalNotes() string {
+	if x != nil && x.ApprovalNotes != nil {
+		return *x.ApprovalNotes
 	}
 	return ""
 }
 
-type RejectCommissionResponse struct {
+type ApproveCommissionResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	CommissionCalculation *CommissionCalculation `protobuf:"bytes,1,opt,name=commission_calculation,json=commissionCalculation,proto3" json:"commission_calculation,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ApproveCommissionResponse) Reset() {
+	*x = ApproveCommissionResponse{}
+	mi := &file_commissions_commision_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveCommissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveCommissionResponse) ProtoMessage() {}
+
+func (x *ApproveCommissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commissions_commision_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveCommissionResponse.ProtoReflect.Descriptor instead.
+func (*ApproveCommissionResponse) Descriptor() ([]byte, []int) {
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ApproveCommissionResponse) GetCommissionCalculation() *CommissionCalculation {
+	if x != nil {
+		return x.CommissionCalculation
+	}
+	return nil
+}
+
+type RejectCommissionRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	CommissionCalculationId int64                  `protobuf:"varint,1,opt,name=commission_calculation_id,json=commissionCalculationId,proto3" json:"commission_calculation_id,omitempty"`
+	RejectedBy              int64                  `protobuf:"varint,2,opt,name=rejected_by,json=rejectedBy,proto3" json:"rejected_by,omitempty"`
+	RejectionReason         string                 `protobuf:"bytes,3,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *RejectCommissionRequest) Reset() {
+	*x = RejectCommissionRequest{}
+	mi := &file_commissions_commision_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectCommissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectCommissionRequest) ProtoMessage() {}
+
+func (x *RejectCommissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commissions_commision_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectCommissionRequest.ProtoReflect.Descriptor instead.
+func (*RejectCommissionRequest) Descriptor() ([]byte, []int) {
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RejectCommissionRequest) GetCommissionCalculationId() int64 {
+	if x != nil {
+		return x.CommissionCalculationId
+	}
+	return 0
+}
+
+func (x *RejectCommissionRequest) GetRejectedBy() int64 {
+	if x != nil {
+		return x.RejectedBy
+	}
+	return 0
+}
+
+func (x *RejectCommissionRequest) GetRejectionReason() string {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return ""
+}
+
+type RejectCommissionResponse struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
 	CommissionCalculation *CommissionCalculation `protobuf:"bytes,1,opt,name=commission_calculation,json=commissionCalculation,proto3" json:"commission_calculation,omitempty"`
 	unknownFields         protoimpl.UnknownFields
@@ -1634,7 +2145,7 @@ type RejectCommissionResponse struct {
 
 func (x *RejectCommissionResponse) Reset() {
 	*x = RejectCommissionResponse{}
-	mi := &file_commissions_commision_service_proto_msgTypes[21]
+	mi := &file_commissions_commision_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1646,7 +2157,7 @@ func (x *RejectCommissionResponse) String() string {
 func (*RejectCommissionResponse) ProtoMessage() {}
 
 func (x *RejectCommissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[21]
+	mi := &file_commissions_commision_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1659,7 +2170,7 @@ func (x *RejectCommissionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RejectCommissionResponse.ProtoReflect.Descriptor instead.
 func (*RejectCommissionResponse) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{21}
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *RejectCommissionResponse) GetCommissionCalculation() *CommissionCalculation {
@@ -1669,6 +2180,201 @@ func (x *RejectCommissionResponse) GetCommissionCalculation() *CommissionCalcula
 	return nil
 }
 
+// Moves an APPROVED calculation back to CALCULATED; refused once a
+// payment has been recorded.
+type UnapproveCommissionRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	CommissionCalculationId int64                  `protobuf:"varint,1,opt,name=commission_calculation_id,json=commissionCalculationId,proto3" json:"commission_calculation_id,omitempty"`
+	UnapprovedBy            int64                  `protobuf:"varint,2,opt,name=unapproved_by,json=unapprovedBy,proto3" json:"unapproved_by,omitempty"`
+	Reason                  string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *UnapproveCommissionRequest) Reset() {
+	*x = UnapproveCommissionRequest{}
+	mi := &file_commissions_commision_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnapproveCommissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnapproveCommissionRequest) ProtoMessage() {}
+
+func (x *UnapproveCommissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commissions_commision_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnapproveCommissionRequest.ProtoReflect.Descriptor instead.
+func (*UnapproveCommissionRequest) Descriptor() ([]byte, []int) {
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UnapproveCommissionRequest) GetCommissionCalculationId() int64 {
+	if x != nil {
+		return x.CommissionCalculationId
+	}
+	return 0
+}
+
+func (x *UnapproveCommissionRequest) GetUnapprovedBy() int64 {
+	if x != nil {
+		return x.UnapprovedBy
+	}
+	return 0
+}
+
+func (x *UnapproveCommissionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UnapproveCommissionResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	CommissionCalculation *CommissionCalculation `protobuf:"bytes,1,opt,name=commission_calculation,json=commissionCalculation,proto3" json:"commission_calculation,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UnapproveCommissionResponse) Reset() {
+	*x = UnapproveCommissionResponse{}
+	mi := &file_commissions_commision_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnapproveCommissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnapproveCommissionResponse) ProtoMessage() {}
+
+func (x *UnapproveCommissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commissions_commision_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnapproveCommissionResponse.ProtoReflect.Descriptor instead.
+func (*UnapproveCommissionResponse) Descriptor() ([]byte, []int) {
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UnapproveCommissionResponse) GetCommissionCalculation() *CommissionCalculation {
+	if x != nil {
+		return x.CommissionCalculation
+	}
+	return nil
+}
+
+type GetCommissionHistoryRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	CommissionCalculationId int64                  `protobuf:"varint,1,opt,name=commission_calculation_id,json=commissionCalculationId,proto3" json:"commission_calculation_id,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *GetCommissionHistoryRequest) Reset() {
+	*x = GetCommissionHistoryRequest{}
+	mi := &file_commissions_commision_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommissionHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommissionHistoryRequest) ProtoMessage() {}
+
+func (x *GetCommissionHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commissions_commision_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommissionHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCommissionHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetCommissionHistoryRequest) GetCommissionCalculationId() int64 {
+	if x != nil {
+		return x.CommissionCalculationId
+	}
+	return 0
+}
+
+// Oldest transition first.
+type GetCommissionHistoryResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	History       []*CommissionStatusHistory `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommissionHistoryResponse) Reset() {
+	*x = GetCommissionHistoryResponse{}
+	mi := &file_commissions_commision_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommissionHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommissionHistoryResponse) ProtoMessage() {}
+
+func (x *GetCommissionHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commissions_commision_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommissionHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCommissionHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetCommissionHistoryResponse) GetHistory() []*CommissionStatusHistory {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
 // Commission Payment
 type PayCommissionRequest struct {
 	state                   protoimpl.MessageState `protogen:"open.v1"`
@@ -1678,13 +2384,15 @@ type PayCommissionRequest struct {
 	PaidBy                  int64                  `protobuf:"varint,4,opt,name=paid_by,json=paidBy,proto3" json:"paid_by,omitempty"`
 	Notes                   *string                `protobuf:"bytes,5,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
 	PaymentDate             *string                `protobuf:"bytes,6,opt,name=payment_date,json=paymentDate,proto3,oneof" json:"payment_date,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+	// Defaults to the remaining balance; smaller amounts are installments.
+	PaymentAmount *string `protobuf:"bytes,7,opt,name=payment_amount,json=paymentAmount,proto3,oneof" json:"payment_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PayCommissionRequest) Reset() {
 	*x = PayCommissionRequest{}
-	mi := &file_commissions_commision_service_proto_msgTypes[22]
+	mi := &file_commissions_commision_service_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1696,7 +2404,7 @@ func (x *PayCommissionRequest) String() string {
 func (*PayCommissionRequest) ProtoMessage() {}
 
 func (x *PayCommissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[22]
+	mi := &file_commissions_commision_service_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1709,7 +2417,7 @@ func (x *PayCommissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PayCommissionRequest.ProtoReflect.Descriptor instead.
 func (*PayCommissionRequest) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{22}
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *PayCommissionRequest) GetCommissionCalculationId() int64 {
@@ -1754,17 +2462,25 @@ func (x *PayCommissionRequest) GetPaymentDate() string {
 	return ""
 }
 
+func (x *PayCommissionRequest) GetPaymentAmount() string {
+	if x != nil && x.PaymentAmount != nil {
+		return *x.PaymentAmount
+	}
+	return ""
+}
+
 type PayCommissionResponse struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	CommissionPayment  *CommissionPayment     `protobuf:"bytes,1,opt,name=commission_payment,json=commissionPayment,proto3" json:"commission_payment,omitempty"`
 	UpdatedCalculation *CommissionCalculation `protobuf:"bytes,2,opt,name=updated_calculation,json=updatedCalculation,proto3" json:"updated_calculation,omitempty"`
+	RemainingBalance   string                 `protobuf:"bytes,3,opt,name=remaining_balance,json=remainingBalance,proto3" json:"remaining_balance,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
 
 func (x *PayCommissionResponse) Reset() {
 	*x = PayCommissionResponse{}
-	mi := &file_commissions_commision_service_proto_msgTypes[23]
+	mi := &file_commissions_commision_service_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1776,7 +2492,7 @@ func (x *PayCommissionResponse) String() string {
 func (*PayCommissionResponse) ProtoMessage() {}
 
 func (x *PayCommissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[23]
+	mi := &file_commissions_commision_service_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1789,7 +2505,7 @@ func (x *PayCommissionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PayCommissionResponse.ProtoReflect.Descriptor instead.
 func (*PayCommissionResponse) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{23}
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *PayCommissionResponse) GetCommissionPayment() *CommissionPayment {
@@ -1806,6 +2522,137 @@ func (x *PayCommissionResponse) GetUpdatedCalculation() *CommissionCalculation {
 	return nil
 }
 
+func (x *PayCommissionResponse) GetRemainingBalance() string {
+	if x != nil {
+		return x.RemainingBalance
+	}
+	return ""
+}
+
+// Marks one installment reversed. The calculation drops back to
+// PARTIALLY_PAID while other payments remain, or APPROVED when none do.
+type VoidCommissionPaymentRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	CommissionCalculationId int64                  `protobuf:"varint,1,opt,name=commission_calculation_id,json=commissionCalculationId,proto3" json:"commission_calculation_id,omitempty"`
+	VoidedBy                int64                  `protobuf:"varint,2,opt,name=voided_by,json=voidedBy,proto3" json:"voided_by,omitempty"`
+	Reason                  string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Installment to void; it must belong to commission_calculation_id.
+	// When 0, the calculation's most recent non-voided payment is voided.
+	CommissionPaymentId int64 `protobuf:"varint,4,opt,name=commission_payment_id,json=commissionPaymentId,proto3" json:"commission_payment_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *VoidCommissionPaymentRequest) Reset() {
+	*x = VoidCommissionPaymentRequest{}
+	mi := &file_commissions_commision_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidCommissionPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidCommissionPaymentRequest) ProtoMessage() {}
+
+func (x *VoidCommissionPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commissions_commision_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidCommissionPaymentRequest.ProtoReflect.Descriptor instead.
+func (*VoidCommissionPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *VoidCommissionPaymentRequest) GetCommissionCalculationId() int64 {
+	if x != nil {
+		return x.CommissionCalculationId
+	}
+	return 0
+}
+
+func (x *VoidCommissionPaymentRequest) GetVoidedBy() int64 {
+	if x != nil {
+		return x.VoidedBy
+	}
+	return 0
+}
+
+func (x *VoidCommissionPaymentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *VoidCommissionPaymentRequest) GetCommissionPaymentId() int64 {
+	if x != nil {
+		return x.CommissionPaymentId
+	}
+	return 0
+}
+
+type VoidCommissionPaymentResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	CommissionPayment  *CommissionPayment     `protobuf:"bytes,1,opt,name=commission_payment,json=commissionPayment,proto3" json:"commission_payment,omitempty"`
+	UpdatedCalculation *CommissionCalculation `protobuf:"bytes,2,opt,name=updated_calculation,json=updatedCalculation,proto3" json:"updated_calculation,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *VoidCommissionPaymentResponse) Reset() {
+	*x = VoidCommissionPaymentResponse{}
+	mi := &file_commissions_commision_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidCommissionPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidCommissionPaymentResponse) ProtoMessage() {}
+
+func (x *VoidCommissionPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commissions_commision_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidCommissionPaymentResponse.ProtoReflect.Descriptor instead.
+func (*VoidCommissionPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *VoidCommissionPaymentResponse) GetCommissionPayment() *CommissionPayment {
+	if x != nil {
+		return x.CommissionPayment
+	}
+	return nil
+}
+
+func (x *VoidCommissionPaymentResponse) GetUpdatedCalculation() *CommissionCalculation {
+	if x != nil {
+		return x.UpdatedCalculation
+	}
+	return nil
+}
+
 type GetCommissionPaymentRequest struct {
 	state                   protoimpl.MessageState `protogen:"open.v1"`
 	CommissionCalculationId int64                  `protobuf:"varint,1,opt,name=commission_calculation_id,json=commissionCalculationId,proto3" json:"commission_calculation_id,omitempty"`
@@ -1815,7 +2662,7 @@ type GetCommissionPaymentRequest struct {
 
 func (x *GetCommissionPaymentRequest) Reset() {
 	*x = GetCommissionPaymentRequest{}
-	mi := &file_commissions_commision_service_proto_msgTypes[24]
+	mi := &file_commissions_commision_service_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1827,7 +2674,7 @@ func (x *GetCommissionPaymentRequest) String() string {
 func (*GetCommissionPaymentRequest) ProtoMessage() {}
 
 func (x *GetCommissionPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[24]
+	mi := &file_commissions_commision_service_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1840,7 +2687,7 @@ func (x *GetCommissionPaymentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommissionPaymentRequest.ProtoReflect.Descriptor instead.
 func (*GetCommissionPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{24}
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *GetCommissionPaymentRequest) GetCommissionCalculationId() int64 {
@@ -1851,15 +2698,19 @@ func (x *GetCommissionPaymentRequest) GetCommissionCalculationId() int64 {
 }
 
 type GetCommissionPaymentResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	CommissionPayment *CommissionPayment     `protobuf:"bytes,1,opt,name=commission_payment,json=commissionPayment,proto3" json:"commission_payment,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Most recent payment, kept for existing clients.
+	CommissionPayment  *CommissionPayment   `protobuf:"bytes,1,opt,name=commission_payment,json=commissionPayment,proto3" json:"commission_payment,omitempty"`
+	CommissionPayments []*CommissionPayment `protobuf:"bytes,2,rep,name=commission_payments,json=commissionPayments,proto3" json:"commission_payments,omitempty"`
+	TotalPaid          string               `protobuf:"bytes,3,opt,name=total_paid,json=totalPaid,proto3" json:"total_paid,omitempty"`
+	RemainingBalance   string               `protobuf:"bytes,4,opt,name=remaining_balance,json=remainingBalance,proto3" json:"remaining_balance,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *GetCommissionPaymentResponse) Reset() {
 	*x = GetCommissionPaymentResponse{}
-	mi := &file_commissions_commision_service_proto_msgTypes[25]
+	mi := &file_commissions_commision_service_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1871,7 +2722,7 @@ func (x *GetCommissionPaymentResponse) String() string {
 func (*GetCommissionPaymentResponse) ProtoMessage() {}
 
 func (x *GetCommissionPaymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[25]
+	mi := &file_commissions_commision_service_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1884,7 +2735,7 @@ func (x *GetCommissionPaymentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommissionPaymentResponse.ProtoReflect.Descriptor instead.
 func (*GetCommissionPaymentResponse) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{25}
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GetCommissionPaymentResponse) GetCommissionPayment() *CommissionPayment {
@@ -1894,6 +2745,27 @@ func (x *GetCommissionPaymentResponse) GetCommissionPayment() *CommissionPayment
 	return nil
 }
 
+func (x *GetCommissionPaymentResponse) GetCommissionPayments() []*CommissionPayment {
+	if x != nil {
+		return x.CommissionPayments
+	}
+	return nil
+}
+
+func (x *GetCommissionPaymentResponse) GetTotalPaid() string {
+	if x != nil {
+		return x.TotalPaid
+	}
+	return ""
+}
+
+func (x *GetCommissionPaymentResponse) GetRemainingBalance() string {
+	if x != nil {
+		return x.RemainingBalance
+	}
+	return ""
+}
+
 // Commission Reports
 type GetCommissionSummaryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1905,7 +2777,7 @@ type GetCommissionSummaryRequest struct {
 
 func (x *GetCommissionSummaryRequest) Reset() {
 	*x = GetCommissionSummaryRequest{}
-	mi := &file_commissions_commision_service_proto_msgTypes[26]
+	mi := &file_commissions_commision_service_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1917,7 +2789,7 @@ func (x *GetCommissionSummaryRequest) String() string {
 func (*GetCommissionSummaryRequest) ProtoMessage() {}
 
 func (x *GetCommissionSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[26]
+	mi := &file_commissions_commision_service_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1930,7 +2802,7 @@ func (x *GetCommissionSummaryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommissionSummaryRequest.ProtoReflect.Descriptor instead.
 func (*GetCommissionSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{26}
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *GetCommissionSummaryRequest) GetEmployeeId() int64 {
@@ -1956,7 +2828,7 @@ type GetCommissionSummaryResponse struct {
 
 func (x *GetCommissionSummaryResponse) Reset() {
 	*x = GetCommissionSummaryResponse{}
-	mi := &file_commissions_commision_service_proto_msgTypes[27]
+	mi := &file_commissions_commision_service_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1968,7 +2840,7 @@ func (x *GetCommissionSummaryResponse) String() string {
 func (*GetCommissionSummaryResponse) ProtoMessage() {}
 
 func (x *GetCommissionSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[27]
+	mi := &file_commissions_commision_service_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1981,7 +2853,7 @@ func (x *GetCommissionSummaryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommissionSummaryResponse.ProtoReflect.Descriptor instead.
 func (*GetCommissionSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{27}
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GetCommissionSummaryResponse) GetSummary() *CommissionSummary {
@@ -2009,7 +2881,7 @@ type CommissionSummary struct {
 
 func (x *CommissionSummary) Reset() {
 	*x = CommissionSummary{}
-	mi := &file_commissions_commision_service_proto_msgTypes[28]
+	mi := &file_commissions_commision_service_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2021,7 +2893,7 @@ func (x *CommissionSummary) String() string {
 func (*CommissionSummary) ProtoMessage() {}
 
 func (x *CommissionSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[28]
+	mi := &file_commissions_commision_service_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2034,7 +2906,7 @@ func (x *CommissionSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommissionSummary.ProtoReflect.Descriptor instead.
 func (*CommissionSummary) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{28}
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *CommissionSummary) GetEmployeeId() int64 {
@@ -2119,7 +2991,7 @@ type GetCommissionReportRequest struct {
 
 func (x *GetCommissionReportRequest) Reset() {
 	*x = GetCommissionReportRequest{}
-	mi := &file_commissions_commision_service_proto_msgTypes[29]
+	mi := &file_commissions_commision_service_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2131,7 +3003,7 @@ func (x *GetCommissionReportRequest) String() string {
 func (*GetCommissionReportRequest) ProtoMessage() {}
 
 func (x *GetCommissionReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[29]
+	mi := &file_commissions_commision_service_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2144,7 +3016,7 @@ func (x *GetCommissionReportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommissionReportRequest.ProtoReflect.Descriptor instead.
 func (*GetCommissionReportRequest) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{29}
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetCommissionReportRequest) GetDateRange() *DateRange {
@@ -2180,27 +3052,324 @@ type GetCommissionReportResponse struct {
 	EmployeeSummaries          []*CommissionSummary   `protobuf:"bytes,1,rep,name=employee_summaries,json=employeeSummaries,proto3" json:"employee_summaries,omitempty"`
 	TotalCommissionsCalculated string                 `protobuf:"bytes,2,opt,name=total_commissions_calculated,json=totalCommissionsCalculated,proto3" json:"total_commissions_calculated,omitempty"`
 	TotalCommissionsPaid       string                 `protobuf:"bytes,3,opt,name=total_commissions_paid,json=totalCommissionsPaid,proto3" json:"total_commissions_paid,omitempty"`
-	TotalCommissionsPending    string                 `protobuf:"bytes,4,opt,name=total_commissions_pending,json=totalCommissionsPending,proto3" json:"total_commissions_pending,omitempty"`
-	Pagination                 *PaginationResponse    `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	unknownFields              protoimpl.UnknownFields
-	sizeCache                  protoimpl.SizeCache
+	// Unpaid balance of APPROVED and PARTIALLY_PAID calculations; DRAFT
+	// calculations are not counted.
+	TotalCommissionsPending string                   `protobuf:"bytes,4,opt,name=total_commissions_pending,json=totalCommissionsPending,proto3" json:"total_commissions_pending,omitempty"`
+	Pagination              *PaginationResponse      `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	StatusTotals            []*CommissionStatusTotal `protobuf:"bytes,6,rep,name=status_totals,json=statusTotals,proto3" json:"status_totals,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *GetCommissionReportResponse) Reset() {
+	*x = GetCommissionReportResponse{}
+	mi := &file_commissions_commision_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommissionReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommissionReportResponse) ProtoMessage() {}
+
+func (x *GetCommissionReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commissions_commision_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommissionReportResponse.ProtoReflect.Descriptor instead.
+func (*GetCommissionReportResponse) Descriptor() ([]byte, []int) {
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetCommissionReportResponse) GetEmployeeSummaries() []*CommissionSummary {
+	if x != nil {
+		return x.EmployeeSummaries
+	}
+	return nil
+}
+
+func (x *GetCommissionReportResponse) GetTotalCommissionsCalculated() string {
+	if x != nil {
+		return x.TotalCommissionsCalculated
+	}
+	return ""
+}
+
+func (x *GetCommissionReportResponse) GetTotalCommissionsPaid() string {
+	if x != nil {
+		return x.TotalCommissionsPaid
+	}
+	return ""
+}
+
+func (x *GetCommissionReportResponse) GetTotalCommissionsPending() string {
+	if x != nil {
+		return x.TotalCommissionsPending
+	}
+	return ""
+}
+
+func (x *GetCommissionReportResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetCommissionReportResponse) GetStatusTotals() []*CommissionStatusTotal {
+	if x != nil {
+		return x.StatusTotals
+	}
+	return nil
+}
+
+type CommissionStatusTotal struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Status           CommissionStatus       `protobuf:"varint,1,opt,name=status,proto3,enum=commission.CommissionStatus" json:"status,omitempty"`
+	CalculationCount int32                  `protobuf:"varint,2,opt,name=calculation_count,json=calculationCount,proto3" json:"calculation_count,omitempty"`
+	TotalCommission  string                 `protobuf:"bytes,3,opt,name=total_commission,json=totalCommission,proto3" json:"total_commission,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CommissionStatusTotal) Reset() {
+	*x = CommissionStatusTotal{}
+	mi := &file_commissions_commision_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommissionStatusTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommissionStatusTotal) ProtoMessage() {}
+
+func (x *CommissionStatusTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_commissions_commision_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommissionStatusTotal.ProtoReflect.Descriptor instead.
+func (*CommissionStatusTotal) Descriptor() ([]byte, []int) {
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CommissionStatusTotal) GetStatus() CommissionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CommissionStatus_COMMISSION_STATUS_UNSPECIFIED
+}
+
+func (x *CommissionStatusTotal) GetCalculationCount() int32 {
+	if x != nil {
+		return x.CalculationCount
+	}
+	return 0
+}
+
+func (x *CommissionStatusTotal) GetTotalCommission() string {
+	if x != nil {
+		return x.TotalCommission
+	}
+	return ""
+}
+
+// Daily snapshot of commission accrued period-to-date, written by the
+// accrual job before the period is calculated.
+type CommissionAccrual struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccrualDate       string                 `protobuf:"bytes,2,opt,name=accrual_date,json=accrualDate,proto3" json:"accrual_date,omitempty"`
+	EmployeeId        int64                  `protobuf:"varint,3,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	Period            *DateRange             `protobuf:"bytes,4,opt,name=period,proto3" json:"period,omitempty"`
+	AccruedSales      string                 `protobuf:"bytes,5,opt,name=accrued_sales,json=accruedSales,proto3" json:"accrued_sales,omitempty"`
+	AccruedCommission string                 `protobuf:"bytes,6,opt,name=accrued_commission,json=accruedCommission,proto3" json:"accrued_commission,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Employee          *EmployeeSummary       `protobuf:"bytes,8,opt,name=employee,proto3,oneof" json:"employee,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CommissionAccrual) Reset() {
+	*x = CommissionAccrual{}
+	mi := &file_commissions_commision_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommissionAccrual) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommissionAccrual) ProtoMessage() {}
+
+func (x *CommissionAccrual) ProtoReflect() protoreflect.Message {
+	mi := &file_commissions_commision_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommissionAccrual.ProtoReflect.Descriptor instead.
+func (*CommissionAccrual) Descriptor() ([]byte, []int) {
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CommissionAccrual) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CommissionAccrual) GetAccrualDate() string {
+	if x != nil {
+		return x.AccrualDate
+	}
+	return ""
+}
+
+func (x *CommissionAccrual) GetEmployeeId() int64 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *CommissionAccrual) GetPeriod() *DateRange {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *CommissionAccrual) GetAccruedSales() string {
+	if x != nil {
+		return x.AccruedSales
+	}
+	return ""
+}
+
+func (x *CommissionAccrual) GetAccruedCommission() string {
+	if x != nil {
+		return x.AccruedCommission
+	}
+	return ""
+}
+
+func (x *CommissionAccrual) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CommissionAccrual) GetEmployee() *EmployeeSummary {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+type GetCommissionAccrualRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccrualDate   string                 `protobuf:"bytes,1,opt,name=accrual_date,json=accrualDate,proto3" json:"accrual_date,omitempty"`
+	EmployeeId    *int64                 `protobuf:"varint,2,opt,name=employee_id,json=employeeId,proto3,oneof" json:"employee_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommissionAccrualRequest) Reset() {
+	*x = GetCommissionAccrualRequest{}
+	mi := &file_commissions_commision_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommissionAccrualRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommissionAccrualRequest) ProtoMessage() {}
+
+func (x *GetCommissionAccrualRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commissions_commision_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommissionAccrualRequest.ProtoReflect.Descriptor instead.
+func (*GetCommissionAccrualRequest) Descriptor() ([]byte, []int) {
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetCommissionAccrualRequest) GetAccrualDate() string {
+	if x != nil {
+		return x.AccrualDate
+	}
+	return ""
+}
+
+func (x *GetCommissionAccrualRequest) GetEmployeeId() int64 {
+	if x != nil && x.EmployeeId != nil {
+		return *x.EmployeeId
+	}
+	return 0
+}
+
+type GetCommissionAccrualResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Accruals               []*CommissionAccrual   `protobuf:"bytes,1,rep,name=accruals,proto3" json:"accruals,omitempty"`
+	TotalAccruedCommission string                 `protobuf:"bytes,2,opt,name=total_accrued_commission,json=totalAccruedCommission,proto3" json:"total_accrued_commission,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *GetCommissionReportResponse) Reset() {
-	*x = GetCommissionReportResponse{}
-	mi := &file_commissions_commision_service_proto_msgTypes[30]
+func (x *GetCommissionAccrualResponse) Reset() {
+	*x = GetCommissionAccrualResponse{}
+	mi := &file_commissions_commision_service_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommissionReportResponse) String() string {
+func (x *GetCommissionAccrualResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommissionReportResponse) ProtoMessage() {}
+func (*GetCommissionAccrualResponse) ProtoMessage() {}
 
-func (x *GetCommissionReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[30]
+func (x *GetCommissionAccrualResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commissions_commision_service_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2211,60 +3380,41 @@ func (x *GetCommissionReportResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommissionReportResponse.ProtoReflect.Descriptor instead.
-func (*GetCommissionReportResponse) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use GetCommissionAccrualResponse.ProtoReflect.Descriptor instead.
+func (*GetCommissionAccrualResponse) Descriptor() ([]byte, []int) {
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *GetCommissionReportResponse) GetEmployeeSummaries() []*CommissionSummary {
+func (x *GetCommissionAccrualResponse) GetAccruals() []*CommissionAccrual {
 	if x != nil {
-		return x.EmployeeSummaries
+		return x.Accruals
 	}
 	return nil
 }
 
-func (x *GetCommissionReportResponse) GetTotalCommissionsCalculated() string {
-	if x != nil {
-		return x.TotalCommissionsCalculated
-	}
-	return ""
-}
-
-func (x *GetCommissionReportResponse) GetTotalCommissionsPaid() string {
-	if x != nil {
-		return x.TotalCommissionsPaid
-	}
-	return ""
-}
-
-func (x *GetCommissionReportResponse) GetTotalCommissionsPending() string {
+func (x *GetCommissionAccrualResponse) GetTotalAccruedCommission() string {
 	if x != nil {
-		return x.TotalCommissionsPending
+		return x.TotalAccruedCommission
 	}
 	return ""
 }
 
-func (x *GetCommissionReportResponse) GetPagination() *PaginationResponse {
-	if x != nil {
-		return x.Pagination
-	}
-	return nil
-}
-
 // Bulk Operations
 type BulkCalculateCommissionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EmployeeIds   []int64                `protobuf:"varint,1,rep,packed,name=employee_ids,json=employeeIds,proto3" json:"employee_ids,omitempty"`
-	PeriodStart   string                 `protobuf:"bytes,2,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
-	PeriodEnd     string                 `protobuf:"bytes,3,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
-	CalculatedBy  int64                  `protobuf:"varint,4,opt,name=calculated_by,json=calculatedBy,proto3" json:"calculated_by,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeIds  []int64                `protobuf:"varint,1,rep,packed,name=employee_ids,json=employeeIds,proto3" json:"employee_ids,omitempty"`
+	PeriodStart  string                 `protobuf:"bytes,2,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd    string                 `protobuf:"bytes,3,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	CalculatedBy int64                  `protobuf:"varint,4,opt,name=calculated_by,json=calculatedBy,proto3" json:"calculated_by,omitempty"`
+	// Zone for the period boundaries; see DateRange.timezone.
+	Timezone      *string `protobuf:"bytes,5,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BulkCalculateCommissionsRequest) Reset() {
 	*x = BulkCalculateCommissionsRequest{}
-	mi := &file_commissions_commision_service_proto_msgTypes[31]
+	mi := &file_commissions_commision_service_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2276,7 +3426,7 @@ func (x *BulkCalculateCommissionsRequest) String() string {
 func (*BulkCalculateCommissionsRequest) ProtoMessage() {}
 
 func (x *BulkCalculateCommissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[31]
+	mi := &file_commissions_commision_service_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2289,7 +3439,7 @@ func (x *BulkCalculateCommissionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkCalculateCommissionsRequest.ProtoReflect.Descriptor instead.
 func (*BulkCalculateCommissionsRequest) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{31}
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *BulkCalculateCommissionsRequest) GetEmployeeIds() []int64 {
@@ -2320,6 +3470,13 @@ func (x *BulkCalculateCommissionsRequest) GetCalculatedBy() int64 {
 	return 0
 }
 
+func (x *BulkCalculateCommissionsRequest) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
 type BulkCalculateCommissionsResponse struct {
 	state         protoimpl.MessageState   `protogen:"open.v1"`
 	Calculations  []*CommissionCalculation `protobuf:"bytes,1,rep,name=calculations,proto3" json:"calculations,omitempty"`
@@ -2332,7 +3489,7 @@ type BulkCalculateCommissionsResponse struct {
 
 func (x *BulkCalculateCommissionsResponse) Reset() {
 	*x = BulkCalculateCommissionsResponse{}
-	mi := &file_commissions_commision_service_proto_msgTypes[32]
+	mi := &file_commissions_commision_service_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2344,7 +3501,7 @@ func (x *BulkCalculateCommissionsResponse) String() string {
 func (*BulkCalculateCommissionsResponse) ProtoMessage() {}
 
 func (x *BulkCalculateCommissionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[32]
+	mi := &file_commissions_commision_service_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2357,7 +3514,7 @@ func (x *BulkCalculateCommissionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkCalculateCommissionsResponse.ProtoReflect.Descriptor instead.
 func (*BulkCalculateCommissionsResponse) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{32}
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *BulkCalculateCommissionsResponse) GetCalculations() []*CommissionCalculation {
@@ -2399,7 +3556,7 @@ type BulkApproveCommissionsRequest struct {
 
 func (x *BulkApproveCommissionsRequest) Reset() {
 	*x = BulkApproveCommissionsRequest{}
-	mi := &file_commissions_commision_service_proto_msgTypes[33]
+	mi := &file_commissions_commision_service_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2411,7 +3568,7 @@ func (x *BulkApproveCommissionsRequest) String() string {
 func (*BulkApproveCommissionsRequest) ProtoMessage() {}
 
 func (x *BulkApproveCommissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[33]
+	mi := &file_commissions_commision_service_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2424,7 +3581,7 @@ func (x *BulkApproveCommissionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkApproveCommissionsRequest.ProtoReflect.Descriptor instead.
 func (*BulkApproveCommissionsRequest) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{33}
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *BulkApproveCommissionsRequest) GetCommissionCalculationIds() []int64 {
@@ -2460,7 +3617,7 @@ type BulkApproveCommissionsResponse struct {
 
 func (x *BulkApproveCommissionsResponse) Reset() {
 	*x = BulkApproveCommissionsResponse{}
-	mi := &file_commissions_commision_service_proto_msgTypes[34]
+	mi := &file_commissions_commision_service_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2472,7 +3629,7 @@ func (x *BulkApproveCommissionsResponse) String() string {
 func (*BulkApproveCommissionsResponse) ProtoMessage() {}
 
 func (x *BulkApproveCommissionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[34]
+	mi := &file_commissions_commision_service_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2485,7 +3642,7 @@ func (x *BulkApproveCommissionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkApproveCommissionsResponse.ProtoReflect.Descriptor instead.
 func (*BulkApproveCommissionsResponse) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{34}
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *BulkApproveCommissionsResponse) GetApprovedCalculations() []*CommissionCalculation {
@@ -2526,7 +3683,7 @@ type GetCommissionSettingsRequest struct {
 
 func (x *GetCommissionSettingsRequest) Reset() {
 	*x = GetCommissionSettingsRequest{}
-	mi := &file_commissions_commision_service_proto_msgTypes[35]
+	mi := &file_commissions_commision_service_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2538,7 +3695,7 @@ func (x *GetCommissionSettingsRequest) String() string {
 func (*GetCommissionSettingsRequest) ProtoMessage() {}
 
 func (x *GetCommissionSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[35]
+	mi := &file_commissions_commision_service_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2551,7 +3708,7 @@ func (x *GetCommissionSettingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommissionSettingsRequest.ProtoReflect.Descriptor instead.
 func (*GetCommissionSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{35}
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *GetCommissionSettingsRequest) GetEmployeeId() int64 {
@@ -2571,7 +3728,7 @@ type GetCommissionSettingsResponse struct {
 
 func (x *GetCommissionSettingsResponse) Reset() {
 	*x = GetCommissionSettingsResponse{}
-	mi := &file_commissions_commision_service_proto_msgTypes[36]
+	mi := &file_commissions_commision_service_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2583,7 +3740,7 @@ func (x *GetCommissionSettingsResponse) String() string {
 func (*GetCommissionSettingsResponse) ProtoMessage() {}
 
 func (x *GetCommissionSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[36]
+	mi := &file_commissions_commision_service_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2596,7 +3753,7 @@ func (x *GetCommissionSettingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommissionSettingsResponse.ProtoReflect.Descriptor instead.
 func (*GetCommissionSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{36}
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *GetCommissionSettingsResponse) GetEmployee() *EmployeeSummary {
@@ -2625,7 +3782,7 @@ type CommissionTierSetting struct {
 
 func (x *CommissionTierSetting) Reset() {
 	*x = CommissionTierSetting{}
-	mi := &file_commissions_commision_service_proto_msgTypes[37]
+	mi := &file_commissions_commision_service_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2637,7 +3794,7 @@ func (x *CommissionTierSetting) String() string {
 func (*CommissionTierSetting) ProtoMessage() {}
 
 func (x *CommissionTierSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_commissions_commision_service_proto_msgTypes[37]
+	mi := &file_commissions_commision_service_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2650,7 +3807,7 @@ func (x *CommissionTierSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommissionTierSetting.ProtoReflect.Descriptor instead.
 func (*CommissionTierSetting) Descriptor() ([]byte, []int) {
-	return file_commissions_commision_service_proto_rawDescGZIP(), []int{37}
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *CommissionTierSetting) GetId() int32 {
@@ -2681,6 +3838,268 @@ func (x *CommissionTierSetting) GetCommissionRate() string {
 	return ""
 }
 
+type CommissionBonusRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Unset applies the rule to every employee.
+	EmployeeId      *int64        `protobuf:"varint,2,opt,name=employee_id,json=employeeId,proto3,oneof" json:"employee_id,omitempty"`
+	RuleName        string        `protobuf:"bytes,3,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	RuleType        BonusRuleType `protobuf:"varint,4,opt,name=rule_type,json=ruleType,proto3,enum=commission.BonusRuleType" json:"rule_type,omitempty"`
+	ThresholdAmount string        `protobuf:"bytes,5,opt,name=threshold_amount,json=thresholdAmount,proto3" json:"threshold_amount,omitempty"`
+	// Matches rule_type: bonus_amount for FLAT_ABOVE_THRESHOLD, bonus_rate
+	// for RATE_ABOVE_THRESHOLD.
+	//
+	// Types that are valid to be assigned to Bonus:
+	//
+	//	*CommissionBonusRule_BonusAmount
+	//	*CommissionBonusRule_BonusRate
+	Bonus         isCommissionBonusRule_Bonus `protobuf_oneof:"bonus"`
+	IsActive      bool                        `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt     *timestamppb.Timestamp      `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp      `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommissionBonusRule) Reset() {
+	*x = CommissionBonusRule{}
+	mi := &file_commissions_commision_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommissionBonusRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommissionBonusRule) ProtoMessage() {}
+
+func (x *CommissionBonusRule) ProtoReflect() protoreflect.Message {
+	mi := &file_commissions_commision_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommissionBonusRule.ProtoReflect.Descriptor instead.
+func (*CommissionBonusRule) Descriptor() ([]byte, []int) {
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CommissionBonusRule) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CommissionBonusRule) GetEmployeeId() int64 {
+	if x != nil && x.EmployeeId != nil {
+		return *x.EmployeeId
+	}
+	return 0
+}
+
+func (x *CommissionBonusRule) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *CommissionBonusRule) GetRuleType() BonusRuleType {
+	if x != nil {
+		return x.RuleType
+	}
+	return BonusRuleType_BONUS_RULE_TYPE_UNSPECIFIED
+}
+
+func (x *CommissionBonusRule) GetThresholdAmount() string {
+	if x != nil {
+		return x.ThresholdAmount
+	}
+	return ""
+}
+
+func (x *CommissionBonusRule) GetBonus() isCommissionBonusRule_Bonus {
+	if x != nil {
+		return x.Bonus
+	}
+	return nil
+}
+
+func (x *CommissionBonusRule) GetBonusAmount() string {
+	if x != nil {
+		if x, ok := x.Bonus.(*CommissionBonusRule_BonusAmount); ok {
+			return x.BonusAmount
+		}
+	}
+	return ""
+}
+
+func (x *CommissionBonusRule) GetBonusRate() string {
+	if x != nil {
+		if x, ok := x.Bonus.(*CommissionBonusRule_BonusRate); ok {
+			return x.BonusRate
+		}
+	}
+	return ""
+}
+
+func (x *CommissionBonusRule) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *CommissionBonusRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CommissionBonusRule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type isCommissionBonusRule_Bonus interface {
+	isCommissionBonusRule_Bonus()
+}
+
+type CommissionBonusRule_BonusAmount struct {
+	BonusAmount string `protobuf:"bytes,6,opt,name=bonus_amount,json=bonusAmount,proto3,oneof"`
+}
+
+type CommissionBonusRule_BonusRate struct {
+	BonusRate string `protobuf:"bytes,7,opt,name=bonus_rate,json=bonusRate,proto3,oneof"`
+}
+
+func (*CommissionBonusRule_BonusAmount) isCommissionBonusRule_Bonus() {}
+
+func (*CommissionBonusRule_BonusRate) isCommissionBonusRule_Bonus() {}
+
+type ListCommissionBonusRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId    *int64                 `protobuf:"varint,1,opt,name=employee_id,json=employeeId,proto3,oneof" json:"employee_id,omitempty"`
+	IsActive      *bool                  `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	Pagination    *PaginationRequest     `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommissionBonusRulesRequest) Reset() {
+	*x = ListCommissionBonusRulesRequest{}
+	mi := &file_commissions_commision_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommissionBonusRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommissionBonusRulesRequest) ProtoMessage() {}
+
+func (x *ListCommissionBonusRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_commissions_commision_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommissionBonusRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListCommissionBonusRulesRequest) Descriptor() ([]byte, []int) {
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListCommissionBonusRulesRequest) GetEmployeeId() int64 {
+	if x != nil && x.EmployeeId != nil {
+		return *x.EmployeeId
+	}
+	return 0
+}
+
+func (x *ListCommissionBonusRulesRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *ListCommissionBonusRulesRequest) GetPagination() *PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListCommissionBonusRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BonusRules    []*CommissionBonusRule `protobuf:"bytes,1,rep,name=bonus_rules,json=bonusRules,proto3" json:"bonus_rules,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommissionBonusRulesResponse) Reset() {
+	*x = ListCommissionBonusRulesResponse{}
+	mi := &file_commissions_commision_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommissionBonusRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommissionBonusRulesResponse) ProtoMessage() {}
+
+func (x *ListCommissionBonusRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_commissions_commision_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommissionBonusRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListCommissionBonusRulesResponse) Descriptor() ([]byte, []int) {
+	return file_commissions_commision_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListCommissionBonusRulesResponse) GetBonusRules() []*CommissionBonusRule {
+	if x != nil {
+		return x.BonusRules
+	}
+	return nil
+}
+
+func (x *ListCommissionBonusRulesResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
 var File_commissions_commision_service_proto protoreflect.FileDescriptor
 
 const file_commissions_commision_service_proto_rawDesc = "" +
@@ -2694,11 +4113,13 @@ const file_commissions_commision_service_proto_rawDesc = "" +
 	"\x12PaginationResponse\x12&\n" +
 	"\x0fnext_page_token\x18\x01 \x01(\tR\rnextPageToken\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"E\n" +
+	"totalCount\"s\n" +
 	"\tDateRange\x12\x1d\n" +
 	"\n" +
 	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
-	"\bend_date\x18\x02 \x01(\tR\aendDate\"\x86\a\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12\x1f\n" +
+	"\btimezone\x18\x03 \x01(\tH\x00R\btimezone\x88\x01\x01B\v\n" +
+	"\t_timezone\"\xea\b\n" +
 	"\x15CommissionCalculation\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
 	"\vemployee_id\x18\x02 \x01(\x03R\n" +
@@ -2722,7 +4143,13 @@ const file_commissions_commision_service_proto_rawDesc = "" +
 	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12K\n" +
 	"\x12commission_details\x18\x0f \x03(\v2\x1c.commission.CommissionDetailR\x11commissionDetails\x12Q\n" +
 	"\x12commission_payment\x18\x10 \x01(\v2\x1d.commission.CommissionPaymentH\x02R\x11commissionPayment\x88\x01\x01\x12<\n" +
-	"\bemployee\x18\x11 \x01(\v2\x1b.commission.EmployeeSummaryH\x03R\bemployee\x88\x01\x01B\x0e\n" +
+	"\bemployee\x18\x11 \x01(\v2\x1b.commission.EmployeeSummaryH\x03R\bemployee\x88\x01\x01\x12*\n" +
+	"\x11product_group_ids\x18\x12 \x03(\x05R\x0fproductGroupIds\x12\x1a\n" +
+	"\bcurrency\x18\x13 \x01(\tR\bcurrency\x12N\n" +
+	"\x13commission_payments\x18\x14 \x03(\v2\x1d.commission.CommissionPaymentR\x12commissionPayments\x12\x1d\n" +
+	"\n" +
+	"total_paid\x18\x15 \x01(\tR\ttotalPaid\x12+\n" +
+	"\x11remaining_balance\x18\x16 \x01(\tR\x10remainingBalanceB\x0e\n" +
 	"\f_approved_byB\b\n" +
 	"\x06_notesB\x15\n" +
 	"\x13_commission_paymentB\v\n" +
@@ -2742,7 +4169,7 @@ const file_commissions_commision_service_proto_rawDesc = "" +
 	"\x15order_document_number\x18\n" +
 	" \x01(\tH\x01R\x13orderDocumentNumber\x88\x01\x01B\x0f\n" +
 	"\r_product_nameB\x18\n" +
-	"\x16_order_document_number\"\x89\x04\n" +
+	"\x16_order_document_number\"\xd8\x05\n" +
 	"\x11CommissionPayment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12:\n" +
 	"\x19commission_calculation_id\x18\x02 \x01(\x03R\x17commissionCalculationId\x12\x1f\n" +
@@ -2757,10 +4184,31 @@ const file_commissions_commision_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12F\n" +
-	"\fpayment_type\x18\v \x01(\v2\x1e.commission.PaymentTypeSummaryH\x02R\vpaymentType\x88\x01\x01B\x13\n" +
+	"\fpayment_type\x18\v \x01(\v2\x1e.commission.PaymentTypeSummaryH\x02R\vpaymentType\x88\x01\x01\x12\x1b\n" +
+	"\tis_voided\x18\f \x01(\bR\bisVoided\x12 \n" +
+	"\tvoided_by\x18\r \x01(\x03H\x03R\bvoidedBy\x88\x01\x01\x12$\n" +
+	"\vvoid_reason\x18\x0e \x01(\tH\x04R\n" +
+	"voidReason\x88\x01\x01\x12<\n" +
+	"\tvoided_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampH\x05R\bvoidedAt\x88\x01\x01B\x13\n" +
 	"\x11_reference_numberB\b\n" +
 	"\x06_notesB\x0f\n" +
-	"\r_payment_type\"\xe2\x01\n" +
+	"\r_payment_typeB\f\n" +
+	"\n" +
+	"_voided_byB\x0e\n" +
+	"\f_void_reasonB\f\n" +
+	"\n" +
+	"_voided_at\"\xdd\x02\n" +
+	"\x17CommissionStatusHistory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12:\n" +
+	"\x19commission_calculation_id\x18\x02 \x01(\x03R\x17commissionCalculationId\x12=\n" +
+	"\vfrom_status\x18\x03 \x01(\x0e2\x1c.commission.CommissionStatusR\n" +
+	"fromStatus\x129\n" +
+	"\tto_status\x18\x04 \x01(\x0e2\x1c.commission.CommissionStatusR\btoStatus\x12\x19\n" +
+	"\bactor_id\x18\x05 \x01(\x03R\aactorId\x12\x1b\n" +
+	"\x06reason\x18\x06 \x01(\tH\x00R\x06reason\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\t\n" +
+	"\a_reason\"\xe2\x01\n" +
 	"\x0fEmployeeSummary\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12#\n" +
 	"\remployee_name\x18\x02 \x01(\tR\femployeeName\x12\x1f\n" +
@@ -2786,7 +4234,7 @@ const file_commissions_commision_service_proto_rawDesc = "" +
 	"\x0ftier_max_amount\x18\x02 \x01(\tR\rtierMaxAmount\x12\x1b\n" +
 	"\ttier_rate\x18\x03 \x01(\tR\btierRate\x12*\n" +
 	"\x11tier_sales_amount\x18\x04 \x01(\tR\x0ftierSalesAmount\x12'\n" +
-	"\x0ftier_commission\x18\x05 \x01(\tR\x0etierCommission\"\xe9\x01\n" +
+	"\x0ftier_commission\x18\x05 \x01(\tR\x0etierCommission\"\xc3\x02\n" +
 	"\x1aCalculateCommissionRequest\x12\x1f\n" +
 	"\vemployee_id\x18\x01 \x01(\x03R\n" +
 	"employeeId\x12!\n" +
@@ -2794,13 +4242,29 @@ const file_commissions_commision_service_proto_rawDesc = "" +
 	"\n" +
 	"period_end\x18\x03 \x01(\tR\tperiodEnd\x12#\n" +
 	"\rcalculated_by\x18\x04 \x01(\x03R\fcalculatedBy\x12.\n" +
-	"\x10save_calculation\x18\x05 \x01(\bH\x00R\x0fsaveCalculation\x88\x01\x01B\x13\n" +
-	"\x11_save_calculation\"\xd5\x01\n" +
+	"\x10save_calculation\x18\x05 \x01(\bH\x00R\x0fsaveCalculation\x88\x01\x01\x12*\n" +
+	"\x11product_group_ids\x18\x06 \x03(\x05R\x0fproductGroupIds\x12\x1f\n" +
+	"\btimezone\x18\a \x01(\tH\x01R\btimezone\x88\x01\x01B\x13\n" +
+	"\x11_save_calculationB\v\n" +
+	"\t_timezone\"\xf1\x01\n" +
 	"\x1bCalculateCommissionResponse\x12X\n" +
 	"\x16commission_calculation\x18\x01 \x01(\v2!.commission.CommissionCalculationR\x15commissionCalculation\x12=\n" +
 	"\tbreakdown\x18\x02 \x01(\v2\x1f.commission.CommissionBreakdownR\tbreakdown\x12\x1d\n" +
 	"\n" +
-	"is_preview\x18\x03 \x01(\bR\tisPreview\"\xa8\x01\n" +
+	"is_preview\x18\x03 \x01(\bR\tisPreview\x12\x1a\n" +
+	"\bwarnings\x18\x04 \x03(\tR\bwarnings\"m\n" +
+	"\x1cGetCurrentPeriodSalesRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\x03R\n" +
+	"employeeId\x12\x1f\n" +
+	"\btimezone\x18\x02 \x01(\tH\x00R\btimezone\x88\x01\x01B\v\n" +
+	"\t_timezone\"\x94\x02\n" +
+	"\x1dGetCurrentPeriodSalesResponse\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\x03R\n" +
+	"employeeId\x12-\n" +
+	"\x06period\x18\x02 \x01(\v2\x15.commission.DateRangeR\x06period\x121\n" +
+	"\x14commissionable_sales\x18\x03 \x01(\tR\x13commissionableSales\x121\n" +
+	"\x14projected_commission\x18\x04 \x01(\tR\x13projectedCommission\x12=\n" +
+	"\tbreakdown\x18\x05 \x01(\v2\x1f.commission.CommissionBreakdownR\tbreakdown\"\xa8\x01\n" +
 	"\x1cRecalculateCommissionRequest\x12:\n" +
 	"\x19commission_calculation_id\x18\x01 \x01(\x03R\x17commissionCalculationId\x12'\n" +
 	"\x0frecalculated_by\x18\x02 \x01(\x03R\x0erecalculatedBy\x12\x19\n" +
@@ -2812,7 +4276,7 @@ const file_commissions_commision_service_proto_rawDesc = "" +
 	"\x1fGetCommissionCalculationRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"|\n" +
 	" GetCommissionCalculationResponse\x12X\n" +
-	"\x16commission_calculation\x18\x01 \x01(\v2!.commission.CommissionCalculationR\x15commissionCalculation\"\xc0\x02\n" +
+	"\x16commission_calculation\x18\x01 \x01(\v2!.commission.CommissionCalculationR\x15commissionCalculation\"\x82\x03\n" +
 	"!ListCommissionCalculationsRequest\x12=\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x1d.commission.PaginationRequestR\n" +
@@ -2820,14 +4284,29 @@ const file_commissions_commision_service_proto_rawDesc = "" +
 	"\vemployee_id\x18\x02 \x01(\x03H\x00R\n" +
 	"employeeId\x88\x01\x01\x129\n" +
 	"\x06status\x18\x03 \x01(\x0e2\x1c.commission.CommissionStatusH\x01R\x06status\x88\x01\x01\x12I\n" +
-	"\x12calculation_period\x18\x04 \x01(\v2\x15.commission.DateRangeH\x02R\x11calculationPeriod\x88\x01\x01B\x0e\n" +
+	"\x12calculation_period\x18\x04 \x01(\v2\x15.commission.DateRangeH\x02R\x11calculationPeriod\x88\x01\x01\x12,\n" +
+	"\x0finclude_details\x18\x05 \x01(\bH\x03R\x0eincludeDetails\x88\x01\x01B\x0e\n" +
 	"\f_employee_idB\t\n" +
 	"\a_statusB\x15\n" +
-	"\x13_calculation_period\"\xc0\x01\n" +
+	"\x13_calculation_periodB\x12\n" +
+	"\x10_include_details\"\xc0\x01\n" +
 	"\"ListCommissionCalculationsResponse\x12Z\n" +
 	"\x17commission_calculations\x18\x01 \x03(\v2!.commission.CommissionCalculationR\x16commissionCalculations\x12>\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x1e.commission.PaginationResponseR\n" +
+	"pagination\"\xcc\x01\n" +
+	"\x1cListCommissionDetailsRequest\x12:\n" +
+	"\x19commission_calculation_id\x18\x01 \x01(\x03R\x17commissionCalculationId\x12=\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1d.commission.PaginationRequestR\n" +
+	"pagination\x12\"\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\x05H\x00R\tproductId\x88\x01\x01B\r\n" +
+	"\v_product_id\"\xac\x01\n" +
+	"\x1dListCommissionDetailsResponse\x12K\n" +
+	"\x12commission_details\x18\x01 \x03(\v2\x1c.commission.CommissionDetailR\x11commissionDetails\x12>\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1e.commission.PaginationResponseR\n" +
 	"pagination\"\xb6\x01\n" +
 	"\x18ApproveCommissionRequest\x12:\n" +
 	"\x19commission_calculation_id\x18\x01 \x01(\x03R\x17commissionCalculationId\x12\x1f\n" +
@@ -2843,24 +4322,49 @@ const file_commissions_commision_service_proto_rawDesc = "" +
 	"rejectedBy\x12)\n" +
 	"\x10rejection_reason\x18\x03 \x01(\tR\x0frejectionReason\"t\n" +
 	"\x18RejectCommissionResponse\x12X\n" +
-	"\x16commission_calculation\x18\x01 \x01(\v2!.commission.CommissionCalculationR\x15commissionCalculation\"\xb6\x02\n" +
+	"\x16commission_calculation\x18\x01 \x01(\v2!.commission.CommissionCalculationR\x15commissionCalculation\"\x95\x01\n" +
+	"\x1aUnapproveCommissionRequest\x12:\n" +
+	"\x19commission_calculation_id\x18\x01 \x01(\x03R\x17commissionCalculationId\x12#\n" +
+	"\runapproved_by\x18\x02 \x01(\x03R\funapprovedBy\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"w\n" +
+	"\x1bUnapproveCommissionResponse\x12X\n" +
+	"\x16commission_calculation\x18\x01 \x01(\v2!.commission.CommissionCalculationR\x15commissionCalculation\"Y\n" +
+	"\x1bGetCommissionHistoryRequest\x12:\n" +
+	"\x19commission_calculation_id\x18\x01 \x01(\x03R\x17commissionCalculationId\"]\n" +
+	"\x1cGetCommissionHistoryResponse\x12=\n" +
+	"\ahistory\x18\x01 \x03(\v2#.commission.CommissionStatusHistoryR\ahistory\"\xf5\x02\n" +
 	"\x14PayCommissionRequest\x12:\n" +
 	"\x19commission_calculation_id\x18\x01 \x01(\x03R\x17commissionCalculationId\x12&\n" +
 	"\x0fpayment_type_id\x18\x02 \x01(\x05R\rpaymentTypeId\x12.\n" +
 	"\x10reference_number\x18\x03 \x01(\tH\x00R\x0freferenceNumber\x88\x01\x01\x12\x17\n" +
 	"\apaid_by\x18\x04 \x01(\x03R\x06paidBy\x12\x19\n" +
 	"\x05notes\x18\x05 \x01(\tH\x01R\x05notes\x88\x01\x01\x12&\n" +
-	"\fpayment_date\x18\x06 \x01(\tH\x02R\vpaymentDate\x88\x01\x01B\x13\n" +
+	"\fpayment_date\x18\x06 \x01(\tH\x02R\vpaymentDate\x88\x01\x01\x12*\n" +
+	"\x0epayment_amount\x18\a \x01(\tH\x03R\rpaymentAmount\x88\x01\x01B\x13\n" +
 	"\x11_reference_numberB\b\n" +
 	"\x06_notesB\x0f\n" +
-	"\r_payment_date\"\xb9\x01\n" +
+	"\r_payment_dateB\x11\n" +
+	"\x0f_payment_amount\"\xe6\x01\n" +
 	"\x15PayCommissionResponse\x12L\n" +
 	"\x12commission_payment\x18\x01 \x01(\v2\x1d.commission.CommissionPaymentR\x11commissionPayment\x12R\n" +
+	"\x13updated_calculation\x18\x02 \x01(\v2!.commission.CommissionCalculationR\x12updatedCalculation\x12+\n" +
+	"\x11remaining_balance\x18\x03 \x01(\tR\x10remainingBalance\"\xc3\x01\n" +
+	"\x1cVoidCommissionPaymentRequest\x12:\n" +
+	"\x19commission_calculation_id\x18\x01 \x01(\x03R\x17commissionCalculationId\x12\x1b\n" +
+	"\tvoided_by\x18\x02 \x01(\x03R\bvoidedBy\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x122\n" +
+	"\x15commission_payment_id\x18\x04 \x01(\x03R\x13commissionPaymentId\"\xc1\x01\n" +
+	"\x1dVoidCommissionPaymentResponse\x12L\n" +
+	"\x12commission_payment\x18\x01 \x01(\v2\x1d.commission.CommissionPaymentR\x11commissionPayment\x12R\n" +
 	"\x13updated_calculation\x18\x02 \x01(\v2!.commission.CommissionCalculationR\x12updatedCalculation\"Y\n" +
 	"\x1bGetCommissionPaymentRequest\x12:\n" +
-	"\x19commission_calculation_id\x18\x01 \x01(\x03R\x17commissionCalculationId\"l\n" +
+	"\x19commission_calculation_id\x18\x01 \x01(\x03R\x17commissionCalculationId\"\x88\x02\n" +
 	"\x1cGetCommissionPaymentResponse\x12L\n" +
-	"\x12commission_payment\x18\x01 \x01(\v2\x1d.commission.CommissionPaymentR\x11commissionPayment\"t\n" +
+	"\x12commission_payment\x18\x01 \x01(\v2\x1d.commission.CommissionPaymentR\x11commissionPayment\x12N\n" +
+	"\x13commission_payments\x18\x02 \x03(\v2\x1d.commission.CommissionPaymentR\x12commissionPayments\x12\x1d\n" +
+	"\n" +
+	"total_paid\x18\x03 \x01(\tR\ttotalPaid\x12+\n" +
+	"\x11remaining_balance\x18\x04 \x01(\tR\x10remainingBalance\"t\n" +
 	"\x1bGetCommissionSummaryRequest\x12\x1f\n" +
 	"\vemployee_id\x18\x01 \x01(\x03R\n" +
 	"employeeId\x124\n" +
@@ -2892,7 +4396,7 @@ const file_commissions_commision_service_proto_rawDesc = "" +
 	"pagination\x18\x04 \x01(\v2\x1d.commission.PaginationRequestR\n" +
 	"paginationB\x0e\n" +
 	"\f_employee_idB\t\n" +
-	"\a_status\"\xdf\x02\n" +
+	"\a_status\"\xa7\x03\n" +
 	"\x1bGetCommissionReportResponse\x12L\n" +
 	"\x12employee_summaries\x18\x01 \x03(\v2\x1d.commission.CommissionSummaryR\x11employeeSummaries\x12@\n" +
 	"\x1ctotal_commissions_calculated\x18\x02 \x01(\tR\x1atotalCommissionsCalculated\x124\n" +
@@ -2900,13 +4404,40 @@ const file_commissions_commision_service_proto_rawDesc = "" +
 	"\x19total_commissions_pending\x18\x04 \x01(\tR\x17totalCommissionsPending\x12>\n" +
 	"\n" +
 	"pagination\x18\x05 \x01(\v2\x1e.commission.PaginationResponseR\n" +
-	"pagination\"\xab\x01\n" +
+	"pagination\x12F\n" +
+	"\rstatus_totals\x18\x06 \x03(\v2!.commission.CommissionStatusTotalR\fstatusTotals\"\xa5\x01\n" +
+	"\x15CommissionStatusTotal\x124\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1c.commission.CommissionStatusR\x06status\x12+\n" +
+	"\x11calculation_count\x18\x02 \x01(\x05R\x10calculationCount\x12)\n" +
+	"\x10total_commission\x18\x03 \x01(\tR\x0ftotalCommission\"\xf0\x02\n" +
+	"\x11CommissionAccrual\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\faccrual_date\x18\x02 \x01(\tR\vaccrualDate\x12\x1f\n" +
+	"\vemployee_id\x18\x03 \x01(\x03R\n" +
+	"employeeId\x12-\n" +
+	"\x06period\x18\x04 \x01(\v2\x15.commission.DateRangeR\x06period\x12#\n" +
+	"\raccrued_sales\x18\x05 \x01(\tR\faccruedSales\x12-\n" +
+	"\x12accrued_commission\x18\x06 \x01(\tR\x11accruedCommission\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\bemployee\x18\b \x01(\v2\x1b.commission.EmployeeSummaryH\x00R\bemployee\x88\x01\x01B\v\n" +
+	"\t_employee\"v\n" +
+	"\x1bGetCommissionAccrualRequest\x12!\n" +
+	"\faccrual_date\x18\x01 \x01(\tR\vaccrualDate\x12$\n" +
+	"\vemployee_id\x18\x02 \x01(\x03H\x00R\n" +
+	"employeeId\x88\x01\x01B\x0e\n" +
+	"\f_employee_id\"\x93\x01\n" +
+	"\x1cGetCommissionAccrualResponse\x129\n" +
+	"\baccruals\x18\x01 \x03(\v2\x1d.commission.CommissionAccrualR\baccruals\x128\n" +
+	"\x18total_accrued_commission\x18\x02 \x01(\tR\x16totalAccruedCommission\"\xd9\x01\n" +
 	"\x1fBulkCalculateCommissionsRequest\x12!\n" +
 	"\femployee_ids\x18\x01 \x03(\x03R\vemployeeIds\x12!\n" +
 	"\fperiod_start\x18\x02 \x01(\tR\vperiodStart\x12\x1d\n" +
 	"\n" +
 	"period_end\x18\x03 \x01(\tR\tperiodEnd\x12#\n" +
-	"\rcalculated_by\x18\x04 \x01(\x03R\fcalculatedBy\"\xc7\x01\n" +
+	"\rcalculated_by\x18\x04 \x01(\x03R\fcalculatedBy\x12\x1f\n" +
+	"\btimezone\x18\x05 \x01(\tH\x00R\btimezone\x88\x01\x01B\v\n" +
+	"\t_timezone\"\xc7\x01\n" +
 	" BulkCalculateCommissionsResponse\x12E\n" +
 	"\fcalculations\x18\x01 \x03(\v2!.commission.CommissionCalculationR\fcalculations\x12\x16\n" +
 	"\x06errors\x18\x02 \x03(\tR\x06errors\x12#\n" +
@@ -2936,32 +4467,78 @@ const file_commissions_commision_service_proto_rawDesc = "" +
 	"\x10min_sales_amount\x18\x02 \x01(\tR\x0eminSalesAmount\x12-\n" +
 	"\x10max_sales_amount\x18\x03 \x01(\tH\x00R\x0emaxSalesAmount\x88\x01\x01\x12'\n" +
 	"\x0fcommission_rate\x18\x04 \x01(\tR\x0ecommissionRateB\x13\n" +
-	"\x11_max_sales_amount*\x8f\x01\n" +
+	"\x11_max_sales_amount\"\xbd\x03\n" +
+	"\x13CommissionBonusRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12$\n" +
+	"\vemployee_id\x18\x02 \x01(\x03H\x01R\n" +
+	"employeeId\x88\x01\x01\x12\x1b\n" +
+	"\trule_name\x18\x03 \x01(\tR\bruleName\x126\n" +
+	"\trule_type\x18\x04 \x01(\x0e2\x19.commission.BonusRuleTypeR\bruleType\x12)\n" +
+	"\x10threshold_amount\x18\x05 \x01(\tR\x0fthresholdAmount\x12#\n" +
+	"\fbonus_amount\x18\x06 \x01(\tH\x00R\vbonusAmount\x12\x1f\n" +
+	"\n" +
+	"bonus_rate\x18\a \x01(\tH\x00R\tbonusRate\x12\x1b\n" +
+	"\tis_active\x18\b \x01(\bR\bisActive\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\a\n" +
+	"\x05bonusB\x0e\n" +
+	"\f_employee_id\"\xc6\x01\n" +
+	"\x1fListCommissionBonusRulesRequest\x12$\n" +
+	"\vemployee_id\x18\x01 \x01(\x03H\x00R\n" +
+	"employeeId\x88\x01\x01\x12 \n" +
+	"\tis_active\x18\x02 \x01(\bH\x01R\bisActive\x88\x01\x01\x12=\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x1d.commission.PaginationRequestR\n" +
+	"paginationB\x0e\n" +
+	"\f_employee_idB\f\n" +
+	"\n" +
+	"_is_active\"\xa4\x01\n" +
+	" ListCommissionBonusRulesResponse\x12@\n" +
+	"\vbonus_rules\x18\x01 \x03(\v2\x1f.commission.CommissionBonusRuleR\n" +
+	"bonusRules\x12>\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1e.commission.PaginationResponseR\n" +
+	"pagination*\x8f\x01\n" +
 	"\x0eCommissionType\x12\x1f\n" +
 	"\x1bCOMMISSION_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n" +
 	"\x1aCOMMISSION_TYPE_PERCENTAGE\x10\x01\x12 \n" +
 	"\x1cCOMMISSION_TYPE_FIXED_AMOUNT\x10\x02\x12\x1a\n" +
-	"\x16COMMISSION_TYPE_TIERED\x10\x03*\xb0\x01\n" +
+	"\x16COMMISSION_TYPE_TIERED\x10\x03*\xd6\x01\n" +
 	"\x10CommissionStatus\x12!\n" +
 	"\x1dCOMMISSION_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
 	"\x17COMMISSION_STATUS_DRAFT\x10\x01\x12 \n" +
 	"\x1cCOMMISSION_STATUS_CALCULATED\x10\x02\x12\x1e\n" +
 	"\x1aCOMMISSION_STATUS_APPROVED\x10\x03\x12\x1a\n" +
-	"\x16COMMISSION_STATUS_PAID\x10\x042\x88\v\n" +
+	"\x16COMMISSION_STATUS_PAID\x10\x04\x12$\n" +
+	" COMMISSION_STATUS_PARTIALLY_PAID\x10\x05*\x84\x01\n" +
+	"\rBonusRuleType\x12\x1f\n" +
+	"\x1bBONUS_RULE_TYPE_UNSPECIFIED\x10\x00\x12(\n" +
+	"$BONUS_RULE_TYPE_FLAT_ABOVE_THRESHOLD\x10\x01\x12(\n" +
+	"$BONUS_RULE_TYPE_RATE_ABOVE_THRESHOLD\x10\x022\x87\x11\n" +
 	"\x11CommissionService\x12f\n" +
 	"\x13CalculateCommission\x12&.commission.CalculateCommissionRequest\x1a'.commission.CalculateCommissionResponse\x12l\n" +
 	"\x15RecalculateCommission\x12(.commission.RecalculateCommissionRequest\x1a).commission.RecalculateCommissionResponse\x12u\n" +
-	"\x18BulkCalculateCommissions\x12+.commission.BulkCalculateCommissionsRequest\x1a,.commission.BulkCalculateCommissionsResponse\x12u\n" +
+	"\x18BulkCalculateCommissions\x12+.commission.BulkCalculateCommissionsRequest\x1a,.commission.BulkCalculateCommissionsResponse\x12l\n" +
+	"\x15GetCurrentPeriodSales\x12(.commission.GetCurrentPeriodSalesRequest\x1a).commission.GetCurrentPeriodSalesResponse\x12u\n" +
 	"\x18GetCommissionCalculation\x12+.commission.GetCommissionCalculationRequest\x1a,.commission.GetCommissionCalculationResponse\x12{\n" +
-	"\x1aListCommissionCalculations\x12-.commission.ListCommissionCalculationsRequest\x1a..commission.ListCommissionCalculationsResponse\x12`\n" +
+	"\x1aListCommissionCalculations\x12-.commission.ListCommissionCalculationsRequest\x1a..commission.ListCommissionCalculationsResponse\x12l\n" +
+	"\x15ListCommissionDetails\x12(.commission.ListCommissionDetailsRequest\x1a).commission.ListCommissionDetailsResponse\x12`\n" +
 	"\x11ApproveCommission\x12$.commission.ApproveCommissionRequest\x1a%.commission.ApproveCommissionResponse\x12]\n" +
-	"\x10RejectCommission\x12#.commission.RejectCommissionRequest\x1a$.commission.RejectCommissionResponse\x12o\n" +
-	"\x16BulkApproveCommissions\x12).commission.BulkApproveCommissionsRequest\x1a*.commission.BulkApproveCommissionsResponse\x12T\n" +
+	"\x10RejectCommission\x12#.commission.RejectCommissionRequest\x1a$.commission.RejectCommissionResponse\x12f\n" +
+	"\x13UnapproveCommission\x12&.commission.UnapproveCommissionRequest\x1a'.commission.UnapproveCommissionResponse\x12o\n" +
+	"\x16BulkApproveCommissions\x12).commission.BulkApproveCommissionsRequest\x1a*.commission.BulkApproveCommissionsResponse\x12i\n" +
+	"\x14GetCommissionHistory\x12'.commission.GetCommissionHistoryRequest\x1a(.commission.GetCommissionHistoryResponse\x12T\n" +
 	"\rPayCommission\x12 .commission.PayCommissionRequest\x1a!.commission.PayCommissionResponse\x12i\n" +
-	"\x14GetCommissionPayment\x12'.commission.GetCommissionPaymentRequest\x1a(.commission.GetCommissionPaymentResponse\x12i\n" +
+	"\x14GetCommissionPayment\x12'.commission.GetCommissionPaymentRequest\x1a(.commission.GetCommissionPaymentResponse\x12l\n" +
+	"\x15VoidCommissionPayment\x12(.commission.VoidCommissionPaymentRequest\x1a).commission.VoidCommissionPaymentResponse\x12i\n" +
 	"\x14GetCommissionSummary\x12'.commission.GetCommissionSummaryRequest\x1a(.commission.GetCommissionSummaryResponse\x12f\n" +
-	"\x13GetCommissionReport\x12&.commission.GetCommissionReportRequest\x1a'.commission.GetCommissionReportResponse\x12l\n" +
-	"\x15GetCommissionSettings\x12(.commission.GetCommissionSettingsRequest\x1a).commission.GetCommissionSettingsResponseB'Z%syntra-system/proto/protogen;protogenb\x06proto3"
+	"\x13GetCommissionReport\x12&.commission.GetCommissionReportRequest\x1a'.commission.GetCommissionReportResponse\x12i\n" +
+	"\x14GetCommissionAccrual\x12'.commission.GetCommissionAccrualRequest\x1a(.commission.GetCommissionAccrualResponse\x12l\n" +
+	"\x15GetCommissionSettings\x12(.commission.GetCommissionSettingsRequest\x1a).commission.GetCommissionSettingsResponse\x12u\n" +
+	"\x18ListCommissionBonusRules\x12+.commission.ListCommissionBonusRulesRequest\x1a,.commission.ListCommissionBonusRulesResponseB'Z%syntra-system/proto/protogen;protogenb\x06proto3"
 
 var (
 	file_commissions_commision_service_proto_rawDescOnce sync.Once
@@ -2975,122 +4552,182 @@ func file_commissions_commision_service_proto_rawDescGZIP() []byte {
 	return file_commissions_commision_service_proto_rawDescData
 }
 
-var file_commissions_commision_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_commissions_commision_service_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
+var file_commissions_commision_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_commissions_commision_service_proto_msgTypes = make([]protoimpl.MessageInfo, 56)
 var file_commissions_commision_service_proto_goTypes = []any{
 	(CommissionType)(0),                        // 0: commission.CommissionType
 	(CommissionStatus)(0),                      // 1: commission.CommissionStatus
-	(*PaginationRequest)(nil),                  // 2: commission.PaginationRequest
-	(*PaginationResponse)(nil),                 // 3: commission.PaginationResponse
-	(*DateRange)(nil),                          // 4: commission.DateRange
-	(*CommissionCalculation)(nil),              // 5: commission.CommissionCalculation
-	(*CommissionDetail)(nil),                   // 6: commission.CommissionDetail
-	(*CommissionPayment)(nil),                  // 7: commission.CommissionPayment
-	(*EmployeeSummary)(nil),                    // 8: commission.EmployeeSummary
-	(*PaymentTypeSummary)(nil),                 // 9: commission.PaymentTypeSummary
-	(*CommissionBreakdown)(nil),                // 10: commission.CommissionBreakdown
-	(*TierCommission)(nil),                     // 11: commission.TierCommission
-	(*CalculateCommissionRequest)(nil),         // 12: commission.CalculateCommissionRequest
-	(*CalculateCommissionResponse)(nil),        // 13: commission.CalculateCommissionResponse
-	(*RecalculateCommissionRequest)(nil),       // 14: commission.RecalculateCommissionRequest
-	(*RecalculateCommissionResponse)(nil),      // 15: commission.RecalculateCommissionResponse
-	(*GetCommissionCalculationRequest)(nil),    // 16: commission.GetCommissionCalculationRequest
-	(*GetCommissionCalculationResponse)(nil),   // 17: commission.GetCommissionCalculationResponse
-	(*ListCommissionCalculationsRequest)(nil),  // 18: commission.ListCommissionCalculationsRequest
-	(*ListCommissionCalculationsResponse)(nil), // 19: commission.ListCommissionCalculationsResponse
-	(*ApproveCommissionRequest)(nil),           // 20: commission.ApproveCommissionRequest
-	(*ApproveCommissionResponse)(nil),          // 21: commission.ApproveCommissionResponse
-	(*RejectCommissionRequest)(nil),            // 22: commission.RejectCommissionRequest
-	(*RejectCommissionResponse)(nil),           // 23: commission.RejectCommissionResponse
-	(*PayCommissionRequest)(nil),               // 24: commission.PayCommissionRequest
-	(*PayCommissionResponse)(nil),              // 25: commission.PayCommissionResponse
-	(*GetCommissionPaymentRequest)(nil),        // 26: commission.GetCommissionPaymentRequest
-	(*GetCommissionPaymentResponse)(nil),       // 27: commission.GetCommissionPaymentResponse
-	(*GetCommissionSummaryRequest)(nil),        // 28: commission.GetCommissionSummaryRequest
-	(*GetCommissionSummaryResponse)(nil),       // 29: commission.GetCommissionSummaryResponse
-	(*CommissionSummary)(nil),                  // 30: commission.CommissionSummary
-	(*GetCommissionReportRequest)(nil),         // 31: commission.GetCommissionReportRequest
-	(*GetCommissionReportResponse)(nil),        // 32: commission.GetCommissionReportResponse
-	(*BulkCalculateCommissionsRequest)(nil),    // 33: commission.BulkCalculateCommissionsRequest
-	(*BulkCalculateCommissionsResponse)(nil),   // 34: commission.BulkCalculateCommissionsResponse
-	(*BulkApproveCommissionsRequest)(nil),      // 35: commission.BulkApproveCommissionsRequest
-	(*BulkApproveCommissionsResponse)(nil),     // 36: commission.BulkApproveCommissionsResponse
-	(*GetCommissionSettingsRequest)(nil),       // 37: commission.GetCommissionSettingsRequest
-	(*GetCommissionSettingsResponse)(nil),      // 38: commission.GetCommissionSettingsResponse
-	(*CommissionTierSetting)(nil),              // 39: commission.CommissionTierSetting
-	(*timestamppb.Timestamp)(nil),              // 40: google.protobuf.Timestamp
+	(BonusRuleType)(0),                         // 2: commission.BonusRuleType
+	(*PaginationRequest)(nil),                  // 3: commission.PaginationRequest
+	(*PaginationResponse)(nil),                 // 4: commission.PaginationResponse
+	(*DateRange)(nil),                          // 5: commission.DateRange
+	(*CommissionCalculation)(nil),              // 6: commission.CommissionCalculation
+	(*CommissionDetail)(nil),                   // 7: commission.CommissionDetail
+	(*CommissionPayment)(nil),                  // 8: commission.CommissionPayment
+	(*CommissionStatusHistory)(nil),            // 9: commission.CommissionStatusHistory
+	(*EmployeeSummary)(nil),                    // 10: commission.EmployeeSummary
+	(*PaymentTypeSummary)(nil),                 // 11: commission.PaymentTypeSummary
+	(*CommissionBreakdown)(nil),                // 12: commission.CommissionBreakdown
+	(*TierCommission)(nil),                     // 13: commission.TierCommission
+	(*CalculateCommissionRequest)(nil),         // 14: commission.CalculateCommissionRequest
+	(*CalculateCommissionResponse)(nil),        // 15: commission.CalculateCommissionResponse
+	(*GetCurrentPeriodSalesRequest)(nil),       // 16: commission.GetCurrentPeriodSalesRequest
+	(*GetCurrentPeriodSalesResponse)(nil),      // 17: commission.GetCurrentPeriodSalesResponse
+	(*RecalculateCommissionRequest)(nil),       // 18: commission.RecalculateCommissionRequest
+	(*RecalculateCommissionResponse)(nil),      // 19: commission.RecalculateCommissionResponse
+	(*GetCommissionCalculationRequest)(nil),    // 20: commission.GetCommissionCalculationRequest
+	(*GetCommissionCalculationResponse)(nil),   // 21: commission.GetCommissionCalculationResponse
+	(*ListCommissionCalculationsRequest)(nil),  // 22: commission.ListCommissionCalculationsRequest
+	(*ListCommissionCalculationsResponse)(nil), // 23: commission.ListCommissionCalculationsResponse
+	(*ListCommissionDetailsRequest)(nil),       // 24: commission.ListCommissionDetailsRequest
+	(*ListCommissionDetailsResponse)(nil),      // 25: commission.ListCommissionDetailsResponse
+	(*ApproveCommissionRequest)(nil),           // 26: commission.ApproveCommissionRequest
+	(*ApproveCommissionResponse)(nil),          // 27: commission.ApproveCommissionResponse
+	(*RejectCommissionRequest)(nil),            // 28: commission.RejectCommissionRequest
+	(*RejectCommissionResponse)(nil),           // 29: commission.RejectCommissionResponse
+	(*UnapproveCommissionRequest)(nil),         // 30: commission.UnapproveCommissionRequest
+	(*UnapproveCommissionResponse)(nil),        // 31: commission.UnapproveCommissionResponse
+	(*GetCommissionHistoryRequest)(nil),        // 32: commission.GetCommissionHistoryRequest
+	(*GetCommissionHistoryResponse)(nil),       // 33: commission.GetCommissionHistoryResponse
+	(*PayCommissionRequest)(nil),               // 34: commission.PayCommissionRequest
+	(*PayCommissionResponse)(nil),              // 35: commission.PayCommissionResponse
+	(*VoidCommissionPaymentRequest)(nil),       // 36: commission.VoidCommissionPaymentRequest
+	(*VoidCommissionPaymentResponse)(nil),      // 37: commission.VoidCommissionPaymentResponse
+	(*GetCommissionPaymentRequest)(nil),        // 38: commission.GetCommissionPaymentRequest
+	(*GetCommissionPaymentResponse)(nil),       // 39: commission.GetCommissionPaymentResponse
+	(*GetCommissionSummaryRequest)(nil),        // 40: commission.GetCommissionSummaryRequest
+	(*GetCommissionSummaryResponse)(nil),       // 41: commission.GetCommissionSummaryResponse
+	(*CommissionSummary)(nil),                  // 42: commission.CommissionSummary
+	(*GetCommissionReportRequest)(nil),         // 43: commission.GetCommissionReportRequest
+	(*GetCommissionReportResponse)(nil),        // 44: commission.GetCommissionReportResponse
+	(*CommissionStatusTotal)(nil),              // 45: commission.CommissionStatusTotal
+	(*CommissionAccrual)(nil),                  // 46: commission.CommissionAccrual
+	(*GetCommissionAccrualRequest)(nil),        // 47: commission.GetCommissionAccrualRequest
+	(*GetCommissionAccrualResponse)(nil),       // 48: commission.GetCommissionAccrualResponse
+	(*BulkCalculateCommissionsRequest)(nil),    // 49: commission.BulkCalculateCommissionsRequest
+	(*BulkCalculateCommissionsResponse)(nil),   // 50: commission.BulkCalculateCommissionsResponse
+	(*BulkApproveCommissionsRequest)(nil),      // 51: commission.BulkApproveCommissionsRequest
+	(*BulkApproveCommissionsResponse)(nil),     // 52: commission.BulkApproveCommissionsResponse
+	(*GetCommissionSettingsRequest)(nil),       // 53: commission.GetCommissionSettingsRequest
+	(*GetCommissionSettingsResponse)(nil),      // 54: commission.GetCommissionSettingsResponse
+	(*CommissionTierSetting)(nil),              // 55: commission.CommissionTierSetting
+	(*CommissionBonusRule)(nil),                // 56: commission.CommissionBonusRule
+	(*ListCommissionBonusRulesRequest)(nil),    // 57: commission.ListCommissionBonusRulesRequest
+	(*ListCommissionBonusRulesResponse)(nil),   // 58: commission.ListCommissionBonusRulesResponse
+	(*timestamppb.Timestamp)(nil),              // 59: google.protobuf.Timestamp
 }
 var file_commissions_commision_service_proto_depIdxs = []int32{
 	1,  // 0: commission.CommissionCalculation.status:type_name -> commission.CommissionStatus
-	40, // 1: commission.CommissionCalculation.created_at:type_name -> google.protobuf.Timestamp
-	40, // 2: commission.CommissionCalculation.updated_at:type_name -> google.protobuf.Timestamp
-	6,  // 3: commission.CommissionCalculation.commission_details:type_name -> commission.CommissionDetail
-	7,  // 4: commission.CommissionCalculation.commission_payment:type_name -> commission.CommissionPayment
-	8,  // 5: commission.CommissionCalculation.employee:type_name -> commission.EmployeeSummary
-	40, // 6: commission.CommissionDetail.created_at:type_name -> google.protobuf.Timestamp
-	40, // 7: commission.CommissionPayment.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 8: commission.CommissionPayment.payment_type:type_name -> commission.PaymentTypeSummary
-	0,  // 9: commission.EmployeeSummary.commission_type:type_name -> commission.CommissionType
-	11, // 10: commission.CommissionBreakdown.tier_commissions:type_name -> commission.TierCommission
-	5,  // 11: commission.CalculateCommissionResponse.commission_calculation:type_name -> commission.CommissionCalculation
-	10, // 12: commission.CalculateCommissionResponse.breakdown:type_name -> commission.CommissionBreakdown
-	5,  // 13: commission.RecalculateCommissionResponse.commission_calculation:type_name -> commission.CommissionCalculation
-	10, // 14: commission.RecalculateCommissionResponse.breakdown:type_name -> commission.CommissionBreakdown
-	5,  // 15: commission.GetCommissionCalculationResponse.commission_calculation:type_name -> commission.CommissionCalculation
-	2,  // 16: commission.ListCommissionCalculationsRequest.pagination:type_name -> commission.PaginationRequest
-	1,  // 17: commission.ListCommissionCalculationsRequest.status:type_name -> commission.CommissionStatus
-	4,  // 18: commission.ListCommissionCalculationsRequest.calculation_period:type_name -> commission.DateRange
-	5,  // 19: commission.ListCommissionCalculationsResponse.commission_calculations:type_name -> commission.CommissionCalculation
-	3,  // 20: commission.ListCommissionCalculationsResponse.pagination:type_name -> commission.PaginationResponse
-	5,  // 21: commission.ApproveCommissionResponse.commission_calculation:type_name -> commission.CommissionCalculation
-	5,  // 22: commission.RejectCommissionResponse.commission_calculation:type_name -> commission.CommissionCalculation
-	7,  // 23: commission.PayCommissionResponse.commission_payment:type_name -> commission.CommissionPayment
-	5,  // 24: commission.PayCommissionResponse.updated_calculation:type_name -> commission.CommissionCalculation
-	7,  // 25: commission.GetCommissionPaymentResponse.commission_payment:type_name -> commission.CommissionPayment
-	4,  // 26: commission.GetCommissionSummaryRequest.date_range:type_name -> commission.DateRange
-	30, // 27: commission.GetCommissionSummaryResponse.summary:type_name -> commission.CommissionSummary
-	4,  // 28: commission.CommissionSummary.period:type_name -> commission.DateRange
-	5,  // 29: commission.CommissionSummary.recent_calculations:type_name -> commission.CommissionCalculation
-	4,  // 30: commission.GetCommissionReportRequest.date_range:type_name -> commission.DateRange
-	1,  // 31: commission.GetCommissionReportRequest.status:type_name -> commission.CommissionStatus
-	2,  // 32: commission.GetCommissionReportRequest.pagination:type_name -> commission.PaginationRequest
-	30, // 33: commission.GetCommissionReportResponse.employee_summaries:type_name -> commission.CommissionSummary
-	3,  // 34: commission.GetCommissionReportResponse.pagination:type_name -> commission.PaginationResponse
-	5,  // 35: commission.BulkCalculateCommissionsResponse.calculations:type_name -> commission.CommissionCalculation
-	5,  // 36: commission.BulkApproveCommissionsResponse.approved_calculations:type_name -> commission.CommissionCalculation
-	8,  // 37: commission.GetCommissionSettingsResponse.employee:type_name -> commission.EmployeeSummary
-	39, // 38: commission.GetCommissionSettingsResponse.tier_settings:type_name -> commission.CommissionTierSetting
-	12, // 39: commission.CommissionService.CalculateCommission:input_type -> commission.CalculateCommissionRequest
-	14, // 40: commission.CommissionService.RecalculateCommission:input_type -> commission.RecalculateCommissionRequest
-	33, // 41: commission.CommissionService.BulkCalculateCommissions:input_type -> commission.BulkCalculateCommissionsRequest
-	16, // 42: commission.CommissionService.GetCommissionCalculation:input_type -> commission.GetCommissionCalculationRequest
-	18, // 43: commission.CommissionService.ListCommissionCalculations:input_type -> commission.ListCommissionCalculationsRequest
-	20, // 44: commission.CommissionService.ApproveCommission:input_type -> commission.ApproveCommissionRequest
-	22, // 45: commission.CommissionService.RejectCommission:input_type -> commission.RejectCommissionRequest
-	35, // 46: commission.CommissionService.BulkApproveCommissions:input_type -> commission.BulkApproveCommissionsRequest
-	24, // 47: commission.CommissionService.PayCommission:input_type -> commission.PayCommissionRequest
-	26, // 48: commission.CommissionService.GetCommissionPayment:input_type -> commission.GetCommissionPaymentRequest
-	28, // 49: commission.CommissionService.GetCommissionSummary:input_type -> commission.GetCommissionSummaryRequest
-	31, // 50: commission.CommissionService.GetCommissionReport:input_type -> commission.GetCommissionReportRequest
-	37, // 51: commission.CommissionService.GetCommissionSettings:input_type -> commission.GetCommissionSettingsRequest
-	13, // 52: commission.CommissionService.CalculateCommission:output_type -> commission.CalculateCommissionResponse
-	15, // 53: commission.CommissionService.RecalculateCommission:output_type -> commission.RecalculateCommissionResponse
-	34, // 54: commission.CommissionService.BulkCalculateCommissions:output_type -> commission.BulkCalculateCommissionsResponse
-	17, // 55: commission.CommissionService.GetCommissionCalculation:output_type -> commission.GetCommissionCalculationResponse
-	19, // 56: commission.CommissionService.ListCommissionCalculations:output_type -> commission.ListCommissionCalculationsResponse
-	21, // 57: commission.CommissionService.ApproveCommission:output_type -> commission.ApproveCommissionResponse
-	23, // 58: commission.CommissionService.RejectCommission:output_type -> commission.RejectCommissionResponse
-	36, // 59: commission.CommissionService.BulkApproveCommissions:output_type -> commission.BulkApproveCommissionsResponse
-	25, // 60: commission.CommissionService.PayCommission:output_type -> commission.PayCommissionResponse
-	27, // 61: commission.CommissionService.GetCommissionPayment:output_type -> commission.GetCommissionPaymentResponse
-	29, // 62: commission.CommissionService.GetCommissionSummary:output_type -> commission.GetCommissionSummaryResponse
-	32, // 63: commission.CommissionService.GetCommissionReport:output_type -> commission.GetCommissionReportResponse
-	38, // 64: commission.CommissionService.GetCommissionSettings:output_type -> commission.GetCommissionSettingsResponse
-	52, // [52:65] is the sub-list for method output_type
-	39, // [39:52] is the sub-list for method input_type
-	39, // [39:39] is the sub-list for extension type_name
-	39, // [39:39] is the sub-list for extension extendee
-	0,  // [0:39] is the sub-list for field type_name
+	59, // 1: commission.CommissionCalculation.created_at:type_name -> google.protobuf.Timestamp
+	59, // 2: commission.CommissionCalculation.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 3: commission.CommissionCalculation.commission_details:type_name -> commission.CommissionDetail
+	8,  // 4: commission.CommissionCalculation.commission_payment:type_name -> commission.CommissionPayment
+	10, // 5: commission.CommissionCalculation.employee:type_name -> commission.EmployeeSummary
+	8,  // 6: commission.CommissionCalculation.commission_payments:type_name -> commission.CommissionPayment
+	59, // 7: commission.CommissionDetail.created_at:type_name -> google.protobuf.Timestamp
+	59, // 8: commission.CommissionPayment.created_at:type_name -> google.protobuf.Timestamp
+	11, // 9: commission.CommissionPayment.payment_type:type_name -> commission.PaymentTypeSummary
+	59, // 10: commission.CommissionPayment.voided_at:type_name -> google.protobuf.Timestamp
+	1,  // 11: commission.CommissionStatusHistory.from_status:type_name -> commission.CommissionStatus
+	1,  // 12: commission.CommissionStatusHistory.to_status:type_name -> commission.CommissionStatus
+	59, // 13: commission.CommissionStatusHistory.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 14: commission.EmployeeSummary.commission_type:type_name -> commission.CommissionType
+	13, // 15: commission.CommissionBreakdown.tier_commissions:type_name -> commission.TierCommission
+	6,  // 16: commission.CalculateCommissionResponse.commission_calculation:type_name -> commission.CommissionCalculation
+	12, // 17: commission.CalculateCommissionResponse.breakdown:type_name -> commission.CommissionBreakdown
+	5,  // 18: commission.GetCurrentPeriodSalesResponse.period:type_name -> commission.DateRange
+	12, // 19: commission.GetCurrentPeriodSalesResponse.breakdown:type_name -> commission.CommissionBreakdown
+	6,  // 20: commission.RecalculateCommissionResponse.commission_calculation:type_name -> commission.CommissionCalculation
+	12, // 21: commission.RecalculateCommissionResponse.breakdown:type_name -> commission.CommissionBreakdown
+	6,  // 22: commission.GetCommissionCalculationResponse.commission_calculation:type_name -> commission.CommissionCalculation
+	3,  // 23: commission.ListCommissionCalculationsRequest.pagination:type_name -> commission.PaginationRequest
+	1,  // 24: commission.ListCommissionCalculationsRequest.status:type_name -> commission.CommissionStatus
+	5,  // 25: commission.ListCommissionCalculationsRequest.calculation_period:type_name -> commission.DateRange
+	6,  // 26: commission.ListCommissionCalculationsResponse.commission_calculations:type_name -> commission.CommissionCalculation
+	4,  // 27: commission.ListCommissionCalculationsResponse.pagination:type_name -> commission.PaginationResponse
+	3,  // 28: commission.ListCommissionDetailsRequest.pagination:type_name -> commission.PaginationRequest
+	7,  // 29: commission.ListCommissionDetailsResponse.commission_details:type_name -> commission.CommissionDetail
+	4,  // 30: commission.ListCommissionDetailsResponse.pagination:type_name -> commission.PaginationResponse
+	6,  // 31: commission.ApproveCommissionResponse.commission_calculation:type_name -> commission.CommissionCalculation
+	6,  // 32: commission.RejectCommissionResponse.commission_calculation:type_name -> commission.CommissionCalculation
+	6,  // 33: commission.UnapproveCommissionResponse.commission_calculation:type_name -> commission.CommissionCalculation
+	9,  // 34: commission.GetCommissionHistoryResponse.history:type_name -> commission.CommissionStatusHistory
+	8,  // 35: commission.PayCommissionResponse.commission_payment:type_name -> commission.CommissionPayment
+	6,  // 36: commission.PayCommissionResponse.updated_calculation:type_name -> commission.CommissionCalculation
+	8,  // 37: commission.VoidCommissionPaymentResponse.commission_payment:type_name -> commission.CommissionPayment
+	6,  // 38: commission.VoidCommissionPaymentResponse.updated_calculation:type_name -> commission.CommissionCalculation
+	8,  // 39: commission.GetCommissionPaymentResponse.commission_payment:type_name -> commission.CommissionPayment
+	8,  // 40: commission.GetCommissionPaymentResponse.commission_payments:type_name -> commission.CommissionPayment
+	5,  // 41: commission.GetCommissionSummaryRequest.date_range:type_name -> commission.DateRange
+	42, // 42: commission.GetCommissionSummaryResponse.summary:type_name -> commission.CommissionSummary
+	5,  // 43: commission.CommissionSummary.period:type_name -> commission.DateRange
+	6,  // 44: commission.CommissionSummary.recent_calculations:type_name -> commission.CommissionCalculation
+	5,  // 45: commission.GetCommissionReportRequest.date_range:type_name -> commission.DateRange
+	1,  // 46: commission.GetCommissionReportRequest.status:type_name -> commission.CommissionStatus
+	3,  // 47: commission.GetCommissionReportRequest.pagination:type_name -> commission.PaginationRequest
+	42, // 48: commission.GetCommissionReportResponse.employee_summaries:type_name -> commission.CommissionSummary
+	4,  // 49: commission.GetCommissionReportResponse.pagination:type_name -> commission.PaginationResponse
+	45, // 50: commission.GetCommissionReportResponse.status_totals:type_name -> commission.CommissionStatusTotal
+	1,  // 51: commission.CommissionStatusTotal.status:type_name -> commission.CommissionStatus
+	5,  // 52: commission.CommissionAccrual.period:type_name -> commission.DateRange
+	59, // 53: commission.CommissionAccrual.created_at:type_name -> google.protobuf.Timestamp
+	10, // 54: commission.CommissionAccrual.employee:type_name -> commission.EmployeeSummary
+	46, // 55: commission.GetCommissionAccrualResponse.accruals:type_name -> commission.CommissionAccrual
+	6,  // 56: commission.BulkCalculateCommissionsResponse.calculations:type_name -> commission.CommissionCalculation
+	6,  // 57: commission.BulkApproveCommissionsResponse.approved_calculations:type_name -> commission.CommissionCalculation
+	10, // 58: commission.GetCommissionSettingsResponse.employee:type_name -> commission.EmployeeSummary
+	55, // 59: commission.GetCommissionSettingsResponse.tier_settings:type_name -> commission.CommissionTierSetting
+	2,  // 60: commission.CommissionBonusRule.rule_type:type_name -> commission.BonusRuleType
+	59, // 61: commission.CommissionBonusRule.created_at:type_name -> google.protobuf.Timestamp
+	59, // 62: commission.CommissionBonusRule.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 63: commission.ListCommissionBonusRulesRequest.pagination:type_name -> commission.PaginationRequest
+	56, // 64: commission.ListCommissionBonusRulesResponse.bonus_rules:type_name -> commission.CommissionBonusRule
+	4,  // 65: commission.ListCommissionBonusRulesResponse.pagination:type_name -> commission.PaginationResponse
+	14, // 66: commission.CommissionService.CalculateCommission:input_type -> commission.CalculateCommissionRequest
+	18, // 67: commission.CommissionService.RecalculateCommission:input_type -> commission.RecalculateCommissionRequest
+	49, // 68: commission.CommissionService.BulkCalculateCommissions:input_type -> commission.BulkCalculateCommissionsRequest
+	16, // 69: commission.CommissionService.GetCurrentPeriodSales:input_type -> commission.GetCurrentPeriodSalesRequest
+	20, // 70: commission.CommissionService.GetCommissionCalculation:input_type -> commission.GetCommissionCalculationRequest
+	22, // 71: commission.CommissionService.ListCommissionCalculations:input_type -> commission.ListCommissionCalculationsRequest
+	24, // 72: commission.CommissionService.ListCommissionDetails:input_type -> commission.ListCommissionDetailsRequest
+	26, // 73: commission.CommissionService.ApproveCommission:input_type -> commission.ApproveCommissionRequest
+	28, // 74: commission.CommissionService.RejectCommission:input_type -> commission.RejectCommissionRequest
+	30, // 75: commission.CommissionService.UnapproveCommission:input_type -> commission.UnapproveCommissionRequest
+	51, // 76: commission.CommissionService.BulkApproveCommissions:input_type -> commission.BulkApproveCommissionsRequest
+	32, // 77: commission.CommissionService.GetCommissionHistory:input_type -> commission.GetCommissionHistoryRequest
+	34, // 78: commission.CommissionService.PayCommission:input_type -> commission.PayCommissionRequest
+	38, // 79: commission.CommissionService.GetCommissionPayment:input_type -> commission.GetCommissionPaymentRequest
+	36, // 80: commission.CommissionService.VoidCommissionPayment:input_type -> commission.VoidCommissionPaymentRequest
+	40, // 81: commission.CommissionService.GetCommissionSummary:input_type -> commission.GetCommissionSummaryRequest
+	43, // 82: commission.CommissionService.GetCommissionReport:input_type -> commission.GetCommissionReportRequest
+	47, // 83: commission.CommissionService.GetCommissionAccrual:input_type -> commission.GetCommissionAccrualRequest
+	53, // 84: commission.CommissionService.GetCommissionSettings:input_type -> commission.GetCommissionSettingsRequest
+	57, // 85: commission.CommissionService.ListCommissionBonusRules:input_type -> commission.ListCommissionBonusRulesRequest
+	15, // 86: commission.CommissionService.CalculateCommission:output_type -> commission.CalculateCommissionResponse
+	19, // 87: commission.CommissionService.RecalculateCommission:output_type -> commission.RecalculateCommissionResponse
+	50, // 88: commission.CommissionService.BulkCalculateCommissions:output_type -> commission.BulkCalculateCommissionsResponse
+	17, // 89: commission.CommissionService.GetCurrentPeriodSales:output_type -> commission.GetCurrentPeriodSalesResponse
+	21, // 90: commission.CommissionService.GetCommissionCalculation:output_type -> commission.GetCommissionCalculationResponse
+	23, // 91: commission.CommissionService.ListCommissionCalculations:output_type -> commission.ListCommissionCalculationsResponse
+	25, // 92: commission.CommissionService.ListCommissionDetails:output_type -> commission.ListCommissionDetailsResponse
+	27, // 93: commission.CommissionService.ApproveCommission:output_type -> commission.ApproveCommissionResponse
+	29, // 94: commission.CommissionService.RejectCommission:output_type -> commission.RejectCommissionResponse
+	31, // 95: commission.CommissionService.UnapproveCommission:output_type -> commission.UnapproveCommissionResponse
+	52, // 96: commission.CommissionService.BulkApproveCommissions:output_type -> commission.BulkApproveCommissionsResponse
+	33, // 97: commission.CommissionService.GetCommissionHistory:output_type -> commission.GetCommissionHistoryResponse
+	35, // 98: commission.CommissionService.PayCommission:output_type -> commission.PayCommissionResponse
+	39, // 99: commission.CommissionService.GetCommissionPayment:output_type -> commission.GetCommissionPaymentResponse
+	37, // 100: commission.CommissionService.VoidCommissionPayment:output_type -> commission.VoidCommissionPaymentResponse
+	41, // 101: commission.CommissionService.GetCommissionSummary:output_type -> commission.GetCommissionSummaryResponse
+	44, // 102: commission.CommissionService.GetCommissionReport:output_type -> commission.GetCommissionReportResponse
+	48, // 103: commission.CommissionService.GetCommissionAccrual:output_type -> commission.GetCommissionAccrualResponse
+	54, // 104: commission.CommissionService.GetCommissionSettings:output_type -> commission.GetCommissionSettingsResponse
+	58, // 105: commission.CommissionService.ListCommissionBonusRules:output_type -> commission.ListCommissionBonusRulesResponse
+	86, // [86:106] is the sub-list for method output_type
+	66, // [66:86] is the sub-list for method input_type
+	66, // [66:66] is the sub-list for extension type_name
+	66, // [66:66] is the sub-list for extension extendee
+	0,  // [0:66] is the sub-list for field type_name
 }
 
 func init() { file_commissions_commision_service_proto_init() }
@@ -3098,25 +4735,37 @@ func file_commissions_commision_service_proto_init() {
 	if File_commissions_commision_service_proto != nil {
 		return
 	}
+	file_commissions_commision_service_proto_msgTypes[2].OneofWrappers = []any{}
 	file_commissions_commision_service_proto_msgTypes[3].OneofWrappers = []any{}
 	file_commissions_commision_service_proto_msgTypes[4].OneofWrappers = []any{}
 	file_commissions_commision_service_proto_msgTypes[5].OneofWrappers = []any{}
 	file_commissions_commision_service_proto_msgTypes[6].OneofWrappers = []any{}
-	file_commissions_commision_service_proto_msgTypes[10].OneofWrappers = []any{}
-	file_commissions_commision_service_proto_msgTypes[12].OneofWrappers = []any{}
-	file_commissions_commision_service_proto_msgTypes[16].OneofWrappers = []any{}
-	file_commissions_commision_service_proto_msgTypes[18].OneofWrappers = []any{}
-	file_commissions_commision_service_proto_msgTypes[22].OneofWrappers = []any{}
-	file_commissions_commision_service_proto_msgTypes[29].OneofWrappers = []any{}
-	file_commissions_commision_service_proto_msgTypes[33].OneofWrappers = []any{}
-	file_commissions_commision_service_proto_msgTypes[37].OneofWrappers = []any{}
+	file_commissions_commision_service_proto_msgTypes[7].OneofWrappers = []any{}
+	file_commissions_commision_service_proto_msgTypes[11].OneofWrappers = []any{}
+	file_commissions_commision_service_proto_msgTypes[13].OneofWrappers = []any{}
+	file_commissions_commision_service_proto_msgTypes[15].OneofWrappers = []any{}
+	file_commissions_commision_service_proto_msgTypes[19].OneofWrappers = []any{}
+	file_commissions_commision_service_proto_msgTypes[21].OneofWrappers = []any{}
+	file_commissions_commision_service_proto_msgTypes[23].OneofWrappers = []any{}
+	file_commissions_commision_service_proto_msgTypes[31].OneofWrappers = []any{}
+	file_commissions_commision_service_proto_msgTypes[40].OneofWrappers = []any{}
+	file_commissions_commision_service_proto_msgTypes[43].OneofWrappers = []any{}
+	file_commissions_commision_service_proto_msgTypes[44].OneofWrappers = []any{}
+	file_commissions_commision_service_proto_msgTypes[46].OneofWrappers = []any{}
+	file_commissions_commision_service_proto_msgTypes[48].OneofWrappers = []any{}
+	file_commissions_commision_service_proto_msgTypes[52].OneofWrappers = []any{}
+	file_commissions_commision_service_proto_msgTypes[53].OneofWrappers = []any{
+		(*CommissionBonusRule_BonusAmount)(nil),
+		(*CommissionBonusRule_BonusRate)(nil),
+	}
+	file_commissions_commision_service_proto_msgTypes[54].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_commissions_commision_service_proto_rawDesc), len(file_commissions_commision_service_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   38,
+			NumEnums:      3,
+			NumMessages:   56,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
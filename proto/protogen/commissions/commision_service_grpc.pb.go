@@ -22,16 +22,23 @@ const (
 	CommissionService_CalculateCommission_FullMethodName        = "/commission.CommissionService/CalculateCommission"
 	CommissionService_RecalculateCommission_FullMethodName      = "/commission.CommissionService/RecalculateCommission"
 	CommissionService_BulkCalculateCommissions_FullMethodName   = "/commission.CommissionService/BulkCalculateCommissions"
+	CommissionService_GetCurrentPeriodSales_FullMethodName      = "/commission.CommissionService/GetCurrentPeriodSales"
 	CommissionService_GetCommissionCalculation_FullMethodName   = "/commission.CommissionService/GetCommissionCalculation"
 	CommissionService_ListCommissionCalculations_FullMethodName = "/commission.CommissionService/ListCommissionCalculations"
+	CommissionService_ListCommissionDetails_FullMethodName      = "/commission.CommissionService/ListCommissionDetails"
 	CommissionService_ApproveCommission_FullMethodName          = "/commission.CommissionService/ApproveCommission"
 	CommissionService_RejectCommission_FullMethodName           = "/commission.CommissionService/RejectCommission"
+	CommissionService_UnapproveCommission_FullMethodName        = "/commission.CommissionService/UnapproveCommission"
 	CommissionService_BulkApproveCommissions_FullMethodName     = "/commission.CommissionService/BulkApproveCommissions"
+	CommissionService_GetCommissionHistory_FullMethodName       = "/commission.CommissionService/GetCommissionHistory"
 	CommissionService_PayCommission_FullMethodName              = "/commission.CommissionService/PayCommission"
 	CommissionService_GetCommissionPayment_FullMethodName       = "/commission.CommissionService/GetCommissionPayment"
+	CommissionService_VoidCommissionPayment_FullMethodName      = "/commission.CommissionService/VoidCommissionPayment"
 	CommissionService_GetCommissionSummary_FullMethodName       = "/commission.CommissionService/GetCommissionSummary"
 	CommissionService_GetCommissionReport_FullMethodName        = "/commission.CommissionService/GetCommissionReport"
+	CommissionService_GetCommissionAccrual_FullMethodName       = "/commission.CommissionService/GetCommissionAccrual"
 	CommissionService_GetCommissionSettings_FullMethodName      = "/commission.CommissionService/GetCommissionSettings"
+	CommissionService_ListCommissionBonusRules_FullMethodName   = "/commission.CommissionService/ListCommissionBonusRules"
 )
 
 // CommissionServiceClient is the client API for CommissionService service.
@@ -42,20 +49,27 @@ type CommissionServiceClient interface {
 	CalculateCommission(ctx context.Context, in *CalculateCommissionRequest, opts ...grpc.CallOption) (*CalculateCommissionResponse, error)
 	RecalculateCommission(ctx context.Context, in *RecalculateCommissionRequest, opts ...grpc.CallOption) (*RecalculateCommissionResponse, error)
 	BulkCalculateCommissions(ctx context.Context, in *BulkCalculateCommissionsRequest, opts ...grpc.CallOption) (*BulkCalculateCommissionsResponse, error)
+	GetCurrentPeriodSales(ctx context.Context, in *GetCurrentPeriodSalesRequest, opts ...grpc.CallOption) (*GetCurrentPeriodSalesResponse, error)
 	// Commission Management
 	GetCommissionCalculation(ctx context.Context, in *GetCommissionCalculationRequest, opts ...grpc.CallOption) (*GetCommissionCalculationResponse, error)
 	ListCommissionCalculations(ctx context.Context, in *ListCommissionCalculationsRequest, opts ...grpc.CallOption) (*ListCommissionCalculationsResponse, error)
+	ListCommissionDetails(ctx context.Context, in *ListCommissionDetailsRequest, opts ...grpc.CallOption) (*ListCommissionDetailsResponse, error)
 	ApproveCommission(ctx context.Context, in *ApproveCommissionRequest, opts ...grpc.CallOption) (*ApproveCommissionResponse, error)
 	RejectCommission(ctx context.Context, in *RejectCommissionRequest, opts ...grpc.CallOption) (*RejectCommissionResponse, error)
+	UnapproveCommission(ctx context.Context, in *UnapproveCommissionRequest, opts ...grpc.CallOption) (*UnapproveCommissionResponse, error)
 	BulkApproveCommissions(ctx context.Context, in *BulkApproveCommissionsRequest, opts ...grpc.CallOption) (*BulkApproveCommissionsResponse, error)
+	GetCommissionHistory(ctx context.Context, in *GetCommissionHistoryRequest, opts ...grpc.CallOption) (*GetCommissionHistoryResponse, error)
 	// Commission Payment
 	PayCommission(ctx context.Context, in *PayCommissionRequest, opts ...grpc.CallOption) (*PayCommissionResponse, error)
 	GetCommissionPayment(ctx context.Context, in *GetCommissionPaymentRequest, opts ...grpc.CallOption) (*GetCommissionPaymentResponse, error)
+	VoidCommissionPayment(ctx context.Context, in *VoidCommissionPaymentRequest, opts ...grpc.CallOption) (*VoidCommissionPaymentResponse, error)
 	// Commission Reporting
 	GetCommissionSummary(ctx context.Context, in *GetCommissionSummaryRequest, opts ...grpc.CallOption) (*GetCommissionSummaryResponse, error)
 	GetCommissionReport(ctx context.Context, in *GetCommissionReportRequest, opts ...grpc.CallOption) (*GetCommissionReportResponse, error)
+	GetCommissionAccrual(ctx context.Context, in *GetCommissionAccrualRequest, opts ...grpc.CallOption) (*GetCommissionAccrualResponse, error)
 	// Commission Settings
 	GetCommissionSettings(ctx context.Context, in *GetCommissionSettingsRequest, opts ...grpc.CallOption) (*GetCommissionSettingsResponse, error)
+	ListCommissionBonusRules(ctx context.Context, in *ListCommissionBonusRulesRequest, opts ...grpc.CallOption) (*ListCommissionBonusRulesResponse, error)
 }
 
 type commissionServiceClient struct {
@@ -96,6 +110,16 @@ func (c *commissionServiceClient) BulkCalculateCommissions(ctx context.Context,
 	return out, nil
 }
 
+func (c *commissionServiceClient) GetCurrentPeriodSales(ctx context.Context, in *GetCurrentPeriodSalesRequest, opts ...grpc.CallOption) (*GetCurrentPeriodSalesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCurrentPeriodSalesResponse)
+	err := c.cc.Invoke(ctx, CommissionService_GetCurrentPeriodSales_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *commissionServiceClient) GetCommissionCalculation(ctx context.Context, in *GetCommissionCalculationRequest, opts ...grpc.CallOption) (*GetCommissionCalculationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetCommissionCalculationResponse)
@@ -116,6 +140,16 @@ func (c *commissionServiceClient) ListCommissionCalculations(ctx context.Context
 	return out, nil
 }
 
+func (c *commissionServiceClient) ListCommissionDetails(ctx context.Context, in *ListCommissionDetailsRequest, opts ...grpc.CallOption) (*ListCommissionDetailsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommissionDetailsResponse)
+	err := c.cc.Invoke(ctx, CommissionService_ListCommissionDetails_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *commissionServiceClient) ApproveCommission(ctx context.Context, in *ApproveCommissionRequest, opts ...grpc.CallOption) (*ApproveCommissionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ApproveCommissionResponse)
@@ -136,6 +170,16 @@ func (c *commissionServiceClient) RejectCommission(ctx context.Context, in *Reje
 	return out, nil
 }
 
+func (c *commissionServiceClient) UnapproveCommission(ctx context.Context, in *UnapproveCommissionRequest, opts ...grpc.CallOption) (*UnapproveCommissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnapproveCommissionResponse)
+	err := c.cc.Invoke(ctx, CommissionService_UnapproveCommission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *commissionServiceClient) BulkApproveCommissions(ctx context.Context, in *BulkApproveCommissionsRequest, opts ...grpc.CallOption) (*BulkApproveCommissionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BulkApproveCommissionsResponse)
@@ -146,6 +190,16 @@ func (c *commissionServiceClient) BulkApproveCommissions(ctx context.Context, in
 	return out, nil
 }
 
+func (c *commissionServiceClient) GetCommissionHistory(ctx context.Context, in *GetCommissionHistoryRequest, opts ...grpc.CallOption) (*GetCommissionHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommissionHistoryResponse)
+	err := c.cc.Invoke(ctx, CommissionService_GetCommissionHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *commissionServiceClient) PayCommission(ctx context.Context, in *PayCommissionRequest, opts ...grpc.CallOption) (*PayCommissionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PayCommissionResponse)
@@ -166,6 +220,16 @@ func (c *commissionServiceClient) GetCommissionPayment(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *commissionServiceClient) VoidCommissionPayment(ctx context.Context, in *VoidCommissionPaymentRequest, opts ...grpc.CallOption) (*VoidCommissionPaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoidCommissionPaymentResponse)
+	err := c.cc.Invoke(ctx, CommissionService_VoidCommissionPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *commissionServiceClient) GetCommissionSummary(ctx context.Context, in *GetCommissionSummaryRequest, opts ...grpc.CallOption) (*GetCommissionSummaryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetCommissionSummaryResponse)
@@ -186,6 +250,16 @@ func (c *commissionServiceClient) GetCommissionReport(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *commissionServiceClient) GetCommissionAccrual(ctx context.Context, in *GetCommissionAccrualRequest, opts ...grpc.CallOption) (*GetCommissionAccrualResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommissionAccrualResponse)
+	err := c.cc.Invoke(ctx, CommissionService_GetCommissionAccrual_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *commissionServiceClient) GetCommissionSettings(ctx context.Context, in *GetCommissionSettingsRequest, opts ...grpc.CallOption) (*GetCommissionSettingsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetCommissionSettingsResponse)
@@ -196,6 +270,16 @@ func (c *commissionServiceClient) GetCommissionSettings(ctx context.Context, in
 	return out, nil
 }
 
+func (c *commissionServiceClient) ListCommissionBonusRules(ctx context.Context, in *ListCommissionBonusRulesRequest, opts ...grpc.CallOption) (*ListCommissionBonusRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommissionBonusRulesResponse)
+	err := c.cc.Invoke(ctx, CommissionService_ListCommissionBonusRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CommissionServiceServer is the server API for CommissionService service.
 // All implementations must embed UnimplementedCommissionServiceServer
 // for forward compatibility.
@@ -204,20 +288,27 @@ type CommissionServiceServer interface {
 	CalculateCommission(context.Context, *CalculateCommissionRequest) (*CalculateCommissionResponse, error)
 	RecalculateCommission(context.Context, *RecalculateCommissionRequest) (*RecalculateCommissionResponse, error)
 	BulkCalculateCommissions(context.Context, *BulkCalculateCommissionsRequest) (*BulkCalculateCommissionsResponse, error)
+	GetCurrentPeriodSales(context.Context, *GetCurrentPeriodSalesRequest) (*GetCurrentPeriodSalesResponse, error)
 	// Commission Management
 	GetCommissionCalculation(context.Context, *GetCommissionCalculationRequest) (*GetCommissionCalculationResponse, error)
 	ListCommissionCalculations(context.Context, *ListCommissionCalculationsRequest) (*ListCommissionCalculationsResponse, error)
+	ListCommissionDetails(context.Context, *ListCommissionDetailsRequest) (*ListCommissionDetailsResponse, error)
 	ApproveCommission(context.Context, *ApproveCommissionRequest) (*ApproveCommissionResponse, error)
 	RejectCommission(context.Context, *RejectCommissionRequest) (*RejectCommissionResponse, error)
+	UnapproveCommission(context.Context, *UnapproveCommissionRequest) (*UnapproveCommissionResponse, error)
 	BulkApproveCommissions(context.Context, *BulkApproveCommissionsRequest) (*BulkApproveCommissionsResponse, error)
+	GetCommissionHistory(context.Context, *GetCommissionHistoryRequest) (*GetCommissionHistoryResponse, error)
 	// Commission Payment
 	PayCommission(context.Context, *PayCommissionRequest) (*PayCommissionResponse, error)
 	GetCommissionPayment(context.Context, *GetCommissionPaymentRequest) (*GetCommissionPaymentResponse, error)
+	VoidCommissionPayment(context.Context, *VoidCommissionPaymentRequest) (*VoidCommissionPaymentResponse, error)
 	// Commission Reporting
 	GetCommissionSummary(context.Context, *GetCommissionSummaryRequest) (*GetCommissionSummaryResponse, error)
 	GetCommissionReport(context.Context, *GetCommissionReportRequest) (*GetCommissionReportResponse, error)
+	GetCommissionAccrual(context.Context, *GetCommissionAccrualRequest) (*GetCommissionAccrualResponse, error)
 	// Commission Settings
 	GetCommissionSettings(context.Context, *GetCommissionSettingsRequest) (*GetCommissionSettingsResponse, error)
+	ListCommissionBonusRules(context.Context, *ListCommissionBonusRulesRequest) (*ListCommissionBonusRulesResponse, error)
 	mustEmbedUnimplementedCommissionServiceServer()
 }
 
@@ -237,36 +328,57 @@ func (UnimplementedCommissionServiceServer) RecalculateCommission(context.Contex
 func (UnimplementedCommissionServiceServer) BulkCalculateCommissions(context.Context, *BulkCalculateCommissionsRequest) (*BulkCalculateCommissionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BulkCalculateCommissions not implemented")
 }
+func (UnimplementedCommissionServiceServer) GetCurrentPeriodSales(context.Context, *GetCurrentPeriodSalesRequest) (*GetCurrentPeriodSalesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentPeriodSales not implemented")
+}
 func (UnimplementedCommissionServiceServer) GetCommissionCalculation(context.Context, *GetCommissionCalculationRequest) (*GetCommissionCalculationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCommissionCalculation not implemented")
 }
 func (UnimplementedCommissionServiceServer) ListCommissionCalculations(context.Context, *ListCommissionCalculationsRequest) (*ListCommissionCalculationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCommissionCalculations not implemented")
 }
+func (UnimplementedCommissionServiceServer) ListCommissionDetails(context.Context, *ListCommissionDetailsRequest) (*ListCommissionDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommissionDetails not implemented")
+}
 func (UnimplementedCommissionServiceServer) ApproveCommission(context.Context, *ApproveCommissionRequest) (*ApproveCommissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApproveCommission not implemented")
 }
 func (UnimplementedCommissionServiceServer) RejectCommission(context.Context, *RejectCommissionRequest) (*RejectCommissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RejectCommission not implemented")
 }
+func (UnimplementedCommissionServiceServer) UnapproveCommission(context.Context, *UnapproveCommissionRequest) (*UnapproveCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnapproveCommission not implemented")
+}
 func (UnimplementedCommissionServiceServer) BulkApproveCommissions(context.Context, *BulkApproveCommissionsRequest) (*BulkApproveCommissionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BulkApproveCommissions not implemented")
 }
+func (UnimplementedCommissionServiceServer) GetCommissionHistory(context.Context, *GetCommissionHistoryRequest) (*GetCommissionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommissionHistory not implemented")
+}
 func (UnimplementedCommissionServiceServer) PayCommission(context.Context, *PayCommissionRequest) (*PayCommissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PayCommission not implemented")
 }
 func (UnimplementedCommissionServiceServer) GetCommissionPayment(context.Context, *GetCommissionPaymentRequest) (*GetCommissionPaymentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCommissionPayment not implemented")
 }
+func (UnimplementedCommissionServiceServer) VoidCommissionPayment(context.Context, *VoidCommissionPaymentRequest) (*VoidCommissionPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoidCommissionPayment not implemented")
+}
 func (UnimplementedCommissionServiceServer) GetCommissionSummary(context.Context, *GetCommissionSummaryRequest) (*GetCommissionSummaryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCommissionSummary not implemented")
 }
 func (UnimplementedCommissionServiceServer) GetCommissionReport(context.Context, *GetCommissionReportRequest) (*GetCommissionReportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCommissionReport not implemented")
 }
+func (UnimplementedCommissionServiceServer) GetCommissionAccrual(context.Context, *GetCommissionAccrualRequest) (*GetCommissionAccrualResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommissionAccrual not implemented")
+}
 func (UnimplementedCommissionServiceServer) GetCommissionSettings(context.Context, *GetCommissionSettingsRequest) (*GetCommissionSettingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCommissionSettings not implemented")
 }
+func (UnimplementedCommissionServiceServer) ListCommissionBonusRules(context.Context, *ListCommissionBonusRulesRequest) (*ListCommissionBonusRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommissionBonusRules not implemented")
+}
 func (UnimplementedCommissionServiceServer) mustEmbedUnimplementedCommissionServiceServer() {}
 func (UnimplementedCommissionServiceServer) testEmbeddedByValue()                           {}
 
@@ -342,6 +454,24 @@ func _CommissionService_BulkCalculateCommissions_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommissionService_GetCurrentPeriodSales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrentPeriodSalesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommissionServiceServer).GetCurrentPeriodSales(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommissionService_GetCurrentPeriodSales_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommissionServiceServer).GetCurrentPeriodSales(ctx, req.(*GetCurrentPeriodSalesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CommissionService_GetCommissionCalculation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCommissionCalculationRequest)
 	if err := dec(in); err != nil {
@@ -378,6 +508,24 @@ func _CommissionService_ListCommissionCalculations_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommissionService_ListCommissionDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommissionDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommissionServiceServer).ListCommissionDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommissionService_ListCommissionDetails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommissionServiceServer).ListCommissionDetails(ctx, req.(*ListCommissionDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CommissionService_ApproveCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApproveCommissionRequest)
 	if err := dec(in); err != nil {
@@ -414,6 +562,24 @@ func _CommissionService_RejectCommission_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommissionService_UnapproveCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnapproveCommissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommissionServiceServer).UnapproveCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommissionService_UnapproveCommission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommissionServiceServer).UnapproveCommission(ctx, req.(*UnapproveCommissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CommissionService_BulkApproveCommissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BulkApproveCommissionsRequest)
 	if err := dec(in); err != nil {
@@ -432,6 +598,24 @@ func _CommissionService_BulkApproveCommissions_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommissionService_GetCommissionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommissionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommissionServiceServer).GetCommissionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommissionService_GetCommissionHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommissionServiceServer).GetCommissionHistory(ctx, req.(*GetCommissionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CommissionService_PayCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PayCommissionRequest)
 	if err := dec(in); err != nil {
@@ -468,6 +652,24 @@ func _CommissionService_GetCommissionPayment_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommissionService_VoidCommissionPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidCommissionPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommissionServiceServer).VoidCommissionPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommissionService_VoidCommissionPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommissionServiceServer).VoidCommissionPayment(ctx, req.(*VoidCommissionPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CommissionService_GetCommissionSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCommissionSummaryRequest)
 	if err := dec(in); err != nil {
@@ -504,6 +706,24 @@ func _CommissionService_GetCommissionReport_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommissionService_GetCommissionAccrual_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommissionAccrualRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommissionServiceServer).GetCommissionAccrual(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommissionService_GetCommissionAccrual_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommissionServiceServer).GetCommissionAccrual(ctx, req.(*GetCommissionAccrualRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CommissionService_GetCommissionSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCommissionSettingsRequest)
 	if err := dec(in); err != nil {
@@ -522,6 +742,24 @@ func _CommissionService_GetCommissionSettings_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommissionService_ListCommissionBonusRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommissionBonusRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommissionServiceServer).ListCommissionBonusRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommissionService_ListCommissionBonusRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommissionServiceServer).ListCommissionBonusRules(ctx, req.(*ListCommissionBonusRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CommissionService_ServiceDesc is the grpc.ServiceDesc for CommissionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -541,6 +779,10 @@ var CommissionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BulkCalculateCommissions",
 			Handler:    _CommissionService_BulkCalculateCommissions_Handler,
 		},
+		{
+			MethodName: "GetCurrentPeriodSales",
+			Handler:    _CommissionService_GetCurrentPeriodSales_Handler,
+		},
 		{
 			MethodName: "GetCommissionCalculation",
 			Handler:    _CommissionService_GetCommissionCalculation_Handler,
@@ -549,6 +791,10 @@ var CommissionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListCommissionCalculations",
 			Handler:    _CommissionService_ListCommissionCalculations_Handler,
 		},
+		{
+			MethodName: "ListCommissionDetails",
+			Handler:    _CommissionService_ListCommissionDetails_Handler,
+		},
 		{
 			MethodName: "ApproveCommission",
 			Handler:    _CommissionService_ApproveCommission_Handler,
@@ -557,10 +803,18 @@ var CommissionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RejectCommission",
 			Handler:    _CommissionService_RejectCommission_Handler,
 		},
+		{
+			MethodName: "UnapproveCommission",
+			Handler:    _CommissionService_UnapproveCommission_Handler,
+		},
 		{
 			MethodName: "BulkApproveCommissions",
 			Handler:    _CommissionService_BulkApproveCommissions_Handler,
 		},
+		{
+			MethodName: "GetCommissionHistory",
+			Handler:    _CommissionService_GetCommissionHistory_Handler,
+		},
 		{
 			MethodName: "PayCommission",
 			Handler:    _CommissionService_PayCommission_Handler,
@@ -569,6 +823,10 @@ var CommissionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCommissionPayment",
 			Handler:    _CommissionService_GetCommissionPayment_Handler,
 		},
+		{
+			MethodName: "VoidCommissionPayment",
+			Handler:    _CommissionService_VoidCommissionPayment_Handler,
+		},
 		{
 			MethodName: "GetCommissionSummary",
 			Handler:    _CommissionService_GetCommissionSummary_Handler,
@@ -577,10 +835,18 @@ var CommissionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCommissionReport",
 			Handler:    _CommissionService_GetCommissionReport_Handler,
 		},
+		{
+			MethodName: "GetCommissionAccrual",
+			Handler:    _CommissionService_GetCommissionAccrual_Handler,
+		},
 		{
 			MethodName: "GetCommissionSettings",
 			Handler:    _CommissionService_GetCommissionSettings_Handler,
 		},
+		{
+			MethodName: "ListCommissionBonusRules",
+			Handler:    _CommissionService_ListCommissionBonusRules_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "commissions/commision_service.proto",
@@ -30,6 +30,8 @@ const (
 	MovementType_MOVEMENT_TYPE_OUT         MovementType = 2
 	MovementType_MOVEMENT_TYPE_ADJUSTMENT  MovementType = 3
 	MovementType_MOVEMENT_TYPE_TRANSFER    MovementType = 4
+	MovementType_MOVEMENT_TYPE_RESERVE     MovementType = 5
+	MovementType_MOVEMENT_TYPE_RELEASE     MovementType = 6
 )
 
 // Enum value maps for MovementType.
@@ -40,6 +42,8 @@ var (
 		2: "MOVEMENT_TYPE_OUT",
 		3: "MOVEMENT_TYPE_ADJUSTMENT",
 		4: "MOVEMENT_TYPE_TRANSFER",
+		5: "MOVEMENT_TYPE_RESERVE",
+		6: "MOVEMENT_TYPE_RELEASE",
 	}
 	MovementType_value = map[string]int32{
 		"MOVEMENT_TYPE_UNSPECIFIED": 0,
@@ -47,6 +51,8 @@ var (
 		"MOVEMENT_TYPE_OUT":         2,
 		"MOVEMENT_TYPE_ADJUSTMENT":  3,
 		"MOVEMENT_TYPE_TRANSFER":    4,
+		"MOVEMENT_TYPE_RESERVE":     5,
+		"MOVEMENT_TYPE_RELEASE":     6,
 	}
 )
 
@@ -86,6 +92,7 @@ const (
 	ReferenceType_REFERENCE_TYPE_ADJUSTMENT  ReferenceType = 3
 	ReferenceType_REFERENCE_TYPE_TRANSFER    ReferenceType = 4
 	ReferenceType_REFERENCE_TYPE_RETURN      ReferenceType = 5
+	ReferenceType_REFERENCE_TYPE_RESERVATION ReferenceType = 6
 )
 
 // Enum value maps for ReferenceType.
@@ -97,6 +104,7 @@ var (
 		3: "REFERENCE_TYPE_ADJUSTMENT",
 		4: "REFERENCE_TYPE_TRANSFER",
 		5: "REFERENCE_TYPE_RETURN",
+		6: "REFERENCE_TYPE_RESERVATION",
 	}
 	ReferenceType_value = map[string]int32{
 		"REFERENCE_TYPE_UNSPECIFIED": 0,
@@ -105,6 +113,7 @@ var (
 		"REFERENCE_TYPE_ADJUSTMENT":  3,
 		"REFERENCE_TYPE_TRANSFER":    4,
 		"REFERENCE_TYPE_RETURN":      5,
+		"REFERENCE_TYPE_RESERVATION": 6,
 	}
 )
 
@@ -135,6 +144,214 @@ func (ReferenceType) EnumDescriptor() ([]byte, []int) {
 	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{1}
 }
 
+type AdjustmentReason int32
+
+const (
+	AdjustmentReason_ADJUSTMENT_REASON_UNSPECIFIED      AdjustmentReason = 0
+	AdjustmentReason_ADJUSTMENT_REASON_DAMAGE           AdjustmentReason = 1
+	AdjustmentReason_ADJUSTMENT_REASON_THEFT            AdjustmentReason = 2
+	AdjustmentReason_ADJUSTMENT_REASON_COUNT_CORRECTION AdjustmentReason = 3
+	AdjustmentReason_ADJUSTMENT_REASON_EXPIRY           AdjustmentReason = 4
+)
+
+// Enum value maps for AdjustmentReason.
+var (
+	AdjustmentReason_name = map[int32]string{
+		0: "ADJUSTMENT_REASON_UNSPECIFIED",
+		1: "ADJUSTMENT_REASON_DAMAGE",
+		2: "ADJUSTMENT_REASON_THEFT",
+		3: "ADJUSTMENT_REASON_COUNT_CORRECTION",
+		4: "ADJUSTMENT_REASON_EXPIRY",
+	}
+	AdjustmentReason_value = map[string]int32{
+		"ADJUSTMENT_REASON_UNSPECIFIED":      0,
+		"ADJUSTMENT_REASON_DAMAGE":           1,
+		"ADJUSTMENT_REASON_THEFT":            2,
+		"ADJUSTMENT_REASON_COUNT_CORRECTION": 3,
+		"ADJUSTMENT_REASON_EXPIRY":           4,
+	}
+)
+
+func (x AdjustmentReason) Enum() *AdjustmentReason {
+	p := new(AdjustmentReason)
+	*p = x
+	return p
+}
+
+func (x AdjustmentReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AdjustmentReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_inventory_service_proto_enumTypes[2].Descriptor()
+}
+
+func (AdjustmentReason) Type() protoreflect.EnumType {
+	return &file_inventory_inventory_service_proto_enumTypes[2]
+}
+
+func (x AdjustmentReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AdjustmentReason.Descriptor instead.
+func (AdjustmentReason) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{2}
+}
+
+type SerialStatus int32
+
+const (
+	SerialStatus_SERIAL_STATUS_UNSPECIFIED SerialStatus = 0
+	SerialStatus_SERIAL_STATUS_IN_STOCK    SerialStatus = 1
+	SerialStatus_SERIAL_STATUS_SOLD        SerialStatus = 2
+)
+
+// Enum value maps for SerialStatus.
+var (
+	SerialStatus_name = map[int32]string{
+		0: "SERIAL_STATUS_UNSPECIFIED",
+		1: "SERIAL_STATUS_IN_STOCK",
+		2: "SERIAL_STATUS_SOLD",
+	}
+	SerialStatus_value = map[string]int32{
+		"SERIAL_STATUS_UNSPECIFIED": 0,
+		"SERIAL_STATUS_IN_STOCK":    1,
+		"SERIAL_STATUS_SOLD":        2,
+	}
+)
+
+func (x SerialStatus) Enum() *SerialStatus {
+	p := new(SerialStatus)
+	*p = x
+	return p
+}
+
+func (x SerialStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SerialStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_inventory_service_proto_enumTypes[3].Descriptor()
+}
+
+func (SerialStatus) Type() protoreflect.EnumType {
+	return &file_inventory_inventory_service_proto_enumTypes[3]
+}
+
+func (x SerialStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SerialStatus.Descriptor instead.
+func (SerialStatus) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{3}
+}
+
+type PurchaseOrderStatus int32
+
+const (
+	PurchaseOrderStatus_PURCHASE_ORDER_STATUS_UNSPECIFIED        PurchaseOrderStatus = 0
+	PurchaseOrderStatus_PURCHASE_ORDER_STATUS_OPEN               PurchaseOrderStatus = 1
+	PurchaseOrderStatus_PURCHASE_ORDER_STATUS_PARTIALLY_RECEIVED PurchaseOrderStatus = 2
+	PurchaseOrderStatus_PURCHASE_ORDER_STATUS_RECEIVED           PurchaseOrderStatus = 3
+	PurchaseOrderStatus_PURCHASE_ORDER_STATUS_CANCELLED          PurchaseOrderStatus = 4
+)
+
+// Enum value maps for PurchaseOrderStatus.
+var (
+	PurchaseOrderStatus_name = map[int32]string{
+		0: "PURCHASE_ORDER_STATUS_UNSPECIFIED",
+		1: "PURCHASE_ORDER_STATUS_OPEN",
+		2: "PURCHASE_ORDER_STATUS_PARTIALLY_RECEIVED",
+		3: "PURCHASE_ORDER_STATUS_RECEIVED",
+		4: "PURCHASE_ORDER_STATUS_CANCELLED",
+	}
+	PurchaseOrderStatus_value = map[string]int32{
+		"PURCHASE_ORDER_STATUS_UNSPECIFIED":        0,
+		"PURCHASE_ORDER_STATUS_OPEN":               1,
+		"PURCHASE_ORDER_STATUS_PARTIALLY_RECEIVED": 2,
+		"PURCHASE_ORDER_STATUS_RECEIVED":           3,
+		"PURCHASE_ORDER_STATUS_CANCELLED":          4,
+	}
+)
+
+func (x PurchaseOrderStatus) Enum() *PurchaseOrderStatus {
+	p := new(PurchaseOrderStatus)
+	*p = x
+	return p
+}
+
+func (x PurchaseOrderStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PurchaseOrderStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_inventory_service_proto_enumTypes[4].Descriptor()
+}
+
+func (PurchaseOrderStatus) Type() protoreflect.EnumType {
+	return &file_inventory_inventory_service_proto_enumTypes[4]
+}
+
+func (x PurchaseOrderStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PurchaseOrderStatus.Descriptor instead.
+func (PurchaseOrderStatus) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{4}
+}
+
+type StockCountStatus int32
+
+const (
+	StockCountStatus_STOCK_COUNT_STATUS_UNSPECIFIED StockCountStatus = 0
+	StockCountStatus_STOCK_COUNT_STATUS_OPEN        StockCountStatus = 1
+	StockCountStatus_STOCK_COUNT_STATUS_FINALIZED   StockCountStatus = 2
+)
+
+// Enum value maps for StockCountStatus.
+var (
+	StockCountStatus_name = map[int32]string{
+		0: "STOCK_COUNT_STATUS_UNSPECIFIED",
+		1: "STOCK_COUNT_STATUS_OPEN",
+		2: "STOCK_COUNT_STATUS_FINALIZED",
+	}
+	StockCountStatus_value = map[string]int32{
+		"STOCK_COUNT_STATUS_UNSPECIFIED": 0,
+		"STOCK_COUNT_STATUS_OPEN":        1,
+		"STOCK_COUNT_STATUS_FINALIZED":   2,
+	}
+)
+
+func (x StockCountStatus) Enum() *StockCountStatus {
+	p := new(StockCountStatus)
+	*p = x
+	return p
+}
+
+func (x StockCountStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StockCountStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_inventory_service_proto_enumTypes[5].Descriptor()
+}
+
+func (StockCountStatus) Type() protoreflect.EnumType {
+	return &file_inventory_inventory_service_proto_enumTypes[5]
+}
+
+func (x StockCountStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StockCountStatus.Descriptor instead.
+func (StockCountStatus) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{5}
+}
+
 type PaginationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
@@ -298,6 +515,7 @@ type InventoryProduct struct {
 	ProductName   string                 `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
 	ProductTypeId int32                  `protobuf:"varint,4,opt,name=product_type_id,json=productTypeId,proto3" json:"product_type_id,omitempty"`
 	SupplierId    int32                  `protobuf:"varint,5,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
+	// Normalized code of one of the units returned by ListUnitsOfMeasure.
 	UnitOfMeasure string                 `protobuf:"bytes,6,opt,name=unit_of_measure,json=unitOfMeasure,proto3" json:"unit_of_measure,omitempty"`
 	ReorderLevel  int32                  `protobuf:"varint,7,opt,name=reorder_level,json=reorderLevel,proto3" json:"reorder_level,omitempty"`
 	MaxStockLevel int32                  `protobuf:"varint,8,opt,name=max_stock_level,json=maxStockLevel,proto3" json:"max_stock_level,omitempty"`
@@ -307,6 +525,10 @@ type InventoryProduct struct {
 	ProductType   *ProductType           `protobuf:"bytes,12,opt,name=product_type,json=productType,proto3,oneof" json:"product_type,omitempty"`
 	Supplier      *Supplier              `protobuf:"bytes,13,opt,name=supplier,proto3,oneof" json:"supplier,omitempty"`
 	Stocks        []*Stock               `protobuf:"bytes,14,rep,name=stocks,proto3" json:"stocks,omitempty"`
+	// Units are tracked individually in StockSerial.
+	IsSerialized bool `protobuf:"varint,15,opt,name=is_serialized,json=isSerialized,proto3" json:"is_serialized,omitempty"`
+	// Stock is held in StockLot rows and picked first-expiry-first-out.
+	IsLotTracked  bool `protobuf:"varint,16,opt,name=is_lot_tracked,json=isLotTracked,proto3" json:"is_lot_tracked,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -439,6 +661,80 @@ func (x *InventoryProduct) GetStocks() []*Stock {
 	return nil
 }
 
+func (x *InventoryProduct) GetIsSerialized() bool {
+	if x != nil {
+		return x.IsSerialized
+	}
+	return false
+}
+
+func (x *InventoryProduct) GetIsLotTracked() bool {
+	if x != nil {
+		return x.IsLotTracked
+	}
+	return false
+}
+
+type UnitOfMeasure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	UnitName      string                 `protobuf:"bytes,2,opt,name=unit_name,json=unitName,proto3" json:"unit_name,omitempty"`
+	Aliases       []string               `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnitOfMeasure) Reset() {
+	*x = UnitOfMeasure{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnitOfMeasure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnitOfMeasure) ProtoMessage() {}
+
+func (x *UnitOfMeasure) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnitOfMeasure.ProtoReflect.Descriptor instead.
+func (*UnitOfMeasure) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UnitOfMeasure) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *UnitOfMeasure) GetUnitName() string {
+	if x != nil {
+		return x.UnitName
+	}
+	return ""
+}
+
+func (x *UnitOfMeasure) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
 type Warehouse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -449,13 +745,19 @@ type Warehouse struct {
 	IsActive      bool                   `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Allows outbound movements to take available stock below zero
+	// (backorder).
+	AllowNegativeStock bool                   `protobuf:"varint,9,opt,name=allow_negative_stock,json=allowNegativeStock,proto3" json:"allow_negative_stock,omitempty"`
+	DeletedAt          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
+	CreatedBy          *int64                 `protobuf:"varint,11,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	UpdatedBy          *int64                 `protobuf:"varint,12,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *Warehouse) Reset() {
 	*x = Warehouse{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[4]
+	mi := &file_inventory_inventory_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -467,7 +769,7 @@ func (x *Warehouse) String() string {
 func (*Warehouse) ProtoMessage() {}
 
 func (x *Warehouse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[4]
+	mi := &file_inventory_inventory_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -480,7 +782,7 @@ func (x *Warehouse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Warehouse.ProtoReflect.Descriptor instead.
 func (*Warehouse) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{4}
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Warehouse) GetId() int32 {
@@ -539,6 +841,34 @@ func (x *Warehouse) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Warehouse) GetAllowNegativeStock() bool {
+	if x != nil {
+		return x.AllowNegativeStock
+	}
+	return false
+}
+
+func (x *Warehouse) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Warehouse) GetCreatedBy() int64 {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return 0
+}
+
+func (x *Warehouse) GetUpdatedBy() int64 {
+	if x != nil && x.UpdatedBy != nil {
+		return *x.UpdatedBy
+	}
+	return 0
+}
+
 type ProductType struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -546,13 +876,14 @@ type ProductType struct {
 	Description     *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
 	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	IsActive        bool                   `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ProductType) Reset() {
 	*x = ProductType{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[5]
+	mi := &file_inventory_inventory_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -564,7 +895,7 @@ func (x *ProductType) String() string {
 func (*ProductType) ProtoMessage() {}
 
 func (x *ProductType) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[5]
+	mi := &file_inventory_inventory_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -577,7 +908,7 @@ func (x *ProductType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductType.ProtoReflect.Descriptor instead.
 func (*ProductType) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{5}
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ProductType) GetId() int32 {
@@ -615,6 +946,13 @@ func (x *ProductType) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ProductType) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
 type Supplier struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -627,13 +965,16 @@ type Supplier struct {
 	IsActive      bool                   `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
+	CreatedBy     *int64                 `protobuf:"varint,12,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	UpdatedBy     *int64                 `protobuf:"varint,13,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Supplier) Reset() {
 	*x = Supplier{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[6]
+	mi := &file_inventory_inventory_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -645,7 +986,7 @@ func (x *Supplier) String() string {
 func (*Supplier) ProtoMessage() {}
 
 func (x *Supplier) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[6]
+	mi := &file_inventory_inventory_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -658,7 +999,7 @@ func (x *Supplier) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Supplier.ProtoReflect.Descriptor instead.
 func (*Supplier) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{6}
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Supplier) GetId() int32 {
@@ -731,6 +1072,27 @@ func (x *Supplier) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Supplier) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Supplier) GetCreatedBy() int64 {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return 0
+}
+
+func (x *Supplier) GetUpdatedBy() int64 {
+	if x != nil && x.UpdatedBy != nil {
+		return *x.UpdatedBy
+	}
+	return 0
+}
+
 type Stock struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -750,7 +1112,7 @@ type Stock struct {
 
 func (x *Stock) Reset() {
 	*x = Stock{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[7]
+	mi := &file_inventory_inventory_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -762,7 +1124,7 @@ func (x *Stock) String() string {
 func (*Stock) ProtoMessage() {}
 
 func (x *Stock) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[7]
+	mi := &file_inventory_inventory_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -775,7 +1137,7 @@ func (x *Stock) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Stock.ProtoReflect.Descriptor instead.
 func (*Stock) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{7}
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Stock) GetId() int64 {
@@ -868,13 +1230,17 @@ type StockMovement struct {
 	Notes         *string                `protobuf:"bytes,9,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
 	CreatedBy     int64                  `protobuf:"varint,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Cost of goods consumed by an outbound movement, from the FIFO or
+	// weighted-average cost layers.
+	CostOfGoods   *string           `protobuf:"bytes,12,opt,name=cost_of_goods,json=costOfGoods,proto3,oneof" json:"cost_of_goods,omitempty"`
+	ReasonCode    *AdjustmentReason `protobuf:"varint,13,opt,name=reason_code,json=reasonCode,proto3,enum=inventory.AdjustmentReason,oneof" json:"reason_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *StockMovement) Reset() {
 	*x = StockMovement{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[8]
+	mi := &file_inventory_inventory_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -886,7 +1252,7 @@ func (x *StockMovement) String() string {
 func (*StockMovement) ProtoMessage() {}
 
 func (x *StockMovement) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[8]
+	mi := &file_inventory_inventory_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -899,7 +1265,7 @@ func (x *StockMovement) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StockMovement.ProtoReflect.Descriptor instead.
 func (*StockMovement) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{8}
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *StockMovement) GetId() int64 {
@@ -979,31 +1345,49 @@ func (x *StockMovement) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-// Stock Operations
-type CheckStockRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ProductId        int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	WarehouseId      *int32                 `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3,oneof" json:"warehouse_id,omitempty"`
-	RequiredQuantity int32                  `protobuf:"varint,3,opt,name=required_quantity,json=requiredQuantity,proto3" json:"required_quantity,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *StockMovement) GetCostOfGoods() string {
+	if x != nil && x.CostOfGoods != nil {
+		return *x.CostOfGoods
+	}
+	return ""
 }
 
-func (x *CheckStockRequest) Reset() {
-	*x = CheckStockRequest{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[9]
+func (x *StockMovement) GetReasonCode() AdjustmentReason {
+	if x != nil && x.ReasonCode != nil {
+		return *x.ReasonCode
+	}
+	return AdjustmentReason_ADJUSTMENT_REASON_UNSPECIFIED
+}
+
+type StockLot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     int32                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	WarehouseId   int32                  `protobuf:"varint,3,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	LotNumber     string                 `protobuf:"bytes,4,opt,name=lot_number,json=lotNumber,proto3" json:"lot_number,omitempty"`
+	ExpiryDate    string                 `protobuf:"bytes,5,opt,name=expiry_date,json=expiryDate,proto3" json:"expiry_date,omitempty"`
+	Quantity      int32                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockLot) Reset() {
+	*x = StockLot{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckStockRequest) String() string {
+func (x *StockLot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckStockRequest) ProtoMessage() {}
+func (*StockLot) ProtoMessage() {}
 
-func (x *CheckStockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[9]
+func (x *StockLot) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1014,117 +1398,95 @@ func (x *CheckStockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckStockRequest.ProtoReflect.Descriptor instead.
-func (*CheckStockRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use StockLot.ProtoReflect.Descriptor instead.
+func (*StockLot) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CheckStockRequest) GetProductId() int32 {
+func (x *StockLot) GetId() int64 {
 	if x != nil {
-		return x.ProductId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *CheckStockRequest) GetWarehouseId() int32 {
-	if x != nil && x.WarehouseId != nil {
-		return *x.WarehouseId
+func (x *StockLot) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
 	}
 	return 0
 }
 
-func (x *CheckStockRequest) GetRequiredQuantity() int32 {
+func (x *StockLot) GetWarehouseId() int32 {
 	if x != nil {
-		return x.RequiredQuantity
+		return x.WarehouseId
 	}
 	return 0
 }
 
-type CheckStockResponse struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	IsAvailable            bool                   `protobuf:"varint,1,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
-	TotalAvailableQuantity int32                  `protobuf:"varint,2,opt,name=total_available_quantity,json=totalAvailableQuantity,proto3" json:"total_available_quantity,omitempty"`
-	StockDetails           []*Stock               `protobuf:"bytes,3,rep,name=stock_details,json=stockDetails,proto3" json:"stock_details,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
-}
-
-func (x *CheckStockResponse) Reset() {
-	*x = CheckStockResponse{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[10]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CheckStockResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CheckStockResponse) ProtoMessage() {}
-
-func (x *CheckStockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[10]
+func (x *StockLot) GetLotNumber() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.LotNumber
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CheckStockResponse.ProtoReflect.Descriptor instead.
-func (*CheckStockResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{10}
+func (x *StockLot) GetExpiryDate() string {
+	if x != nil {
+		return x.ExpiryDate
+	}
+	return ""
 }
 
-func (x *CheckStockResponse) GetIsAvailable() bool {
+func (x *StockLot) GetQuantity() int32 {
 	if x != nil {
-		return x.IsAvailable
+		return x.Quantity
 	}
-	return false
+	return 0
 }
 
-func (x *CheckStockResponse) GetTotalAvailableQuantity() int32 {
+func (x *StockLot) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TotalAvailableQuantity
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *CheckStockResponse) GetStockDetails() []*Stock {
+func (x *StockLot) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.StockDetails
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-type ReserveStockRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	WarehouseId   int32                  `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	ReferenceId   string                 `protobuf:"bytes,4,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
-	ReservedBy    int64                  `protobuf:"varint,5,opt,name=reserved_by,json=reservedBy,proto3" json:"reserved_by,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type StockSerial struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId       int32                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	WarehouseId     int32                  `protobuf:"varint,3,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	SerialNumber    string                 `protobuf:"bytes,4,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	Status          SerialStatus           `protobuf:"varint,5,opt,name=status,proto3,enum=inventory.SerialStatus" json:"status,omitempty"`
+	SoldReferenceId *string                `protobuf:"bytes,6,opt,name=sold_reference_id,json=soldReferenceId,proto3,oneof" json:"sold_reference_id,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ReserveStockRequest) Reset() {
-	*x = ReserveStockRequest{}
+func (x *StockSerial) Reset() {
+	*x = StockSerial{}
 	mi := &file_inventory_inventory_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReserveStockRequest) String() string {
+func (x *StockSerial) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReserveStockRequest) ProtoMessage() {}
+func (*StockSerial) ProtoMessage() {}
 
-func (x *ReserveStockRequest) ProtoReflect() protoreflect.Message {
+func (x *StockSerial) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_inventory_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1136,69 +1498,99 @@ func (x *ReserveStockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReserveStockRequest.ProtoReflect.Descriptor instead.
-func (*ReserveStockRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StockSerial.ProtoReflect.Descriptor instead.
+func (*StockSerial) Descriptor() ([]byte, []int) {
 	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ReserveStockRequest) GetProductId() int32 {
+func (x *StockSerial) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StockSerial) GetProductId() int32 {
 	if x != nil {
 		return x.ProductId
 	}
 	return 0
 }
 
-func (x *ReserveStockRequest) GetWarehouseId() int32 {
+func (x *StockSerial) GetWarehouseId() int32 {
 	if x != nil {
 		return x.WarehouseId
 	}
 	return 0
 }
 
-func (x *ReserveStockRequest) GetQuantity() int32 {
+func (x *StockSerial) GetSerialNumber() string {
 	if x != nil {
-		return x.Quantity
+		return x.SerialNumber
 	}
-	return 0
+	return ""
 }
 
-func (x *ReserveStockRequest) GetReferenceId() string {
+func (x *StockSerial) GetStatus() SerialStatus {
 	if x != nil {
-		return x.ReferenceId
+		return x.Status
+	}
+	return SerialStatus_SERIAL_STATUS_UNSPECIFIED
+}
+
+func (x *StockSerial) GetSoldReferenceId() string {
+	if x != nil && x.SoldReferenceId != nil {
+		return *x.SoldReferenceId
 	}
 	return ""
 }
 
-func (x *ReserveStockRequest) GetReservedBy() int64 {
+func (x *StockSerial) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ReservedBy
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-type ReserveStockResponse struct {
+func (x *StockSerial) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type PurchaseOrder struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UpdatedStock  *Stock                 `protobuf:"bytes,1,opt,name=updated_stock,json=updatedStock,proto3" json:"updated_stock,omitempty"`
-	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	Message       *string                `protobuf:"bytes,3,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PoNumber      string                 `protobuf:"bytes,2,opt,name=po_number,json=poNumber,proto3" json:"po_number,omitempty"`
+	SupplierId    int32                  `protobuf:"varint,3,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
+	WarehouseId   int32                  `protobuf:"varint,4,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Status        PurchaseOrderStatus    `protobuf:"varint,5,opt,name=status,proto3,enum=inventory.PurchaseOrderStatus" json:"status,omitempty"`
+	ExpectedDate  *string                `protobuf:"bytes,6,opt,name=expected_date,json=expectedDate,proto3,oneof" json:"expected_date,omitempty"`
+	Notes         *string                `protobuf:"bytes,7,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	CreatedBy     int64                  `protobuf:"varint,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Lines         []*PurchaseOrderLine   `protobuf:"bytes,11,rep,name=lines,proto3" json:"lines,omitempty"`
+	Supplier      *Supplier              `protobuf:"bytes,12,opt,name=supplier,proto3,oneof" json:"supplier,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReserveStockResponse) Reset() {
-	*x = ReserveStockResponse{}
+func (x *PurchaseOrder) Reset() {
+	*x = PurchaseOrder{}
 	mi := &file_inventory_inventory_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReserveStockResponse) String() string {
+func (x *PurchaseOrder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReserveStockResponse) ProtoMessage() {}
+func (*PurchaseOrder) ProtoMessage() {}
 
-func (x *ReserveStockResponse) ProtoReflect() protoreflect.Message {
+func (x *PurchaseOrder) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_inventory_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1210,57 +1602,123 @@ func (x *ReserveStockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReserveStockResponse.ProtoReflect.Descriptor instead.
-func (*ReserveStockResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PurchaseOrder.ProtoReflect.Descriptor instead.
+func (*PurchaseOrder) Descriptor() ([]byte, []int) {
 	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ReserveStockResponse) GetUpdatedStock() *Stock {
+func (x *PurchaseOrder) GetId() int64 {
 	if x != nil {
-		return x.UpdatedStock
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *ReserveStockResponse) GetSuccess() bool {
+func (x *PurchaseOrder) GetPoNumber() string {
 	if x != nil {
-		return x.Success
+		return x.PoNumber
 	}
-	return false
+	return ""
 }
 
-func (x *ReserveStockResponse) GetMessage() string {
-	if x != nil && x.Message != nil {
-		return *x.Message
+func (x *PurchaseOrder) GetSupplierId() int32 {
+	if x != nil {
+		return x.SupplierId
+	}
+	return 0
+}
+
+func (x *PurchaseOrder) GetWarehouseId() int32 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *PurchaseOrder) GetStatus() PurchaseOrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PurchaseOrderStatus_PURCHASE_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *PurchaseOrder) GetExpectedDate() string {
+	if x != nil && x.ExpectedDate != nil {
+		return *x.ExpectedDate
 	}
 	return ""
 }
 
-type ReleaseStockRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	WarehouseId   int32                  `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	ReferenceId   string                 `protobuf:"bytes,4,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
-	ReleasedBy    int64                  `protobuf:"varint,5,opt,name=released_by,json=releasedBy,proto3" json:"released_by,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PurchaseOrder) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
 }
 
-func (x *ReleaseStockRequest) Reset() {
-	*x = ReleaseStockRequest{}
+func (x *PurchaseOrder) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *PurchaseOrder) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PurchaseOrder) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *PurchaseOrder) GetLines() []*PurchaseOrderLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *PurchaseOrder) GetSupplier() *Supplier {
+	if x != nil {
+		return x.Supplier
+	}
+	return nil
+}
+
+type PurchaseOrderLine struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PurchaseOrderId  int64                  `protobuf:"varint,2,opt,name=purchase_order_id,json=purchaseOrderId,proto3" json:"purchase_order_id,omitempty"`
+	ProductId        int32                  `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	OrderedQuantity  int32                  `protobuf:"varint,4,opt,name=ordered_quantity,json=orderedQuantity,proto3" json:"ordered_quantity,omitempty"`
+	ReceivedQuantity int32                  `protobuf:"varint,5,opt,name=received_quantity,json=receivedQuantity,proto3" json:"received_quantity,omitempty"`
+	UnitCost         string                 `protobuf:"bytes,6,opt,name=unit_cost,json=unitCost,proto3" json:"unit_cost,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PurchaseOrderLine) Reset() {
+	*x = PurchaseOrderLine{}
 	mi := &file_inventory_inventory_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReleaseStockRequest) String() string {
+func (x *PurchaseOrderLine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseStockRequest) ProtoMessage() {}
+func (*PurchaseOrderLine) ProtoMessage() {}
 
-func (x *ReleaseStockRequest) ProtoReflect() protoreflect.Message {
+func (x *PurchaseOrderLine) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_inventory_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1272,69 +1730,97 @@ func (x *ReleaseStockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseStockRequest.ProtoReflect.Descriptor instead.
-func (*ReleaseStockRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PurchaseOrderLine.ProtoReflect.Descriptor instead.
+func (*PurchaseOrderLine) Descriptor() ([]byte, []int) {
 	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ReleaseStockRequest) GetProductId() int32 {
+func (x *PurchaseOrderLine) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLine) GetPurchaseOrderId() int64 {
+	if x != nil {
+		return x.PurchaseOrderId
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLine) GetProductId() int32 {
 	if x != nil {
 		return x.ProductId
 	}
 	return 0
 }
 
-func (x *ReleaseStockRequest) GetWarehouseId() int32 {
+func (x *PurchaseOrderLine) GetOrderedQuantity() int32 {
 	if x != nil {
-		return x.WarehouseId
+		return x.OrderedQuantity
 	}
 	return 0
 }
 
-func (x *ReleaseStockRequest) GetQuantity() int32 {
+func (x *PurchaseOrderLine) GetReceivedQuantity() int32 {
 	if x != nil {
-		return x.Quantity
+		return x.ReceivedQuantity
 	}
 	return 0
 }
 
-func (x *ReleaseStockRequest) GetReferenceId() string {
+func (x *PurchaseOrderLine) GetUnitCost() string {
 	if x != nil {
-		return x.ReferenceId
+		return x.UnitCost
 	}
 	return ""
 }
 
-func (x *ReleaseStockRequest) GetReleasedBy() int64 {
+func (x *PurchaseOrderLine) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ReleasedBy
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-type ReleaseStockResponse struct {
+func (x *PurchaseOrderLine) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type StockCount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UpdatedStock  *Stock                 `protobuf:"bytes,1,opt,name=updated_stock,json=updatedStock,proto3" json:"updated_stock,omitempty"`
-	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	Message       *string                `protobuf:"bytes,3,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WarehouseId   int32                  `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Status        StockCountStatus       `protobuf:"varint,3,opt,name=status,proto3,enum=inventory.StockCountStatus" json:"status,omitempty"`
+	Notes         *string                `protobuf:"bytes,4,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	StartedBy     int64                  `protobuf:"varint,5,opt,name=started_by,json=startedBy,proto3" json:"started_by,omitempty"`
+	FinalizedBy   *int64                 `protobuf:"varint,6,opt,name=finalized_by,json=finalizedBy,proto3,oneof" json:"finalized_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	FinalizedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=finalized_at,json=finalizedAt,proto3,oneof" json:"finalized_at,omitempty"`
+	Lines         []*StockCountLine      `protobuf:"bytes,10,rep,name=lines,proto3" json:"lines,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReleaseStockResponse) Reset() {
-	*x = ReleaseStockResponse{}
+func (x *StockCount) Reset() {
+	*x = StockCount{}
 	mi := &file_inventory_inventory_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReleaseStockResponse) String() string {
+func (x *StockCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseStockResponse) ProtoMessage() {}
+func (*StockCount) ProtoMessage() {}
 
-func (x *ReleaseStockResponse) ProtoReflect() protoreflect.Message {
+func (x *StockCount) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_inventory_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1346,61 +1832,109 @@ func (x *ReleaseStockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseStockResponse.ProtoReflect.Descriptor instead.
-func (*ReleaseStockResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StockCount.ProtoReflect.Descriptor instead.
+func (*StockCount) Descriptor() ([]byte, []int) {
 	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ReleaseStockResponse) GetUpdatedStock() *Stock {
+func (x *StockCount) GetId() int64 {
 	if x != nil {
-		return x.UpdatedStock
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *ReleaseStockResponse) GetSuccess() bool {
+func (x *StockCount) GetWarehouseId() int32 {
 	if x != nil {
-		return x.Success
+		return x.WarehouseId
 	}
-	return false
+	return 0
 }
 
-func (x *ReleaseStockResponse) GetMessage() string {
-	if x != nil && x.Message != nil {
-		return *x.Message
+func (x *StockCount) GetStatus() StockCountStatus {
+	if x != nil {
+		return x.Status
+	}
+	return StockCountStatus_STOCK_COUNT_STATUS_UNSPECIFIED
+}
+
+func (x *StockCount) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
 	}
 	return ""
 }
 
-type UpdateStockRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	WarehouseId   int32                  `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
-	MovementType  MovementType           `protobuf:"varint,3,opt,name=movement_type,json=movementType,proto3,enum=inventory.MovementType" json:"movement_type,omitempty"`
-	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	UnitCost      *string                `protobuf:"bytes,5,opt,name=unit_cost,json=unitCost,proto3,oneof" json:"unit_cost,omitempty"`
-	ReferenceType ReferenceType          `protobuf:"varint,6,opt,name=reference_type,json=referenceType,proto3,enum=inventory.ReferenceType" json:"reference_type,omitempty"`
-	ReferenceId   *string                `protobuf:"bytes,7,opt,name=reference_id,json=referenceId,proto3,oneof" json:"reference_id,omitempty"`
-	Notes         *string                `protobuf:"bytes,8,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
-	CreatedBy     int64                  `protobuf:"varint,9,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *StockCount) GetStartedBy() int64 {
+	if x != nil {
+		return x.StartedBy
+	}
+	return 0
 }
 
-func (x *UpdateStockRequest) Reset() {
-	*x = UpdateStockRequest{}
+func (x *StockCount) GetFinalizedBy() int64 {
+	if x != nil && x.FinalizedBy != nil {
+		return *x.FinalizedBy
+	}
+	return 0
+}
+
+func (x *StockCount) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *StockCount) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *StockCount) GetFinalizedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinalizedAt
+	}
+	return nil
+}
+
+func (x *StockCount) GetLines() []*StockCountLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type StockCountLine struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StockCountId     int64                  `protobuf:"varint,2,opt,name=stock_count_id,json=stockCountId,proto3" json:"stock_count_id,omitempty"`
+	ProductId        int32                  `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ExpectedQuantity int32                  `protobuf:"varint,4,opt,name=expected_quantity,json=expectedQuantity,proto3" json:"expected_quantity,omitempty"`
+	CountedQuantity  int32                  `protobuf:"varint,5,opt,name=counted_quantity,json=countedQuantity,proto3" json:"counted_quantity,omitempty"`
+	Variance         int32                  `protobuf:"varint,6,opt,name=variance,proto3" json:"variance,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StockCountLine) Reset() {
+	*x = StockCountLine{}
 	mi := &file_inventory_inventory_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateStockRequest) String() string {
+func (x *StockCountLine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateStockRequest) ProtoMessage() {}
+func (*StockCountLine) ProtoMessage() {}
 
-func (x *UpdateStockRequest) ProtoReflect() protoreflect.Message {
+func (x *StockCountLine) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_inventory_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1412,96 +1946,91 @@ func (x *UpdateStockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateStockRequest.ProtoReflect.Descriptor instead.
-func (*UpdateStockRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StockCountLine.ProtoReflect.Descriptor instead.
+func (*StockCountLine) Descriptor() ([]byte, []int) {
 	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *UpdateStockRequest) GetProductId() int32 {
+func (x *StockCountLine) GetId() int64 {
 	if x != nil {
-		return x.ProductId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *UpdateStockRequest) GetWarehouseId() int32 {
+func (x *StockCountLine) GetStockCountId() int64 {
 	if x != nil {
-		return x.WarehouseId
+		return x.StockCountId
 	}
 	return 0
 }
 
-func (x *UpdateStockRequest) GetMovementType() MovementType {
+func (x *StockCountLine) GetProductId() int32 {
 	if x != nil {
-		return x.MovementType
+		return x.ProductId
 	}
-	return MovementType_MOVEMENT_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *UpdateStockRequest) GetQuantity() int32 {
+func (x *StockCountLine) GetExpectedQuantity() int32 {
 	if x != nil {
-		return x.Quantity
+		return x.ExpectedQuantity
 	}
 	return 0
 }
 
-func (x *UpdateStockRequest) GetUnitCost() string {
-	if x != nil && x.UnitCost != nil {
-		return *x.UnitCost
-	}
-	return ""
-}
-
-func (x *UpdateStockRequest) GetReferenceType() ReferenceType {
+func (x *StockCountLine) GetCountedQuantity() int32 {
 	if x != nil {
-		return x.ReferenceType
+		return x.CountedQuantity
 	}
-	return ReferenceType_REFERENCE_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *UpdateStockRequest) GetReferenceId() string {
-	if x != nil && x.ReferenceId != nil {
-		return *x.ReferenceId
+func (x *StockCountLine) GetVariance() int32 {
+	if x != nil {
+		return x.Variance
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateStockRequest) GetNotes() string {
-	if x != nil && x.Notes != nil {
-		return *x.Notes
+func (x *StockCountLine) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateStockRequest) GetCreatedBy() int64 {
+func (x *StockCountLine) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CreatedBy
+		return x.UpdatedAt
 	}
-	return 0
+	return nil
 }
 
-type UpdateStockResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StockMovement *StockMovement         `protobuf:"bytes,1,opt,name=stock_movement,json=stockMovement,proto3" json:"stock_movement,omitempty"`
-	UpdatedStock  *Stock                 `protobuf:"bytes,2,opt,name=updated_stock,json=updatedStock,proto3" json:"updated_stock,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Stock Operations
+type CheckStockRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProductId        int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	WarehouseId      *int32                 `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3,oneof" json:"warehouse_id,omitempty"`
+	RequiredQuantity int32                  `protobuf:"varint,3,opt,name=required_quantity,json=requiredQuantity,proto3" json:"required_quantity,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *UpdateStockResponse) Reset() {
-	*x = UpdateStockResponse{}
+func (x *CheckStockRequest) Reset() {
+	*x = CheckStockRequest{}
 	mi := &file_inventory_inventory_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateStockResponse) String() string {
+func (x *CheckStockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateStockResponse) ProtoMessage() {}
+func (*CheckStockRequest) ProtoMessage() {}
 
-func (x *UpdateStockResponse) ProtoReflect() protoreflect.Message {
+func (x *CheckStockRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_inventory_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1513,47 +2042,55 @@ func (x *UpdateStockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateStockResponse.ProtoReflect.Descriptor instead.
-func (*UpdateStockResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckStockRequest.ProtoReflect.Descriptor instead.
+func (*CheckStockRequest) Descriptor() ([]byte, []int) {
 	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *UpdateStockResponse) GetStockMovement() *StockMovement {
+func (x *CheckStockRequest) GetProductId() int32 {
 	if x != nil {
-		return x.StockMovement
+		return x.ProductId
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateStockResponse) GetUpdatedStock() *Stock {
+func (x *CheckStockRequest) GetWarehouseId() int32 {
+	if x != nil && x.WarehouseId != nil {
+		return *x.WarehouseId
+	}
+	return 0
+}
+
+func (x *CheckStockRequest) GetRequiredQuantity() int32 {
 	if x != nil {
-		return x.UpdatedStock
+		return x.RequiredQuantity
 	}
-	return nil
+	return 0
 }
 
-type GetStockRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	WarehouseId   *int32                 `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3,oneof" json:"warehouse_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CheckStockResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	IsAvailable            bool                   `protobuf:"varint,1,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
+	TotalAvailableQuantity int32                  `protobuf:"varint,2,opt,name=total_available_quantity,json=totalAvailableQuantity,proto3" json:"total_available_quantity,omitempty"`
+	StockDetails           []*Stock               `protobuf:"bytes,3,rep,name=stock_details,json=stockDetails,proto3" json:"stock_details,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *GetStockRequest) Reset() {
-	*x = GetStockRequest{}
+func (x *CheckStockResponse) Reset() {
+	*x = CheckStockResponse{}
 	mi := &file_inventory_inventory_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStockRequest) String() string {
+func (x *CheckStockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStockRequest) ProtoMessage() {}
+func (*CheckStockResponse) ProtoMessage() {}
 
-func (x *GetStockRequest) ProtoReflect() protoreflect.Message {
+func (x *CheckStockResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_inventory_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1565,46 +2102,55 @@ func (x *GetStockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStockRequest.ProtoReflect.Descriptor instead.
-func (*GetStockRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckStockResponse.ProtoReflect.Descriptor instead.
+func (*CheckStockResponse) Descriptor() ([]byte, []int) {
 	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetStockRequest) GetProductId() int32 {
+func (x *CheckStockResponse) GetIsAvailable() bool {
 	if x != nil {
-		return x.ProductId
+		return x.IsAvailable
 	}
-	return 0
+	return false
 }
 
-func (x *GetStockRequest) GetWarehouseId() int32 {
-	if x != nil && x.WarehouseId != nil {
-		return *x.WarehouseId
+func (x *CheckStockResponse) GetTotalAvailableQuantity() int32 {
+	if x != nil {
+		return x.TotalAvailableQuantity
 	}
 	return 0
 }
 
-type GetStockResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Stocks        []*Stock               `protobuf:"bytes,1,rep,name=stocks,proto3" json:"stocks,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CheckStockResponse) GetStockDetails() []*Stock {
+	if x != nil {
+		return x.StockDetails
+	}
+	return nil
 }
 
-func (x *GetStockResponse) Reset() {
-	*x = GetStockResponse{}
+type CheckStockBatchLine struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProductId        int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	RequiredQuantity int32                  `protobuf:"varint,2,opt,name=required_quantity,json=requiredQuantity,proto3" json:"required_quantity,omitempty"`
+	WarehouseId      *int32                 `protobuf:"varint,3,opt,name=warehouse_id,json=warehouseId,proto3,oneof" json:"warehouse_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CheckStockBatchLine) Reset() {
+	*x = CheckStockBatchLine{}
 	mi := &file_inventory_inventory_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStockResponse) String() string {
+func (x *CheckStockBatchLine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStockResponse) ProtoMessage() {}
+func (*CheckStockBatchLine) ProtoMessage() {}
 
-func (x *GetStockResponse) ProtoReflect() protoreflect.Message {
+func (x *CheckStockBatchLine) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_inventory_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1616,40 +2162,53 @@ func (x *GetStockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStockResponse.ProtoReflect.Descriptor instead.
-func (*GetStockResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckStockBatchLine.ProtoReflect.Descriptor instead.
+func (*CheckStockBatchLine) Descriptor() ([]byte, []int) {
 	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetStockResponse) GetStocks() []*Stock {
+func (x *CheckStockBatchLine) GetProductId() int32 {
 	if x != nil {
-		return x.Stocks
+		return x.ProductId
 	}
-	return nil
+	return 0
 }
 
-type ListLowStockRequest struct {
+func (x *CheckStockBatchLine) GetRequiredQuantity() int32 {
+	if x != nil {
+		return x.RequiredQuantity
+	}
+	return 0
+}
+
+func (x *CheckStockBatchLine) GetWarehouseId() int32 {
+	if x != nil && x.WarehouseId != nil {
+		return *x.WarehouseId
+	}
+	return 0
+}
+
+type CheckStockBatchRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	WarehouseId   *int32                 `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3,oneof" json:"warehouse_id,omitempty"`
-	Pagination    *PaginationRequest     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Lines         []*CheckStockBatchLine `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListLowStockRequest) Reset() {
-	*x = ListLowStockRequest{}
+func (x *CheckStockBatchRequest) Reset() {
+	*x = CheckStockBatchRequest{}
 	mi := &file_inventory_inventory_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListLowStockRequest) String() string {
+func (x *CheckStockBatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLowStockRequest) ProtoMessage() {}
+func (*CheckStockBatchRequest) ProtoMessage() {}
 
-func (x *ListLowStockRequest) ProtoReflect() protoreflect.Message {
+func (x *CheckStockBatchRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_inventory_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1661,47 +2220,43 @@ func (x *ListLowStockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLowStockRequest.ProtoReflect.Descriptor instead.
-func (*ListLowStockRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckStockBatchRequest.ProtoReflect.Descriptor instead.
+func (*CheckStockBatchRequest) Descriptor() ([]byte, []int) {
 	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ListLowStockRequest) GetWarehouseId() int32 {
-	if x != nil && x.WarehouseId != nil {
-		return *x.WarehouseId
-	}
-	return 0
-}
-
-func (x *ListLowStockRequest) GetPagination() *PaginationRequest {
+func (x *CheckStockBatchRequest) GetLines() []*CheckStockBatchLine {
 	if x != nil {
-		return x.Pagination
+		return x.Lines
 	}
 	return nil
 }
 
-type ListLowStockResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	LowStocks     []*Stock               `protobuf:"bytes,1,rep,name=low_stocks,json=lowStocks,proto3" json:"low_stocks,omitempty"`
-	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CheckStockBatchResult struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	ProductId              int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	WarehouseId            *int32                 `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3,oneof" json:"warehouse_id,omitempty"`
+	RequiredQuantity       int32                  `protobuf:"varint,3,opt,name=required_quantity,json=requiredQuantity,proto3" json:"required_quantity,omitempty"`
+	TotalAvailableQuantity int32                  `protobuf:"varint,4,opt,name=total_available_quantity,json=totalAvailableQuantity,proto3" json:"total_available_quantity,omitempty"`
+	IsAvailable            bool                   `protobuf:"varint,5,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *ListLowStockResponse) Reset() {
-	*x = ListLowStockResponse{}
+func (x *CheckStockBatchResult) Reset() {
+	*x = CheckStockBatchResult{}
 	mi := &file_inventory_inventory_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListLowStockResponse) String() string {
+func (x *CheckStockBatchResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLowStockResponse) ProtoMessage() {}
+func (*CheckStockBatchResult) ProtoMessage() {}
 
-func (x *ListLowStockResponse) ProtoReflect() protoreflect.Message {
+func (x *CheckStockBatchResult) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_inventory_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1713,51 +2268,68 @@ func (x *ListLowStockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLowStockResponse.ProtoReflect.Descriptor instead.
-func (*ListLowStockResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckStockBatchResult.ProtoReflect.Descriptor instead.
+func (*CheckStockBatchResult) Descriptor() ([]byte, []int) {
 	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ListLowStockResponse) GetLowStocks() []*Stock {
+func (x *CheckStockBatchResult) GetProductId() int32 {
 	if x != nil {
-		return x.LowStocks
+		return x.ProductId
 	}
-	return nil
+	return 0
 }
 
-func (x *ListLowStockResponse) GetPagination() *PaginationResponse {
+func (x *CheckStockBatchResult) GetWarehouseId() int32 {
+	if x != nil && x.WarehouseId != nil {
+		return *x.WarehouseId
+	}
+	return 0
+}
+
+func (x *CheckStockBatchResult) GetRequiredQuantity() int32 {
 	if x != nil {
-		return x.Pagination
+		return x.RequiredQuantity
 	}
-	return nil
+	return 0
 }
 
-// Stock Movement Operations
-type ListStockMovementsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pagination    *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	ProductId     *int32                 `protobuf:"varint,2,opt,name=product_id,json=productId,proto3,oneof" json:"product_id,omitempty"`
-	WarehouseId   *int32                 `protobuf:"varint,3,opt,name=warehouse_id,json=warehouseId,proto3,oneof" json:"warehouse_id,omitempty"`
-	MovementType  *MovementType          `protobuf:"varint,4,opt,name=movement_type,json=movementType,proto3,enum=inventory.MovementType,oneof" json:"movement_type,omitempty"`
-	DateRange     *DateRange             `protobuf:"bytes,5,opt,name=date_range,json=dateRange,proto3,oneof" json:"date_range,omitempty"`
+func (x *CheckStockBatchResult) GetTotalAvailableQuantity() int32 {
+	if x != nil {
+		return x.TotalAvailableQuantity
+	}
+	return 0
+}
+
+func (x *CheckStockBatchResult) GetIsAvailable() bool {
+	if x != nil {
+		return x.IsAvailable
+	}
+	return false
+}
+
+type CheckStockBatchResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Results       []*CheckStockBatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	AllAvailable  bool                     `protobuf:"varint,2,opt,name=all_available,json=allAvailable,proto3" json:"all_available,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListStockMovementsRequest) Reset() {
-	*x = ListStockMovementsRequest{}
+func (x *CheckStockBatchResponse) Reset() {
+	*x = CheckStockBatchResponse{}
 	mi := &file_inventory_inventory_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListStockMovementsRequest) String() string {
+func (x *CheckStockBatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListStockMovementsRequest) ProtoMessage() {}
+func (*CheckStockBatchResponse) ProtoMessage() {}
 
-func (x *ListStockMovementsRequest) ProtoReflect() protoreflect.Message {
+func (x *CheckStockBatchResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_inventory_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1769,68 +2341,53 @@ func (x *ListStockMovementsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListStockMovementsRequest.ProtoReflect.Descriptor instead.
-func (*ListStockMovementsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckStockBatchResponse.ProtoReflect.Descriptor instead.
+func (*CheckStockBatchResponse) Descriptor() ([]byte, []int) {
 	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ListStockMovementsRequest) GetPagination() *PaginationRequest {
+func (x *CheckStockBatchResponse) GetResults() []*CheckStockBatchResult {
 	if x != nil {
-		return x.Pagination
+		return x.Results
 	}
 	return nil
 }
 
-func (x *ListStockMovementsRequest) GetProductId() int32 {
-	if x != nil && x.ProductId != nil {
-		return *x.ProductId
-	}
-	return 0
-}
-
-func (x *ListStockMovementsRequest) GetWarehouseId() int32 {
-	if x != nil && x.WarehouseId != nil {
-		return *x.WarehouseId
-	}
-	return 0
-}
-
-func (x *ListStockMovementsRequest) GetMovementType() MovementType {
-	if x != nil && x.MovementType != nil {
-		return *x.MovementType
-	}
-	return MovementType_MOVEMENT_TYPE_UNSPECIFIED
-}
-
-func (x *ListStockMovementsRequest) GetDateRange() *DateRange {
+func (x *CheckStockBatchResponse) GetAllAvailable() bool {
 	if x != nil {
-		return x.DateRange
+		return x.AllAvailable
 	}
-	return nil
+	return false
 }
 
-type ListStockMovementsResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	StockMovements []*StockMovement       `protobuf:"bytes,1,rep,name=stock_movements,json=stockMovements,proto3" json:"stock_movements,omitempty"`
-	Pagination     *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ReserveStockRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ProductId   int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	WarehouseId int32                  `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Quantity    int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	ReferenceId string                 `protobuf:"bytes,4,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	ReservedBy  int64                  `protobuf:"varint,5,opt,name=reserved_by,json=reservedBy,proto3" json:"reserved_by,omitempty"`
+	// Unreleased reservations past this time are returned to available
+	// stock by ReleaseExpiredReservations.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListStockMovementsResponse) Reset() {
-	*x = ListStockMovementsResponse{}
+func (x *ReserveStockRequest) Reset() {
+	*x = ReserveStockRequest{}
 	mi := &file_inventory_inventory_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListStockMovementsResponse) String() string {
+func (x *ReserveStockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListStockMovementsResponse) ProtoMessage() {}
+func (*ReserveStockRequest) ProtoMessage() {}
 
-func (x *ListStockMovementsResponse) ProtoReflect() protoreflect.Message {
+func (x *ReserveStockRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_inventory_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1842,53 +2399,76 @@ func (x *ListStockMovementsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListStockMovementsResponse.ProtoReflect.Descriptor instead.
-func (*ListStockMovementsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReserveStockRequest.ProtoReflect.Descriptor instead.
+func (*ReserveStockRequest) Descriptor() ([]byte, []int) {
 	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ListStockMovementsResponse) GetStockMovements() []*StockMovement {
+func (x *ReserveStockRequest) GetProductId() int32 {
 	if x != nil {
-		return x.StockMovements
+		return x.ProductId
 	}
-	return nil
+	return 0
 }
 
-func (x *ListStockMovementsResponse) GetPagination() *PaginationResponse {
+func (x *ReserveStockRequest) GetWarehouseId() int32 {
 	if x != nil {
-		return x.Pagination
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ReserveStockRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReserveStockRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *ReserveStockRequest) GetReservedBy() int64 {
+	if x != nil {
+		return x.ReservedBy
+	}
+	return 0
+}
+
+func (x *ReserveStockRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-// Product Operations
-type CreateProductRequest struct {
+type ReserveStockResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductCode   string                 `protobuf:"bytes,1,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`
-	ProductName   string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
-	ProductTypeId int32                  `protobuf:"varint,3,opt,name=product_type_id,json=productTypeId,proto3" json:"product_type_id,omitempty"`
-	SupplierId    int32                  `protobuf:"varint,4,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
-	UnitOfMeasure *string                `protobuf:"bytes,5,opt,name=unit_of_measure,json=unitOfMeasure,proto3,oneof" json:"unit_of_measure,omitempty"`
-	ReorderLevel  *int32                 `protobuf:"varint,6,opt,name=reorder_level,json=reorderLevel,proto3,oneof" json:"reorder_level,omitempty"`
-	MaxStockLevel *int32                 `protobuf:"varint,7,opt,name=max_stock_level,json=maxStockLevel,proto3,oneof" json:"max_stock_level,omitempty"`
+	UpdatedStock  *Stock                 `protobuf:"bytes,1,opt,name=updated_stock,json=updatedStock,proto3" json:"updated_stock,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       *string                `protobuf:"bytes,3,opt,name=message,proto3,oneof" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProductRequest) Reset() {
-	*x = CreateProductRequest{}
+func (x *ReserveStockResponse) Reset() {
+	*x = ReserveStockResponse{}
 	mi := &file_inventory_inventory_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProductRequest) String() string {
+func (x *ReserveStockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProductRequest) ProtoMessage() {}
+func (*ReserveStockResponse) ProtoMessage() {}
 
-func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
+func (x *ReserveStockResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_inventory_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1900,81 +2480,57 @@ func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
-func (*CreateProductRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReserveStockResponse.ProtoReflect.Descriptor instead.
+func (*ReserveStockResponse) Descriptor() ([]byte, []int) {
 	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *CreateProductRequest) GetProductCode() string {
-	if x != nil {
-		return x.ProductCode
-	}
-	return ""
-}
-
-func (x *CreateProductRequest) GetProductName() string {
-	if x != nil {
-		return x.ProductName
-	}
-	return ""
-}
-
-func (x *CreateProductRequest) GetProductTypeId() int32 {
+func (x *ReserveStockResponse) GetUpdatedStock() *Stock {
 	if x != nil {
-		return x.ProductTypeId
+		return x.UpdatedStock
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateProductRequest) GetSupplierId() int32 {
+func (x *ReserveStockResponse) GetSuccess() bool {
 	if x != nil {
-		return x.SupplierId
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *CreateProductRequest) GetUnitOfMeasure() string {
-	if x != nil && x.UnitOfMeasure != nil {
-		return *x.UnitOfMeasure
+func (x *ReserveStockResponse) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
 	}
 	return ""
 }
 
-func (x *CreateProductRequest) GetReorderLevel() int32 {
-	if x != nil && x.ReorderLevel != nil {
-		return *x.ReorderLevel
-	}
-	return 0
-}
-
-func (x *CreateProductRequest) GetMaxStockLevel() int32 {
-	if x != nil && x.MaxStockLevel != nil {
-		return *x.MaxStockLevel
-	}
-	return 0
-}
-
-type CreateProductResponse struct {
+type ReleaseStockRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Product       *InventoryProduct      `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	ProductId     int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	WarehouseId   int32                  `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	ReferenceId   string                 `protobuf:"bytes,4,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	ReleasedBy    int64                  `protobuf:"varint,5,opt,name=released_by,json=releasedBy,proto3" json:"released_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProductResponse) Reset() {
-	*x = CreateProductResponse{}
+func (x *ReleaseStockRequest) Reset() {
+	*x = ReleaseStockRequest{}
 	mi := &file_inventory_inventory_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProductResponse) String() string {
+func (x *ReleaseStockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProductResponse) ProtoMessage() {}
+func (*ReleaseStockRequest) ProtoMessage() {}
 
-func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
+func (x *ReleaseStockRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_inventory_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1986,46 +2542,69 @@ func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProductResponse.ProtoReflect.Descriptor instead.
-func (*CreateProductResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReleaseStockRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseStockRequest) Descriptor() ([]byte, []int) {
 	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *CreateProductResponse) GetProduct() *InventoryProduct {
+func (x *ReleaseStockRequest) GetProductId() int32 {
 	if x != nil {
-		return x.Product
+		return x.ProductId
 	}
-	return nil
+	return 0
 }
 
-type UpdateProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ProductName   *string                `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3,oneof" json:"product_name,omitempty"`
-	ProductTypeId *int32                 `protobuf:"varint,3,opt,name=product_type_id,json=productTypeId,proto3,oneof" json:"product_type_id,omitempty"`
-	SupplierId    *int32                 `protobuf:"varint,4,opt,name=supplier_id,json=supplierId,proto3,oneof" json:"supplier_id,omitempty"`
-	UnitOfMeasure *string                `protobuf:"bytes,5,opt,name=unit_of_measure,json=unitOfMeasure,proto3,oneof" json:"unit_of_measure,omitempty"`
-	ReorderLevel  *int32                 `protobuf:"varint,6,opt,name=reorder_level,json=reorderLevel,proto3,oneof" json:"reorder_level,omitempty"`
-	MaxStockLevel *int32                 `protobuf:"varint,7,opt,name=max_stock_level,json=maxStockLevel,proto3,oneof" json:"max_stock_level,omitempty"`
-	IsActive      *bool                  `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
+func (x *ReleaseStockRequest) GetWarehouseId() int32 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
 
-func (x *UpdateProductRequest) Reset() {
-	*x = UpdateProductRequest{}
+func (x *ReleaseStockRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReleaseStockRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *ReleaseStockRequest) GetReleasedBy() int64 {
+	if x != nil {
+		return x.ReleasedBy
+	}
+	return 0
+}
+
+type ReleaseStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UpdatedStock  *Stock                 `protobuf:"bytes,1,opt,name=updated_stock,json=updatedStock,proto3" json:"updated_stock,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       *string                `protobuf:"bytes,3,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseStockResponse) Reset() {
+	*x = ReleaseStockResponse{}
 	mi := &file_inventory_inventory_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProductRequest) String() string {
+func (x *ReleaseStockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductRequest) ProtoMessage() {}
+func (*ReleaseStockResponse) ProtoMessage() {}
 
-func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+func (x *ReleaseStockResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_inventory_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2037,89 +2616,4293 @@ func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ReleaseStockResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReleaseStockResponse) GetUpdatedStock() *Stock {
+	if x != nil {
+		return x.UpdatedStock
+	}
+	return nil
+}
+
+func (x *ReleaseStockResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReleaseStockResponse) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+type ReleaseReservationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReferenceId   string                 `protobuf:"bytes,1,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	ReleasedBy    int64                  `protobuf:"varint,2,opt,name=released_by,json=releasedBy,proto3" json:"released_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseReservationsRequest) Reset() {
+	*x = ReleaseReservationsRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseReservationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseReservationsRequest) ProtoMessage() {}
+
+func (x *ReleaseReservationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseReservationsRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseReservationsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReleaseReservationsRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *ReleaseReservationsRequest) GetReleasedBy() int64 {
+	if x != nil {
+		return x.ReleasedBy
+	}
+	return 0
+}
+
+type ReleasedReservation struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProductId        int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	WarehouseId      int32                  `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	ReleasedQuantity int32                  `protobuf:"varint,3,opt,name=released_quantity,json=releasedQuantity,proto3" json:"released_quantity,omitempty"`
+	UpdatedStock     *Stock                 `protobuf:"bytes,4,opt,name=updated_stock,json=updatedStock,proto3" json:"updated_stock,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ReleasedReservation) Reset() {
+	*x = ReleasedReservation{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleasedReservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleasedReservation) ProtoMessage() {}
+
+func (x *ReleasedReservation) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleasedReservation.ProtoReflect.Descriptor instead.
+func (*ReleasedReservation) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReleasedReservation) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ReleasedReservation) GetWarehouseId() int32 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ReleasedReservation) GetReleasedQuantity() int32 {
+	if x != nil {
+		return x.ReleasedQuantity
+	}
+	return 0
+}
+
+func (x *ReleasedReservation) GetUpdatedStock() *Stock {
+	if x != nil {
+		return x.UpdatedStock
+	}
+	return nil
+}
+
+type ReleaseReservationsResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ReleasedReservations []*ReleasedReservation `protobuf:"bytes,1,rep,name=released_reservations,json=releasedReservations,proto3" json:"released_reservations,omitempty"`
+	Success              bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message              *string                `protobuf:"bytes,3,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ReleaseReservationsResponse) Reset() {
+	*x = ReleaseReservationsResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseReservationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseReservationsResponse) ProtoMessage() {}
+
+func (x *ReleaseReservationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseReservationsResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseReservationsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReleaseReservationsResponse) GetReleasedReservations() []*ReleasedReservation {
+	if x != nil {
+		return x.ReleasedReservations
+	}
+	return nil
+}
+
+func (x *ReleaseReservationsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReleaseReservationsResponse) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+type BatchReserveStockLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	WarehouseId   int32                  `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchReserveStockLine) Reset() {
+	*x = BatchReserveStockLine{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchReserveStockLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchReserveStockLine) ProtoMessage() {}
+
+func (x *BatchReserveStockLine) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchReserveStockLine.ProtoReflect.Descriptor instead.
+func (*BatchReserveStockLine) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BatchReserveStockLine) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *BatchReserveStockLine) GetWarehouseId() int32 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *BatchReserveStockLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// All lines are reserved in one transaction or none are.
+type BatchReserveStockRequest struct {
+	state       protoimpl.MessageState   `protogen:"open.v1"`
+	Lines       []*BatchReserveStockLine `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	ReferenceId string                   `protobuf:"bytes,2,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	ReservedBy  int64                    `protobuf:"varint,3,opt,name=reserved_by,json=reservedBy,proto3" json:"reserved_by,omitempty"`
+	// Applied to every line; see ReserveStockRequest.expires_at.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchReserveStockRequest) Reset() {
+	*x = BatchReserveStockRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchReserveStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchReserveStockRequest) ProtoMessage() {}
+
+func (x *BatchReserveStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchReserveStockRequest.ProtoReflect.Descriptor instead.
+func (*BatchReserveStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BatchReserveStockRequest) GetLines() []*BatchReserveStockLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *BatchReserveStockRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *BatchReserveStockRequest) GetReservedBy() int64 {
+	if x != nil {
+		return x.ReservedBy
+	}
+	return 0
+}
+
+func (x *BatchReserveStockRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type BatchReserveStockResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UpdatedStocks  []*Stock               `protobuf:"bytes,1,rep,name=updated_stocks,json=updatedStocks,proto3" json:"updated_stocks,omitempty"`
+	StockMovements []*StockMovement       `protobuf:"bytes,2,rep,name=stock_movements,json=stockMovements,proto3" json:"stock_movements,omitempty"`
+	Success        bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Message        *string                `protobuf:"bytes,4,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	// Index into lines of the first line that could not be reserved.
+	FailedLineIndex *int32 `protobuf:"varint,5,opt,name=failed_line_index,json=failedLineIndex,proto3,oneof" json:"failed_line_index,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BatchReserveStockResponse) Reset() {
+	*x = BatchReserveStockResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchReserveStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchReserveStockResponse) ProtoMessage() {}
+
+func (x *BatchReserveStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchReserveStockResponse.ProtoReflect.Descriptor instead.
+func (*BatchReserveStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BatchReserveStockResponse) GetUpdatedStocks() []*Stock {
+	if x != nil {
+		return x.UpdatedStocks
+	}
+	return nil
+}
+
+func (x *BatchReserveStockResponse) GetStockMovements() []*StockMovement {
+	if x != nil {
+		return x.StockMovements
+	}
+	return nil
+}
+
+func (x *BatchReserveStockResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BatchReserveStockResponse) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+func (x *BatchReserveStockResponse) GetFailedLineIndex() int32 {
+	if x != nil && x.FailedLineIndex != nil {
+		return *x.FailedLineIndex
+	}
+	return 0
+}
+
+type ReleaseExpiredReservationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to now when unset.
+	ExpiredBefore *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=expired_before,json=expiredBefore,proto3,oneof" json:"expired_before,omitempty"`
+	ReleasedBy    int64                  `protobuf:"varint,2,opt,name=released_by,json=releasedBy,proto3" json:"released_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseExpiredReservationsRequest) Reset() {
+	*x = ReleaseExpiredReservationsRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseExpiredReservationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseExpiredReservationsRequest) ProtoMessage() {}
+
+func (x *ReleaseExpiredReservationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseExpiredReservationsRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseExpiredReservationsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ReleaseExpiredReservationsRequest) GetExpiredBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiredBefore
+	}
+	return nil
+}
+
+func (x *ReleaseExpiredReservationsRequest) GetReleasedBy() int64 {
+	if x != nil {
+		return x.ReleasedBy
+	}
+	return 0
+}
+
+type ReleaseExpiredReservationsResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ReleasedReservations []*ReleasedReservation `protobuf:"bytes,1,rep,name=released_reservations,json=releasedReservations,proto3" json:"released_reservations,omitempty"`
+	ReleasedCount        int32                  `protobuf:"varint,2,opt,name=released_count,json=releasedCount,proto3" json:"released_count,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ReleaseExpiredReservationsResponse) Reset() {
+	*x = ReleaseExpiredReservationsResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseExpiredReservationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseExpiredReservationsResponse) ProtoMessage() {}
+
+func (x *ReleaseExpiredReservationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseExpiredReservationsResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseExpiredReservationsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ReleaseExpiredReservationsResponse) GetReleasedReservations() []*ReleasedReservation {
+	if x != nil {
+		return x.ReleasedReservations
+	}
+	return nil
+}
+
+func (x *ReleaseExpiredReservationsResponse) GetReleasedCount() int32 {
+	if x != nil {
+		return x.ReleasedCount
+	}
+	return 0
+}
+
+// Converts every reservation under reference_id into an OUT movement,
+// drawing down reserved_quantity only.
+type CommitReservationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReferenceId   string                 `protobuf:"bytes,1,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	CommittedBy   int64                  `protobuf:"varint,2,opt,name=committed_by,json=committedBy,proto3" json:"committed_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitReservationsRequest) Reset() {
+	*x = CommitReservationsRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitReservationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitReservationsRequest) ProtoMessage() {}
+
+func (x *CommitReservationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitReservationsRequest.ProtoReflect.Descriptor instead.
+func (*CommitReservationsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CommitReservationsRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *CommitReservationsRequest) GetCommittedBy() int64 {
+	if x != nil {
+		return x.CommittedBy
+	}
+	return 0
+}
+
+type CommittedReservation struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProductId         int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	WarehouseId       int32                  `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	CommittedQuantity int32                  `protobuf:"varint,3,opt,name=committed_quantity,json=committedQuantity,proto3" json:"committed_quantity,omitempty"`
+	UpdatedStock      *Stock                 `protobuf:"bytes,4,opt,name=updated_stock,json=updatedStock,proto3" json:"updated_stock,omitempty"`
+	StockMovement     *StockMovement         `protobuf:"bytes,5,opt,name=stock_movement,json=stockMovement,proto3" json:"stock_movement,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CommittedReservation) Reset() {
+	*x = CommittedReservation{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommittedReservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommittedReservation) ProtoMessage() {}
+
+func (x *CommittedReservation) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommittedReservation.ProtoReflect.Descriptor instead.
+func (*CommittedReservation) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CommittedReservation) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *CommittedReservation) GetWarehouseId() int32 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *CommittedReservation) GetCommittedQuantity() int32 {
+	if x != nil {
+		return x.CommittedQuantity
+	}
+	return 0
+}
+
+func (x *CommittedReservation) GetUpdatedStock() *Stock {
+	if x != nil {
+		return x.UpdatedStock
+	}
+	return nil
+}
+
+func (x *CommittedReservation) GetStockMovement() *StockMovement {
+	if x != nil {
+		return x.StockMovement
+	}
+	return nil
+}
+
+type CommitReservationsResponse struct {
+	state                 protoimpl.MessageState  `protogen:"open.v1"`
+	CommittedReservations []*CommittedReservation `protobuf:"bytes,1,rep,name=committed_reservations,json=committedReservations,proto3" json:"committed_reservations,omitempty"`
+	Success               bool                    `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message               *string                 `protobuf:"bytes,3,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CommitReservationsResponse) Reset() {
+	*x = CommitReservationsResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitReservationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitReservationsResponse) ProtoMessage() {}
+
+func (x *CommitReservationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitReservationsResponse.ProtoReflect.Descriptor instead.
+func (*CommitReservationsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CommitReservationsResponse) GetCommittedReservations() []*CommittedReservation {
+	if x != nil {
+		return x.CommittedReservations
+	}
+	return nil
+}
+
+func (x *CommitReservationsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CommitReservationsResponse) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+type UpdateStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	WarehouseId   int32                  `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	MovementType  MovementType           `protobuf:"varint,3,opt,name=movement_type,json=movementType,proto3,enum=inventory.MovementType" json:"movement_type,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitCost      *string                `protobuf:"bytes,5,opt,name=unit_cost,json=unitCost,proto3,oneof" json:"unit_cost,omitempty"`
+	ReferenceType ReferenceType          `protobuf:"varint,6,opt,name=reference_type,json=referenceType,proto3,enum=inventory.ReferenceType" json:"reference_type,omitempty"`
+	ReferenceId   *string                `protobuf:"bytes,7,opt,name=reference_id,json=referenceId,proto3,oneof" json:"reference_id,omitempty"`
+	Notes         *string                `protobuf:"bytes,8,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	CreatedBy     int64                  `protobuf:"varint,9,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	// Required on inbound movements of products with is_lot_tracked;
+	// outbound movements consume lots first-expiry-first-out.
+	LotNumber  *string `protobuf:"bytes,10,opt,name=lot_number,json=lotNumber,proto3,oneof" json:"lot_number,omitempty"`
+	ExpiryDate *string `protobuf:"bytes,11,opt,name=expiry_date,json=expiryDate,proto3,oneof" json:"expiry_date,omitempty"`
+	// Required for serialized products: one serial per unit, registered on
+	// inbound and consumed on outbound movements.
+	SerialNumbers []string `protobuf:"bytes,12,rep,name=serial_numbers,json=serialNumbers,proto3" json:"serial_numbers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateStockRequest) Reset() {
+	*x = UpdateStockRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStockRequest) ProtoMessage() {}
+
+func (x *UpdateStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStockRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UpdateStockRequest) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *UpdateStockRequest) GetWarehouseId() int32 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *UpdateStockRequest) GetMovementType() MovementType {
+	if x != nil {
+		return x.MovementType
+	}
+	return MovementType_MOVEMENT_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateStockRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *UpdateStockRequest) GetUnitCost() string {
+	if x != nil && x.UnitCost != nil {
+		return *x.UnitCost
+	}
+	return ""
+}
+
+func (x *UpdateStockRequest) GetReferenceType() ReferenceType {
+	if x != nil {
+		return x.ReferenceType
+	}
+	return ReferenceType_REFERENCE_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateStockRequest) GetReferenceId() string {
+	if x != nil && x.ReferenceId != nil {
+		return *x.ReferenceId
+	}
+	return ""
+}
+
+func (x *UpdateStockRequest) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+func (x *UpdateStockRequest) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *UpdateStockRequest) GetLotNumber() string {
+	if x != nil && x.LotNumber != nil {
+		return *x.LotNumber
+	}
+	return ""
+}
+
+func (x *UpdateStockRequest) GetExpiryDate() string {
+	if x != nil && x.ExpiryDate != nil {
+		return *x.ExpiryDate
+	}
+	return ""
+}
+
+func (x *UpdateStockRequest) GetSerialNumbers() []string {
+	if x != nil {
+		return x.SerialNumbers
+	}
+	return nil
+}
+
+type UpdateStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StockMovement *StockMovement         `protobuf:"bytes,1,opt,name=stock_movement,json=stockMovement,proto3" json:"stock_movement,omitempty"`
+	UpdatedStock  *Stock                 `protobuf:"bytes,2,opt,name=updated_stock,json=updatedStock,proto3" json:"updated_stock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateStockResponse) Reset() {
+	*x = UpdateStockResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStockResponse) ProtoMessage() {}
+
+func (x *UpdateStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStockResponse.ProtoReflect.Descriptor instead.
+func (*UpdateStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UpdateStockResponse) GetStockMovement() *StockMovement {
+	if x != nil {
+		return x.StockMovement
+	}
+	return nil
+}
+
+func (x *UpdateStockResponse) GetUpdatedStock() *Stock {
+	if x != nil {
+		return x.UpdatedStock
+	}
+	return nil
+}
+
+// Quantity is signed: negative removes stock, positive adds it.
+type AdjustStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	WarehouseId   int32                  `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	ReasonCode    AdjustmentReason       `protobuf:"varint,4,opt,name=reason_code,json=reasonCode,proto3,enum=inventory.AdjustmentReason" json:"reason_code,omitempty"`
+	Notes         *string                `protobuf:"bytes,5,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	CreatedBy     int64                  `protobuf:"varint,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustStockRequest) Reset() {
+	*x = AdjustStockRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustStockRequest) ProtoMessage() {}
+
+func (x *AdjustStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustStockRequest.ProtoReflect.Descriptor instead.
+func (*AdjustStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AdjustStockRequest) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *AdjustStockRequest) GetWarehouseId() int32 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *AdjustStockRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *AdjustStockRequest) GetReasonCode() AdjustmentReason {
+	if x != nil {
+		return x.ReasonCode
+	}
+	return AdjustmentReason_ADJUSTMENT_REASON_UNSPECIFIED
+}
+
+func (x *AdjustStockRequest) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+func (x *AdjustStockRequest) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+type AdjustStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StockMovement *StockMovement         `protobuf:"bytes,1,opt,name=stock_movement,json=stockMovement,proto3" json:"stock_movement,omitempty"`
+	UpdatedStock  *Stock                 `protobuf:"bytes,2,opt,name=updated_stock,json=updatedStock,proto3" json:"updated_stock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustStockResponse) Reset() {
+	*x = AdjustStockResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustStockResponse) ProtoMessage() {}
+
+func (x *AdjustStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustStockResponse.ProtoReflect.Descriptor instead.
+func (*AdjustStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AdjustStockResponse) GetStockMovement() *StockMovement {
+	if x != nil {
+		return x.StockMovement
+	}
+	return nil
+}
+
+func (x *AdjustStockResponse) GetUpdatedStock() *Stock {
+	if x != nil {
+		return x.UpdatedStock
+	}
+	return nil
+}
+
+type GetStockAdjustmentReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DateRange     *DateRange             `protobuf:"bytes,1,opt,name=date_range,json=dateRange,proto3" json:"date_range,omitempty"`
+	WarehouseId   *int32                 `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3,oneof" json:"warehouse_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStockAdjustmentReportRequest) Reset() {
+	*x = GetStockAdjustmentReportRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockAdjustmentReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockAdjustmentReportRequest) ProtoMessage() {}
+
+func (x *GetStockAdjustmentReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockAdjustmentReportRequest.ProtoReflect.Descriptor instead.
+func (*GetStockAdjustmentReportRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetStockAdjustmentReportRequest) GetDateRange() *DateRange {
+	if x != nil {
+		return x.DateRange
+	}
+	return nil
+}
+
+func (x *GetStockAdjustmentReportRequest) GetWarehouseId() int32 {
+	if x != nil && x.WarehouseId != nil {
+		return *x.WarehouseId
+	}
+	return 0
+}
+
+type AdjustmentReasonTotal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReasonCode    AdjustmentReason       `protobuf:"varint,1,opt,name=reason_code,json=reasonCode,proto3,enum=inventory.AdjustmentReason" json:"reason_code,omitempty"`
+	MovementCount int32                  `protobuf:"varint,2,opt,name=movement_count,json=movementCount,proto3" json:"movement_count,omitempty"`
+	TotalQuantity int32                  `protobuf:"varint,3,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"`
+	TotalValue    string                 `protobuf:"bytes,4,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustmentReasonTotal) Reset() {
+	*x = AdjustmentReasonTotal{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustmentReasonTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustmentReasonTotal) ProtoMessage() {}
+
+func (x *AdjustmentReasonTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustmentReasonTotal.ProtoReflect.Descriptor instead.
+func (*AdjustmentReasonTotal) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AdjustmentReasonTotal) GetReasonCode() AdjustmentReason {
+	if x != nil {
+		return x.ReasonCode
+	}
+	return AdjustmentReason_ADJUSTMENT_REASON_UNSPECIFIED
+}
+
+func (x *AdjustmentReasonTotal) GetMovementCount() int32 {
+	if x != nil {
+		return x.MovementCount
+	}
+	return 0
+}
+
+func (x *AdjustmentReasonTotal) GetTotalQuantity() int32 {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return 0
+}
+
+func (x *AdjustmentReasonTotal) GetTotalValue() string {
+	if x != nil {
+		return x.TotalValue
+	}
+	return ""
+}
+
+type GetStockAdjustmentReportResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	ReasonTotals  []*AdjustmentReasonTotal `protobuf:"bytes,1,rep,name=reason_totals,json=reasonTotals,proto3" json:"reason_totals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStockAdjustmentReportResponse) Reset() {
+	*x = GetStockAdjustmentReportResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockAdjustmentReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockAdjustmentReportResponse) ProtoMessage() {}
+
+func (x *GetStockAdjustmentReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockAdjustmentReportResponse.ProtoReflect.Descriptor instead.
+func (*GetStockAdjustmentReportResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetStockAdjustmentReportResponse) GetReasonTotals() []*AdjustmentReasonTotal {
+	if x != nil {
+		return x.ReasonTotals
+	}
+	return nil
+}
+
+type ListExpiringStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WithinDays    int32                  `protobuf:"varint,1,opt,name=within_days,json=withinDays,proto3" json:"within_days,omitempty"`
+	WarehouseId   *int32                 `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3,oneof" json:"warehouse_id,omitempty"`
+	Pagination    *PaginationRequest     `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpiringStockRequest) Reset() {
+	*x = ListExpiringStockRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpiringStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpiringStockRequest) ProtoMessage() {}
+
+func (x *ListExpiringStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpiringStockRequest.ProtoReflect.Descriptor instead.
+func (*ListExpiringStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListExpiringStockRequest) GetWithinDays() int32 {
+	if x != nil {
+		return x.WithinDays
+	}
+	return 0
+}
+
+func (x *ListExpiringStockRequest) GetWarehouseId() int32 {
+	if x != nil && x.WarehouseId != nil {
+		return *x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ListExpiringStockRequest) GetPagination() *PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListExpiringStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StockLots     []*StockLot            `protobuf:"bytes,1,rep,name=stock_lots,json=stockLots,proto3" json:"stock_lots,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpiringStockResponse) Reset() {
+	*x = ListExpiringStockResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpiringStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpiringStockResponse) ProtoMessage() {}
+
+func (x *ListExpiringStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpiringStockResponse.ProtoReflect.Descriptor instead.
+func (*ListExpiringStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListExpiringStockResponse) GetStockLots() []*StockLot {
+	if x != nil {
+		return x.StockLots
+	}
+	return nil
+}
+
+func (x *ListExpiringStockResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListStockSerialsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pagination    *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ProductId     *int32                 `protobuf:"varint,2,opt,name=product_id,json=productId,proto3,oneof" json:"product_id,omitempty"`
+	WarehouseId   *int32                 `protobuf:"varint,3,opt,name=warehouse_id,json=warehouseId,proto3,oneof" json:"warehouse_id,omitempty"`
+	Status        *SerialStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=inventory.SerialStatus,oneof" json:"status,omitempty"`
+	SerialNumber  *string                `protobuf:"bytes,5,opt,name=serial_number,json=serialNumber,proto3,oneof" json:"serial_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockSerialsRequest) Reset() {
+	*x = ListStockSerialsRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockSerialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockSerialsRequest) ProtoMessage() {}
+
+func (x *ListStockSerialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockSerialsRequest.ProtoReflect.Descriptor instead.
+func (*ListStockSerialsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListStockSerialsRequest) GetPagination() *PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListStockSerialsRequest) GetProductId() int32 {
+	if x != nil && x.ProductId != nil {
+		return *x.ProductId
+	}
+	return 0
+}
+
+func (x *ListStockSerialsRequest) GetWarehouseId() int32 {
+	if x != nil && x.WarehouseId != nil {
+		return *x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ListStockSerialsRequest) GetStatus() SerialStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return SerialStatus_SERIAL_STATUS_UNSPECIFIED
+}
+
+func (x *ListStockSerialsRequest) GetSerialNumber() string {
+	if x != nil && x.SerialNumber != nil {
+		return *x.SerialNumber
+	}
+	return ""
+}
+
+type ListStockSerialsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StockSerials  []*StockSerial         `protobuf:"bytes,1,rep,name=stock_serials,json=stockSerials,proto3" json:"stock_serials,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockSerialsResponse) Reset() {
+	*x = ListStockSerialsResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockSerialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockSerialsResponse) ProtoMessage() {}
+
+func (x *ListStockSerialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockSerialsResponse.ProtoReflect.Descriptor instead.
+func (*ListStockSerialsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListStockSerialsResponse) GetStockSerials() []*StockSerial {
+	if x != nil {
+		return x.StockSerials
+	}
+	return nil
+}
+
+func (x *ListStockSerialsResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	WarehouseId   *int32                 `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3,oneof" json:"warehouse_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStockRequest) Reset() {
+	*x = GetStockRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockRequest) ProtoMessage() {}
+
+func (x *GetStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockRequest.ProtoReflect.Descriptor instead.
+func (*GetStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetStockRequest) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *GetStockRequest) GetWarehouseId() int32 {
+	if x != nil && x.WarehouseId != nil {
+		return *x.WarehouseId
+	}
+	return 0
+}
+
+type GetStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stocks        []*Stock               `protobuf:"bytes,1,rep,name=stocks,proto3" json:"stocks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStockResponse) Reset() {
+	*x = GetStockResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockResponse) ProtoMessage() {}
+
+func (x *GetStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockResponse.ProtoReflect.Descriptor instead.
+func (*GetStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetStockResponse) GetStocks() []*Stock {
+	if x != nil {
+		return x.Stocks
+	}
+	return nil
+}
+
+type ListLowStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   *int32                 `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3,oneof" json:"warehouse_id,omitempty"`
+	Pagination    *PaginationRequest     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLowStockRequest) Reset() {
+	*x = ListLowStockRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLowStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLowStockRequest) ProtoMessage() {}
+
+func (x *ListLowStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLowStockRequest.ProtoReflect.Descriptor instead.
+func (*ListLowStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListLowStockRequest) GetWarehouseId() int32 {
+	if x != nil && x.WarehouseId != nil {
+		return *x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ListLowStockRequest) GetPagination() *PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListLowStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LowStocks     []*Stock               `protobuf:"bytes,1,rep,name=low_stocks,json=lowStocks,proto3" json:"low_stocks,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLowStockResponse) Reset() {
+	*x = ListLowStockResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLowStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLowStockResponse) ProtoMessage() {}
+
+func (x *ListLowStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLowStockResponse.ProtoReflect.Descriptor instead.
+func (*ListLowStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListLowStockResponse) GetLowStocks() []*Stock {
+	if x != nil {
+		return x.LowStocks
+	}
+	return nil
+}
+
+func (x *ListLowStockResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetReorderSuggestionsRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	LookbackDays int32                  `protobuf:"varint,1,opt,name=lookback_days,json=lookbackDays,proto3" json:"lookback_days,omitempty"`
+	// Supplier lead time; items projected to stock out sooner are flagged.
+	LeadTimeDays  int32              `protobuf:"varint,2,opt,name=lead_time_days,json=leadTimeDays,proto3" json:"lead_time_days,omitempty"`
+	WarehouseId   *int32             `protobuf:"varint,3,opt,name=warehouse_id,json=warehouseId,proto3,oneof" json:"warehouse_id,omitempty"`
+	Pagination    *PaginationRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReorderSuggestionsRequest) Reset() {
+	*x = GetReorderSuggestionsRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReorderSuggestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReorderSuggestionsRequest) ProtoMessage() {}
+
+func (x *GetReorderSuggestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReorderSuggestionsRequest.ProtoReflect.Descriptor instead.
+func (*GetReorderSuggestionsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetReorderSuggestionsRequest) GetLookbackDays() int32 {
+	if x != nil {
+		return x.LookbackDays
+	}
+	return 0
+}
+
+func (x *GetReorderSuggestionsRequest) GetLeadTimeDays() int32 {
+	if x != nil {
+		return x.LeadTimeDays
+	}
+	return 0
+}
+
+func (x *GetReorderSuggestionsRequest) GetWarehouseId() int32 {
+	if x != nil && x.WarehouseId != nil {
+		return *x.WarehouseId
+	}
+	return 0
+}
+
+func (x *GetReorderSuggestionsRequest) GetPagination() *PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ReorderSuggestion struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	ProductId              int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductCode            string                 `protobuf:"bytes,2,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`
+	ProductName            string                 `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	AverageDailySales      string                 `protobuf:"bytes,4,opt,name=average_daily_sales,json=averageDailySales,proto3" json:"average_daily_sales,omitempty"`
+	TotalAvailableQuantity int32                  `protobuf:"varint,5,opt,name=total_available_quantity,json=totalAvailableQuantity,proto3" json:"total_available_quantity,omitempty"`
+	ReorderLevel           int32                  `protobuf:"varint,6,opt,name=reorder_level,json=reorderLevel,proto3" json:"reorder_level,omitempty"`
+	MaxStockLevel          int32                  `protobuf:"varint,7,opt,name=max_stock_level,json=maxStockLevel,proto3" json:"max_stock_level,omitempty"`
+	// Quantity needed to bring available stock up to max_stock_level.
+	SuggestedQuantity      int32   `protobuf:"varint,8,opt,name=suggested_quantity,json=suggestedQuantity,proto3" json:"suggested_quantity,omitempty"`
+	DaysUntilStockout      *string `protobuf:"bytes,9,opt,name=days_until_stockout,json=daysUntilStockout,proto3,oneof" json:"days_until_stockout,omitempty"`
+	StockoutBeforeLeadTime bool    `protobuf:"varint,10,opt,name=stockout_before_lead_time,json=stockoutBeforeLeadTime,proto3" json:"stockout_before_lead_time,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ReorderSuggestion) Reset() {
+	*x = ReorderSuggestion{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderSuggestion) ProtoMessage() {}
+
+func (x *ReorderSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderSuggestion.ProtoReflect.Descriptor instead.
+func (*ReorderSuggestion) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ReorderSuggestion) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ReorderSuggestion) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *ReorderSuggestion) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *ReorderSuggestion) GetAverageDailySales() string {
+	if x != nil {
+		return x.AverageDailySales
+	}
+	return ""
+}
+
+func (x *ReorderSuggestion) GetTotalAvailableQuantity() int32 {
+	if x != nil {
+		return x.TotalAvailableQuantity
+	}
+	return 0
+}
+
+func (x *ReorderSuggestion) GetReorderLevel() int32 {
+	if x != nil {
+		return x.ReorderLevel
+	}
+	return 0
+}
+
+func (x *ReorderSuggestion) GetMaxStockLevel() int32 {
+	if x != nil {
+		return x.MaxStockLevel
+	}
+	return 0
+}
+
+func (x *ReorderSuggestion) GetSuggestedQuantity() int32 {
+	if x != nil {
+		return x.SuggestedQuantity
+	}
+	return 0
+}
+
+func (x *ReorderSuggestion) GetDaysUntilStockout() string {
+	if x != nil && x.DaysUntilStockout != nil {
+		return *x.DaysUntilStockout
+	}
+	return ""
+}
+
+func (x *ReorderSuggestion) GetStockoutBeforeLeadTime() bool {
+	if x != nil {
+		return x.StockoutBeforeLeadTime
+	}
+	return false
+}
+
+type GetReorderSuggestionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*ReorderSuggestion   `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReorderSuggestionsResponse) Reset() {
+	*x = GetReorderSuggestionsResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReorderSuggestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReorderSuggestionsResponse) ProtoMessage() {}
+
+func (x *GetReorderSuggestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReorderSuggestionsResponse.ProtoReflect.Descriptor instead.
+func (*GetReorderSuggestionsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetReorderSuggestionsResponse) GetSuggestions() []*ReorderSuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+func (x *GetReorderSuggestionsResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// Valuation Operations
+type WarehouseValuation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   int32                  `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	WarehouseCode string                 `protobuf:"bytes,2,opt,name=warehouse_code,json=warehouseCode,proto3" json:"warehouse_code,omitempty"`
+	WarehouseName string                 `protobuf:"bytes,3,opt,name=warehouse_name,json=warehouseName,proto3" json:"warehouse_name,omitempty"`
+	TotalQuantity int64                  `protobuf:"varint,4,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"`
+	TotalValue    string                 `protobuf:"bytes,5,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarehouseValuation) Reset() {
+	*x = WarehouseValuation{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarehouseValuation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarehouseValuation) ProtoMessage() {}
+
+func (x *WarehouseValuation) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarehouseValuation.ProtoReflect.Descriptor instead.
+func (*WarehouseValuation) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *WarehouseValuation) GetWarehouseId() int32 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *WarehouseValuation) GetWarehouseCode() string {
+	if x != nil {
+		return x.WarehouseCode
+	}
+	return ""
+}
+
+func (x *WarehouseValuation) GetWarehouseName() string {
+	if x != nil {
+		return x.WarehouseName
+	}
+	return ""
+}
+
+func (x *WarehouseValuation) GetTotalQuantity() int64 {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return 0
+}
+
+func (x *WarehouseValuation) GetTotalValue() string {
+	if x != nil {
+		return x.TotalValue
+	}
+	return ""
+}
+
+// Quantities are replayed from stock movements up to as_of_date and
+// valued at the unit cost effective on that date.
+type GetStockValuationAsOfRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AsOfDate      string                 `protobuf:"bytes,1,opt,name=as_of_date,json=asOfDate,proto3" json:"as_of_date,omitempty"`
+	WarehouseId   *int32                 `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3,oneof" json:"warehouse_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStockValuationAsOfRequest) Reset() {
+	*x = GetStockValuationAsOfRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockValuationAsOfRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockValuationAsOfRequest) ProtoMessage() {}
+
+func (x *GetStockValuationAsOfRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockValuationAsOfRequest.ProtoReflect.Descriptor instead.
+func (*GetStockValuationAsOfRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetStockValuationAsOfRequest) GetAsOfDate() string {
+	if x != nil {
+		return x.AsOfDate
+	}
+	return ""
+}
+
+func (x *GetStockValuationAsOfRequest) GetWarehouseId() int32 {
+	if x != nil && x.WarehouseId != nil {
+		return *x.WarehouseId
+	}
+	return 0
+}
+
+type GetStockValuationAsOfResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	AsOfDate            string                 `protobuf:"bytes,1,opt,name=as_of_date,json=asOfDate,proto3" json:"as_of_date,omitempty"`
+	WarehouseValuations []*WarehouseValuation  `protobuf:"bytes,2,rep,name=warehouse_valuations,json=warehouseValuations,proto3" json:"warehouse_valuations,omitempty"`
+	TotalQuantity       int64                  `protobuf:"varint,3,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"`
+	TotalValue          string                 `protobuf:"bytes,4,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetStockValuationAsOfResponse) Reset() {
+	*x = GetStockValuationAsOfResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockValuationAsOfResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockValuationAsOfResponse) ProtoMessage() {}
+
+func (x *GetStockValuationAsOfResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockValuationAsOfResponse.ProtoReflect.Descriptor instead.
+func (*GetStockValuationAsOfResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetStockValuationAsOfResponse) GetAsOfDate() string {
+	if x != nil {
+		return x.AsOfDate
+	}
+	return ""
+}
+
+func (x *GetStockValuationAsOfResponse) GetWarehouseValuations() []*WarehouseValuation {
+	if x != nil {
+		return x.WarehouseValuations
+	}
+	return nil
+}
+
+func (x *GetStockValuationAsOfResponse) GetTotalQuantity() int64 {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return 0
+}
+
+func (x *GetStockValuationAsOfResponse) GetTotalValue() string {
+	if x != nil {
+		return x.TotalValue
+	}
+	return ""
+}
+
+type ProductTypeValuation struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId     int32                  `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	ProductTypeId   int32                  `protobuf:"varint,2,opt,name=product_type_id,json=productTypeId,proto3" json:"product_type_id,omitempty"`
+	ProductTypeName string                 `protobuf:"bytes,3,opt,name=product_type_name,json=productTypeName,proto3" json:"product_type_name,omitempty"`
+	TotalQuantity   int64                  `protobuf:"varint,4,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"`
+	TotalValue      string                 `protobuf:"bytes,5,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ProductTypeValuation) Reset() {
+	*x = ProductTypeValuation{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductTypeValuation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductTypeValuation) ProtoMessage() {}
+
+func (x *ProductTypeValuation) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductTypeValuation.ProtoReflect.Descriptor instead.
+func (*ProductTypeValuation) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ProductTypeValuation) GetWarehouseId() int32 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ProductTypeValuation) GetProductTypeId() int32 {
+	if x != nil {
+		return x.ProductTypeId
+	}
+	return 0
+}
+
+func (x *ProductTypeValuation) GetProductTypeName() string {
+	if x != nil {
+		return x.ProductTypeName
+	}
+	return ""
+}
+
+func (x *ProductTypeValuation) GetTotalQuantity() int64 {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return 0
+}
+
+func (x *ProductTypeValuation) GetTotalValue() string {
+	if x != nil {
+		return x.TotalValue
+	}
+	return ""
+}
+
+type GetInventoryValuationRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId        *int32                 `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3,oneof" json:"warehouse_id,omitempty"`
+	GroupByProductType *bool                  `protobuf:"varint,2,opt,name=group_by_product_type,json=groupByProductType,proto3,oneof" json:"group_by_product_type,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetInventoryValuationRequest) Reset() {
+	*x = GetInventoryValuationRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInventoryValuationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInventoryValuationRequest) ProtoMessage() {}
+
+func (x *GetInventoryValuationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInventoryValuationRequest.ProtoReflect.Descriptor instead.
+func (*GetInventoryValuationRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetInventoryValuationRequest) GetWarehouseId() int32 {
+	if x != nil && x.WarehouseId != nil {
+		return *x.WarehouseId
+	}
+	return 0
+}
+
+func (x *GetInventoryValuationRequest) GetGroupByProductType() bool {
+	if x != nil && x.GroupByProductType != nil {
+		return *x.GroupByProductType
+	}
+	return false
+}
+
+type GetInventoryValuationResponse struct {
+	state                 protoimpl.MessageState  `protogen:"open.v1"`
+	WarehouseValuations   []*WarehouseValuation   `protobuf:"bytes,1,rep,name=warehouse_valuations,json=warehouseValuations,proto3" json:"warehouse_valuations,omitempty"`
+	ProductTypeValuations []*ProductTypeValuation `protobuf:"bytes,2,rep,name=product_type_valuations,json=productTypeValuations,proto3" json:"product_type_valuations,omitempty"`
+	TotalQuantity         int64                   `protobuf:"varint,3,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"`
+	TotalValue            string                  `protobuf:"bytes,4,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
+	// Stock rows left out of the totals because unit_cost did not parse.
+	UnvaluedStocks []*Stock `protobuf:"bytes,5,rep,name=unvalued_stocks,json=unvaluedStocks,proto3" json:"unvalued_stocks,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetInventoryValuationResponse) Reset() {
+	*x = GetInventoryValuationResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInventoryValuationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInventoryValuationResponse) ProtoMessage() {}
+
+func (x *GetInventoryValuationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInventoryValuationResponse.ProtoReflect.Descriptor instead.
+func (*GetInventoryValuationResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetInventoryValuationResponse) GetWarehouseValuations() []*WarehouseValuation {
+	if x != nil {
+		return x.WarehouseValuations
+	}
+	return nil
+}
+
+func (x *GetInventoryValuationResponse) GetProductTypeValuations() []*ProductTypeValuation {
+	if x != nil {
+		return x.ProductTypeValuations
+	}
+	return nil
+}
+
+func (x *GetInventoryValuationResponse) GetTotalQuantity() int64 {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return 0
+}
+
+func (x *GetInventoryValuationResponse) GetTotalValue() string {
+	if x != nil {
+		return x.TotalValue
+	}
+	return ""
+}
+
+func (x *GetInventoryValuationResponse) GetUnvaluedStocks() []*Stock {
+	if x != nil {
+		return x.UnvaluedStocks
+	}
+	return nil
+}
+
+// page_token is an opaque cursor over (created_at, id); total_count is
+// only filled when include_total_count is set.
+type ListStockMovementsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Pagination        *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ProductId         *int32                 `protobuf:"varint,2,opt,name=product_id,json=productId,proto3,oneof" json:"product_id,omitempty"`
+	WarehouseId       *int32                 `protobuf:"varint,3,opt,name=warehouse_id,json=warehouseId,proto3,oneof" json:"warehouse_id,omitempty"`
+	MovementType      *MovementType          `protobuf:"varint,4,opt,name=movement_type,json=movementType,proto3,enum=inventory.MovementType,oneof" json:"movement_type,omitempty"`
+	DateRange         *DateRange             `protobuf:"bytes,5,opt,name=date_range,json=dateRange,proto3,oneof" json:"date_range,omitempty"`
+	IncludeTotalCount *bool                  `protobuf:"varint,6,opt,name=include_total_count,json=includeTotalCount,proto3,oneof" json:"include_total_count,omitempty"`
+	// Matches every leg of a reference, e.g. both sides of a TRANSFER_xxx.
+	ReferenceId   *string `protobuf:"bytes,7,opt,name=reference_id,json=referenceId,proto3,oneof" json:"reference_id,omitempty"`
+	CreatedBy     *int64  `protobuf:"varint,8,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockMovementsRequest) Reset() {
+	*x = ListStockMovementsRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockMovementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockMovementsRequest) ProtoMessage() {}
+
+func (x *ListStockMovementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockMovementsRequest.ProtoReflect.Descriptor instead.
+func (*ListStockMovementsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListStockMovementsRequest) GetPagination() *PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListStockMovementsRequest) GetProductId() int32 {
+	if x != nil && x.ProductId != nil {
+		return *x.ProductId
+	}
+	return 0
+}
+
+func (x *ListStockMovementsRequest) GetWarehouseId() int32 {
+	if x != nil && x.WarehouseId != nil {
+		return *x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ListStockMovementsRequest) GetMovementType() MovementType {
+	if x != nil && x.MovementType != nil {
+		return *x.MovementType
+	}
+	return MovementType_MOVEMENT_TYPE_UNSPECIFIED
+}
+
+func (x *ListStockMovementsRequest) GetDateRange() *DateRange {
+	if x != nil {
+		return x.DateRange
+	}
+	return nil
+}
+
+func (x *ListStockMovementsRequest) GetIncludeTotalCount() bool {
+	if x != nil && x.IncludeTotalCount != nil {
+		return *x.IncludeTotalCount
+	}
+	return false
+}
+
+func (x *ListStockMovementsRequest) GetReferenceId() string {
+	if x != nil && x.ReferenceId != nil {
+		return *x.ReferenceId
+	}
+	return ""
+}
+
+func (x *ListStockMovementsRequest) GetCreatedBy() int64 {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return 0
+}
+
+type ListStockMovementsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StockMovements []*StockMovement       `protobuf:"bytes,1,rep,name=stock_movements,json=stockMovements,proto3" json:"stock_movements,omitempty"`
+	Pagination     *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListStockMovementsResponse) Reset() {
+	*x = ListStockMovementsResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockMovementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockMovementsResponse) ProtoMessage() {}
+
+func (x *ListStockMovementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockMovementsResponse.ProtoReflect.Descriptor instead.
+func (*ListStockMovementsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListStockMovementsResponse) GetStockMovements() []*StockMovement {
+	if x != nil {
+		return x.StockMovements
+	}
+	return nil
+}
+
+func (x *ListStockMovementsResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// Same filters as ListStockMovements; pagination is replaced by an
+// internal keyset cursor and rows are streamed one page at a time.
+type StreamStockMovementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     *int32                 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3,oneof" json:"product_id,omitempty"`
+	WarehouseId   *int32                 `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3,oneof" json:"warehouse_id,omitempty"`
+	MovementType  *MovementType          `protobuf:"varint,3,opt,name=movement_type,json=movementType,proto3,enum=inventory.MovementType,oneof" json:"movement_type,omitempty"`
+	DateRange     *DateRange             `protobuf:"bytes,4,opt,name=date_range,json=dateRange,proto3,oneof" json:"date_range,omitempty"`
+	ReferenceId   *string                `protobuf:"bytes,5,opt,name=reference_id,json=referenceId,proto3,oneof" json:"reference_id,omitempty"`
+	CreatedBy     *int64                 `protobuf:"varint,6,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	BatchSize     int32                  `protobuf:"varint,7,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamStockMovementsRequest) Reset() {
+	*x = StreamStockMovementsRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamStockMovementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamStockMovementsRequest) ProtoMessage() {}
+
+func (x *StreamStockMovementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamStockMovementsRequest.ProtoReflect.Descriptor instead.
+func (*StreamStockMovementsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *StreamStockMovementsRequest) GetProductId() int32 {
+	if x != nil && x.ProductId != nil {
+		return *x.ProductId
+	}
+	return 0
+}
+
+func (x *StreamStockMovementsRequest) GetWarehouseId() int32 {
+	if x != nil && x.WarehouseId != nil {
+		return *x.WarehouseId
+	}
+	return 0
+}
+
+func (x *StreamStockMovementsRequest) GetMovementType() MovementType {
+	if x != nil && x.MovementType != nil {
+		return *x.MovementType
+	}
+	return MovementType_MOVEMENT_TYPE_UNSPECIFIED
+}
+
+func (x *StreamStockMovementsRequest) GetDateRange() *DateRange {
+	if x != nil {
+		return x.DateRange
+	}
+	return nil
+}
+
+func (x *StreamStockMovementsRequest) GetReferenceId() string {
+	if x != nil && x.ReferenceId != nil {
+		return *x.ReferenceId
+	}
+	return ""
+}
+
+func (x *StreamStockMovementsRequest) GetCreatedBy() int64 {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return 0
+}
+
+func (x *StreamStockMovementsRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type GetStockMovementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStockMovementRequest) Reset() {
+	*x = GetStockMovementRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockMovementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockMovementRequest) ProtoMessage() {}
+
+func (x *GetStockMovementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockMovementRequest.ProtoReflect.Descriptor instead.
+func (*GetStockMovementRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetStockMovementRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetStockMovementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StockMovement *StockMovement         `protobuf:"bytes,1,opt,name=stock_movement,json=stockMovement,proto3" json:"stock_movement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStockMovementResponse) Reset() {
+	*x = GetStockMovementResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockMovementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockMovementResponse) ProtoMessage() {}
+
+func (x *GetStockMovementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockMovementResponse.ProtoReflect.Descriptor instead.
+func (*GetStockMovementResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetStockMovementResponse) GetStockMovement() *StockMovement {
+	if x != nil {
+		return x.StockMovement
+	}
+	return nil
+}
+
+// Product Operations
+type CreateProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductCode   string                 `protobuf:"bytes,1,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`
+	ProductName   string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	ProductTypeId int32                  `protobuf:"varint,3,opt,name=product_type_id,json=productTypeId,proto3" json:"product_type_id,omitempty"`
+	SupplierId    int32                  `protobuf:"varint,4,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
+	UnitOfMeasure *string                `protobuf:"bytes,5,opt,name=unit_of_measure,json=unitOfMeasure,proto3,oneof" json:"unit_of_measure,omitempty"`
+	ReorderLevel  *int32                 `protobuf:"varint,6,opt,name=reorder_level,json=reorderLevel,proto3,oneof" json:"reorder_level,omitempty"`
+	MaxStockLevel *int32                 `protobuf:"varint,7,opt,name=max_stock_level,json=maxStockLevel,proto3,oneof" json:"max_stock_level,omitempty"`
+	IsSerialized  *bool                  `protobuf:"varint,8,opt,name=is_serialized,json=isSerialized,proto3,oneof" json:"is_serialized,omitempty"`
+	IsLotTracked  *bool                  `protobuf:"varint,9,opt,name=is_lot_tracked,json=isLotTracked,proto3,oneof" json:"is_lot_tracked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductRequest) Reset() {
+	*x = CreateProductRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductRequest) ProtoMessage() {}
+
+func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CreateProductRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetProductTypeId() int32 {
+	if x != nil {
+		return x.ProductTypeId
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetSupplierId() int32 {
+	if x != nil {
+		return x.SupplierId
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetUnitOfMeasure() string {
+	if x != nil && x.UnitOfMeasure != nil {
+		return *x.UnitOfMeasure
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetReorderLevel() int32 {
+	if x != nil && x.ReorderLevel != nil {
+		return *x.ReorderLevel
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetMaxStockLevel() int32 {
+	if x != nil && x.MaxStockLevel != nil {
+		return *x.MaxStockLevel
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetIsSerialized() bool {
+	if x != nil && x.IsSerialized != nil {
+		return *x.IsSerialized
+	}
+	return false
+}
+
+func (x *CreateProductRequest) GetIsLotTracked() bool {
+	if x != nil && x.IsLotTracked != nil {
+		return *x.IsLotTracked
+	}
+	return false
+}
+
+type CreateProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *InventoryProduct      `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductResponse) Reset() {
+	*x = CreateProductResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductResponse) ProtoMessage() {}
+
+func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CreateProductResponse) GetProduct() *InventoryProduct {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type UpdateProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductName   *string                `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3,oneof" json:"product_name,omitempty"`
+	ProductTypeId *int32                 `protobuf:"varint,3,opt,name=product_type_id,json=productTypeId,proto3,oneof" json:"product_type_id,omitempty"`
+	SupplierId    *int32                 `protobuf:"varint,4,opt,name=supplier_id,json=supplierId,proto3,oneof" json:"supplier_id,omitempty"`
+	UnitOfMeasure *string                `protobuf:"bytes,5,opt,name=unit_of_measure,json=unitOfMeasure,proto3,oneof" json:"unit_of_measure,omitempty"`
+	ReorderLevel  *int32                 `protobuf:"varint,6,opt,name=reorder_level,json=reorderLevel,proto3,oneof" json:"reorder_level,omitempty"`
+	MaxStockLevel *int32                 `protobuf:"varint,7,opt,name=max_stock_level,json=maxStockLevel,proto3,oneof" json:"max_stock_level,omitempty"`
+	IsActive      *bool                  `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductRequest) Reset() {
+	*x = UpdateProductRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductRequest) ProtoMessage() {}
+
+func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *UpdateProductRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetProductName() string {
+	if x != nil && x.ProductName != nil {
+		return *x.ProductName
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetProductTypeId() int32 {
+	if x != nil && x.ProductTypeId != nil {
+		return *x.ProductTypeId
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetSupplierId() int32 {
+	if x != nil && x.SupplierId != nil {
+		return *x.SupplierId
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetUnitOfMeasure() string {
+	if x != nil && x.UnitOfMeasure != nil {
+		return *x.UnitOfMeasure
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetReorderLevel() int32 {
+	if x != nil && x.ReorderLevel != nil {
+		return *x.ReorderLevel
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetMaxStockLevel() int32 {
+	if x != nil && x.MaxStockLevel != nil {
+		return *x.MaxStockLevel
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+type UpdateProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *InventoryProduct      `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductResponse) Reset() {
+	*x = UpdateProductResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductResponse) ProtoMessage() {}
+
+func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *UpdateProductResponse) GetProduct() *InventoryProduct {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type GetProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductRequest) Reset() {
+	*x = GetProductRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRequest) ProtoMessage() {}
+
+func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetProductRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *InventoryProduct      `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductResponse) Reset() {
+	*x = GetProductResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductResponse) ProtoMessage() {}
+
+func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductResponse.ProtoReflect.Descriptor instead.
+func (*GetProductResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetProductResponse) GetProduct() *InventoryProduct {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type GetProductByCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductCode   string                 `protobuf:"bytes,1,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductByCodeRequest) Reset() {
+	*x = GetProductByCodeRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductByCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductByCodeRequest) ProtoMessage() {}
+
+func (x *GetProductByCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductByCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetProductByCodeRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetProductByCodeRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+type GetProductByCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *InventoryProduct      `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductByCodeResponse) Reset() {
+	*x = GetProductByCodeResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductByCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductByCodeResponse) ProtoMessage() {}
+
+func (x *GetProductByCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductByCodeResponse.ProtoReflect.Descriptor instead.
+func (*GetProductByCodeResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetProductByCodeResponse) GetProduct() *InventoryProduct {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type ListProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pagination    *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	IsActive      *bool                  `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	ProductTypeId *int32                 `protobuf:"varint,3,opt,name=product_type_id,json=productTypeId,proto3,oneof" json:"product_type_id,omitempty"`
+	SupplierId    *int32                 `protobuf:"varint,4,opt,name=supplier_id,json=supplierId,proto3,oneof" json:"supplier_id,omitempty"`
+	SearchTerm    *string                `protobuf:"bytes,5,opt,name=search_term,json=searchTerm,proto3,oneof" json:"search_term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsRequest) ProtoMessage() {}
+
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListProductsRequest) GetPagination() *PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListProductsRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetProductTypeId() int32 {
+	if x != nil && x.ProductTypeId != nil {
+		return *x.ProductTypeId
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetSupplierId() int32 {
+	if x != nil && x.SupplierId != nil {
+		return *x.SupplierId
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetSearchTerm() string {
+	if x != nil && x.SearchTerm != nil {
+		return *x.SearchTerm
+	}
+	return ""
+}
+
+type ListProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*InventoryProduct    `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsResponse) Reset() {
+	*x = ListProductsResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsResponse) ProtoMessage() {}
+
+func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListProductsResponse) GetProducts() []*InventoryProduct {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListUnitsOfMeasureRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUnitsOfMeasureRequest) Reset() {
+	*x = ListUnitsOfMeasureRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUnitsOfMeasureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUnitsOfMeasureRequest) ProtoMessage() {}
+
+func (x *ListUnitsOfMeasureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUnitsOfMeasureRequest.ProtoReflect.Descriptor instead.
+func (*ListUnitsOfMeasureRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{76}
+}
+
+type ListUnitsOfMeasureResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UnitsOfMeasure []*UnitOfMeasure       `protobuf:"bytes,1,rep,name=units_of_measure,json=unitsOfMeasure,proto3" json:"units_of_measure,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListUnitsOfMeasureResponse) Reset() {
+	*x = ListUnitsOfMeasureResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUnitsOfMeasureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUnitsOfMeasureResponse) ProtoMessage() {}
+
+func (x *ListUnitsOfMeasureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUnitsOfMeasureResponse.ProtoReflect.Descriptor instead.
+func (*ListUnitsOfMeasureResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListUnitsOfMeasureResponse) GetUnitsOfMeasure() []*UnitOfMeasure {
+	if x != nil {
+		return x.UnitsOfMeasure
+	}
+	return nil
+}
+
+// Warehouse Operations
+type CreateWarehouseRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseCode      string                 `protobuf:"bytes,1,opt,name=warehouse_code,json=warehouseCode,proto3" json:"warehouse_code,omitempty"`
+	WarehouseName      string                 `protobuf:"bytes,2,opt,name=warehouse_name,json=warehouseName,proto3" json:"warehouse_name,omitempty"`
+	Location           *string                `protobuf:"bytes,3,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	ManagerId          *int64                 `protobuf:"varint,4,opt,name=manager_id,json=managerId,proto3,oneof" json:"manager_id,omitempty"`
+	AllowNegativeStock *bool                  `protobuf:"varint,5,opt,name=allow_negative_stock,json=allowNegativeStock,proto3,oneof" json:"allow_negative_stock,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateWarehouseRequest) Reset() {
+	*x = CreateWarehouseRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWarehouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWarehouseRequest) ProtoMessage() {}
+
+func (x *CreateWarehouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*CreateWarehouseRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *CreateWarehouseRequest) GetWarehouseCode() string {
+	if x != nil {
+		return x.WarehouseCode
+	}
+	return ""
+}
+
+func (x *CreateWarehouseRequest) GetWarehouseName() string {
+	if x != nil {
+		return x.WarehouseName
+	}
+	return ""
+}
+
+func (x *CreateWarehouseRequest) GetLocation() string {
+	if x != nil && x.Location != nil {
+		return *x.Location
+	}
+	return ""
+}
+
+func (x *CreateWarehouseRequest) GetManagerId() int64 {
+	if x != nil && x.ManagerId != nil {
+		return *x.ManagerId
+	}
+	return 0
+}
+
+func (x *CreateWarehouseRequest) GetAllowNegativeStock() bool {
+	if x != nil && x.AllowNegativeStock != nil {
+		return *x.AllowNegativeStock
+	}
+	return false
+}
+
+type CreateWarehouseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warehouse     *Warehouse             `protobuf:"bytes,1,opt,name=warehouse,proto3" json:"warehouse,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWarehouseResponse) Reset() {
+	*x = CreateWarehouseResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWarehouseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWarehouseResponse) ProtoMessage() {}
+
+func (x *CreateWarehouseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWarehouseResponse.ProtoReflect.Descriptor instead.
+func (*CreateWarehouseResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *CreateWarehouseResponse) GetWarehouse() *Warehouse {
+	if x != nil {
+		return x.Warehouse
+	}
+	return nil
+}
+
+type UpdateWarehouseRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WarehouseName      *string                `protobuf:"bytes,2,opt,name=warehouse_name,json=warehouseName,proto3,oneof" json:"warehouse_name,omitempty"`
+	Location           *string                `protobuf:"bytes,3,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	ManagerId          *int64                 `protobuf:"varint,4,opt,name=manager_id,json=managerId,proto3,oneof" json:"manager_id,omitempty"`
+	IsActive           *bool                  `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	AllowNegativeStock *bool                  `protobuf:"varint,6,opt,name=allow_negative_stock,json=allowNegativeStock,proto3,oneof" json:"allow_negative_stock,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdateWarehouseRequest) Reset() {
+	*x = UpdateWarehouseRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWarehouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWarehouseRequest) ProtoMessage() {}
+
+func (x *UpdateWarehouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWarehouseRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *UpdateWarehouseRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateWarehouseRequest) GetWarehouseName() string {
+	if x != nil && x.WarehouseName != nil {
+		return *x.WarehouseName
+	}
+	return ""
+}
+
+func (x *UpdateWarehouseRequest) GetLocation() string {
+	if x != nil && x.Location != nil {
+		return *x.Location
+	}
+	return ""
+}
+
+func (x *UpdateWarehouseRequest) GetManagerId() int64 {
+	if x != nil && x.ManagerId != nil {
+		return *x.ManagerId
+	}
+	return 0
+}
+
+func (x *UpdateWarehouseRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *UpdateWarehouseRequest) GetAllowNegativeStock() bool {
+	if x != nil && x.AllowNegativeStock != nil {
+		return *x.AllowNegativeStock
+	}
+	return false
+}
+
+type UpdateWarehouseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warehouse     *Warehouse             `protobuf:"bytes,1,opt,name=warehouse,proto3" json:"warehouse,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWarehouseResponse) Reset() {
+	*x = UpdateWarehouseResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWarehouseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWarehouseResponse) ProtoMessage() {}
+
+func (x *UpdateWarehouseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWarehouseResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWarehouseResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *UpdateWarehouseResponse) GetWarehouse() *Warehouse {
+	if x != nil {
+		return x.Warehouse
+	}
+	return nil
+}
+
+type GetWarehouseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWarehouseRequest) Reset() {
+	*x = GetWarehouseRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWarehouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWarehouseRequest) ProtoMessage() {}
+
+func (x *GetWarehouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*GetWarehouseRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetWarehouseRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetWarehouseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warehouse     *Warehouse             `protobuf:"bytes,1,opt,name=warehouse,proto3" json:"warehouse,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWarehouseResponse) Reset() {
+	*x = GetWarehouseResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWarehouseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWarehouseResponse) ProtoMessage() {}
+
+func (x *GetWarehouseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWarehouseResponse.ProtoReflect.Descriptor instead.
+func (*GetWarehouseResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetWarehouseResponse) GetWarehouse() *Warehouse {
+	if x != nil {
+		return x.Warehouse
+	}
+	return nil
+}
+
+type ListWarehousesRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Pagination *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	IsActive   *bool                  `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	// Admin only; soft-deleted rows are excluded by default.
+	IncludeDeleted *bool `protobuf:"varint,3,opt,name=include_deleted,json=includeDeleted,proto3,oneof" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListWarehousesRequest) Reset() {
+	*x = ListWarehousesRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWarehousesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWarehousesRequest) ProtoMessage() {}
+
+func (x *ListWarehousesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWarehousesRequest.ProtoReflect.Descriptor instead.
+func (*ListWarehousesRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListWarehousesRequest) GetPagination() *PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListWarehousesRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *ListWarehousesRequest) GetIncludeDeleted() bool {
+	if x != nil && x.IncludeDeleted != nil {
+		return *x.IncludeDeleted
+	}
+	return false
+}
+
+type ListWarehousesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warehouses    []*Warehouse           `protobuf:"bytes,1,rep,name=warehouses,proto3" json:"warehouses,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWarehousesResponse) Reset() {
+	*x = ListWarehousesResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWarehousesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWarehousesResponse) ProtoMessage() {}
+
+func (x *ListWarehousesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWarehousesResponse.ProtoReflect.Descriptor instead.
+func (*ListWarehousesResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ListWarehousesResponse) GetWarehouses() []*Warehouse {
+	if x != nil {
+		return x.Warehouses
+	}
+	return nil
+}
+
+func (x *ListWarehousesResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// Supplier Operations
+type CreateSupplierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SupplierCode  string                 `protobuf:"bytes,1,opt,name=supplier_code,json=supplierCode,proto3" json:"supplier_code,omitempty"`
+	SupplierName  string                 `protobuf:"bytes,2,opt,name=supplier_name,json=supplierName,proto3" json:"supplier_name,omitempty"`
+	ContactPerson *string                `protobuf:"bytes,3,opt,name=contact_person,json=contactPerson,proto3,oneof" json:"contact_person,omitempty"`
+	Phone         *string                `protobuf:"bytes,4,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
+	Email         *string                `protobuf:"bytes,5,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	Address       *string                `protobuf:"bytes,6,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSupplierRequest) Reset() {
+	*x = CreateSupplierRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSupplierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSupplierRequest) ProtoMessage() {}
+
+func (x *CreateSupplierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSupplierRequest.ProtoReflect.Descriptor instead.
+func (*CreateSupplierRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CreateSupplierRequest) GetSupplierCode() string {
+	if x != nil {
+		return x.SupplierCode
+	}
+	return ""
+}
+
+func (x *CreateSupplierRequest) GetSupplierName() string {
+	if x != nil {
+		return x.SupplierName
+	}
+	return ""
+}
+
+func (x *CreateSupplierRequest) GetContactPerson() string {
+	if x != nil && x.ContactPerson != nil {
+		return *x.ContactPerson
+	}
+	return ""
+}
+
+func (x *CreateSupplierRequest) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
+	}
+	return ""
+}
+
+func (x *CreateSupplierRequest) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *CreateSupplierRequest) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+type CreateSupplierResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Supplier      *Supplier              `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSupplierResponse) Reset() {
+	*x = CreateSupplierResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSupplierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSupplierResponse) ProtoMessage() {}
+
+func (x *CreateSupplierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSupplierResponse.ProtoReflect.Descriptor instead.
+func (*CreateSupplierResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *CreateSupplierResponse) GetSupplier() *Supplier {
+	if x != nil {
+		return x.Supplier
+	}
+	return nil
+}
+
+type UpdateSupplierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SupplierName  *string                `protobuf:"bytes,2,opt,name=supplier_name,json=supplierName,proto3,oneof" json:"supplier_name,omitempty"`
+	ContactPerson *string                `protobuf:"bytes,3,opt,name=contact_person,json=contactPerson,proto3,oneof" json:"contact_person,omitempty"`
+	Phone         *string                `protobuf:"bytes,4,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
+	Email         *string                `protobuf:"bytes,5,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	Address       *string                `protobuf:"bytes,6,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	IsActive      *bool                  `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSupplierRequest) Reset() {
+	*x = UpdateSupplierRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSupplierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSupplierRequest) ProtoMessage() {}
+
+func (x *UpdateSupplierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSupplierRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSupplierRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *UpdateSupplierRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateSupplierRequest) GetSupplierName() string {
+	if x != nil && x.SupplierName != nil {
+		return *x.SupplierName
+	}
+	return ""
+}
+
+func (x *UpdateSupplierRequest) GetContactPerson() string {
+	if x != nil && x.ContactPerson != nil {
+		return *x.ContactPerson
+	}
+	return ""
+}
+
+func (x *UpdateSupplierRequest) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateSupplierRequest) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *UpdateSupplierRequest) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+func (x *UpdateSupplierRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+type UpdateSupplierResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Supplier      *Supplier              `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSupplierResponse) Reset() {
+	*x = UpdateSupplierResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSupplierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSupplierResponse) ProtoMessage() {}
+
+func (x *UpdateSupplierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSupplierResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSupplierResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *UpdateSupplierResponse) GetSupplier() *Supplier {
+	if x != nil {
+		return x.Supplier
+	}
+	return nil
+}
+
+type GetSupplierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSupplierRequest) Reset() {
+	*x = GetSupplierRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSupplierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSupplierRequest) ProtoMessage() {}
+
+func (x *GetSupplierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSupplierRequest.ProtoReflect.Descriptor instead.
+func (*GetSupplierRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetSupplierRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetSupplierResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Supplier      *Supplier              `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSupplierResponse) Reset() {
+	*x = GetSupplierResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSupplierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSupplierResponse) ProtoMessage() {}
+
+func (x *GetSupplierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSupplierResponse.ProtoReflect.Descriptor instead.
+func (*GetSupplierResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetSupplierResponse) GetSupplier() *Supplier {
+	if x != nil {
+		return x.Supplier
+	}
+	return nil
+}
+
+type ListSuppliersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Pagination     *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	IsActive       *bool                  `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	IncludeDeleted *bool                  `protobuf:"varint,3,opt,name=include_deleted,json=includeDeleted,proto3,oneof" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListSuppliersRequest) Reset() {
+	*x = ListSuppliersRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSuppliersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSuppliersRequest) ProtoMessage() {}
+
+func (x *ListSuppliersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSuppliersRequest.ProtoReflect.Descriptor instead.
+func (*ListSuppliersRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ListSuppliersRequest) GetPagination() *PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListSuppliersRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *ListSuppliersRequest) GetIncludeDeleted() bool {
+	if x != nil && x.IncludeDeleted != nil {
+		return *x.IncludeDeleted
+	}
+	return false
+}
+
+type ListSuppliersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suppliers     []*Supplier            `protobuf:"bytes,1,rep,name=suppliers,proto3" json:"suppliers,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSuppliersResponse) Reset() {
+	*x = ListSuppliersResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSuppliersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSuppliersResponse) ProtoMessage() {}
+
+func (x *ListSuppliersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSuppliersResponse.ProtoReflect.Descriptor instead.
+func (*ListSuppliersResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ListSuppliersResponse) GetSuppliers() []*Supplier {
+	if x != nil {
+		return x.Suppliers
+	}
+	return nil
+}
+
+func (x *ListSuppliersResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// Product Type Operations
+type CreateProductTypeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductTypeName string                 `protobuf:"bytes,1,opt,name=product_type_name,json=productTypeName,proto3" json:"product_type_name,omitempty"`
+	Description     *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateProductTypeRequest) Reset() {
+	*x = CreateProductTypeRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductTypeRequest) ProtoMessage() {}
+
+func (x *CreateProductTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductTypeRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductTypeRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *UpdateProductRequest) GetId() int32 {
+func (x *CreateProductTypeRequest) GetProductTypeName() string {
 	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *UpdateProductRequest) GetProductName() string {
-	if x != nil && x.ProductName != nil {
-		return *x.ProductName
+		return x.ProductTypeName
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetProductTypeId() int32 {
-	if x != nil && x.ProductTypeId != nil {
-		return *x.ProductTypeId
-	}
-	return 0
-}
-
-func (x *UpdateProductRequest) GetSupplierId() int32 {
-	if x != nil && x.SupplierId != nil {
-		return *x.SupplierId
-	}
-	return 0
-}
-
-func (x *UpdateProductRequest) GetUnitOfMeasure() string {
-	if x != nil && x.UnitOfMeasure != nil {
-		return *x.UnitOfMeasure
+func (x *CreateProductTypeRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetReorderLevel() int32 {
-	if x != nil && x.ReorderLevel != nil {
-		return *x.ReorderLevel
-	}
-	return 0
-}
-
-func (x *UpdateProductRequest) GetMaxStockLevel() int32 {
-	if x != nil && x.MaxStockLevel != nil {
-		return *x.MaxStockLevel
-	}
-	return 0
-}
-
-func (x *UpdateProductRequest) GetIsActive() bool {
-	if x != nil && x.IsActive != nil {
-		return *x.IsActive
-	}
-	return false
-}
-
-type UpdateProductResponse struct {
+type CreateProductTypeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Product       *InventoryProduct      `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	ProductType   *ProductType           `protobuf:"bytes,1,opt,name=product_type,json=productType,proto3" json:"product_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProductResponse) Reset() {
-	*x = UpdateProductResponse{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[26]
+func (x *CreateProductTypeResponse) Reset() {
+	*x = CreateProductTypeResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProductResponse) String() string {
+func (x *CreateProductTypeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductResponse) ProtoMessage() {}
+func (*CreateProductTypeResponse) ProtoMessage() {}
 
-func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[26]
+func (x *CreateProductTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2130,40 +6913,43 @@ func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProductResponse.ProtoReflect.Descriptor instead.
-func (*UpdateProductResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use CreateProductTypeResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductTypeResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *UpdateProductResponse) GetProduct() *InventoryProduct {
+func (x *CreateProductTypeResponse) GetProductType() *ProductType {
 	if x != nil {
-		return x.Product
+		return x.ProductType
 	}
 	return nil
 }
 
-type GetProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpdateProductTypeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductTypeName *string                `protobuf:"bytes,2,opt,name=product_type_name,json=productTypeName,proto3,oneof" json:"product_type_name,omitempty"`
+	Description     *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	IsActive        *bool                  `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetProductRequest) Reset() {
-	*x = GetProductRequest{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[27]
+func (x *UpdateProductTypeRequest) Reset() {
+	*x = UpdateProductTypeRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductRequest) String() string {
+func (x *UpdateProductTypeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductRequest) ProtoMessage() {}
+func (*UpdateProductTypeRequest) ProtoMessage() {}
 
-func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[27]
+func (x *UpdateProductTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2174,40 +6960,61 @@ func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
-func (*GetProductRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use UpdateProductTypeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductTypeRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *GetProductRequest) GetId() int32 {
+func (x *UpdateProductTypeRequest) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type GetProductResponse struct {
+func (x *UpdateProductTypeRequest) GetProductTypeName() string {
+	if x != nil && x.ProductTypeName != nil {
+		return *x.ProductTypeName
+	}
+	return ""
+}
+
+func (x *UpdateProductTypeRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateProductTypeRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+type UpdateProductTypeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Product       *InventoryProduct      `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	ProductType   *ProductType           `protobuf:"bytes,1,opt,name=product_type,json=productType,proto3" json:"product_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductResponse) Reset() {
-	*x = GetProductResponse{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[28]
+func (x *UpdateProductTypeResponse) Reset() {
+	*x = UpdateProductTypeResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductResponse) String() string {
+func (x *UpdateProductTypeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductResponse) ProtoMessage() {}
+func (*UpdateProductTypeResponse) ProtoMessage() {}
 
-func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[28]
+func (x *UpdateProductTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2218,40 +7025,41 @@ func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductResponse.ProtoReflect.Descriptor instead.
-func (*GetProductResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use UpdateProductTypeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductTypeResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *GetProductResponse) GetProduct() *InventoryProduct {
+func (x *UpdateProductTypeResponse) GetProductType() *ProductType {
 	if x != nil {
-		return x.Product
+		return x.ProductType
 	}
 	return nil
 }
 
-type GetProductByCodeRequest struct {
+type ListProductTypesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductCode   string                 `protobuf:"bytes,1,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`
+	Pagination    *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	IsActive      *bool                  `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductByCodeRequest) Reset() {
-	*x = GetProductByCodeRequest{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[29]
+func (x *ListProductTypesRequest) Reset() {
+	*x = ListProductTypesRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductByCodeRequest) String() string {
+func (x *ListProductTypesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductByCodeRequest) ProtoMessage() {}
+func (*ListProductTypesRequest) ProtoMessage() {}
 
-func (x *GetProductByCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[29]
+func (x *ListProductTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2262,40 +7070,48 @@ func (x *GetProductByCodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductByCodeRequest.ProtoReflect.Descriptor instead.
-func (*GetProductByCodeRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ListProductTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListProductTypesRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *GetProductByCodeRequest) GetProductCode() string {
+func (x *ListProductTypesRequest) GetPagination() *PaginationRequest {
 	if x != nil {
-		return x.ProductCode
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-type GetProductByCodeResponse struct {
+func (x *ListProductTypesRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+type ListProductTypesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Product       *InventoryProduct      `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	ProductTypes  []*ProductType         `protobuf:"bytes,1,rep,name=product_types,json=productTypes,proto3" json:"product_types,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductByCodeResponse) Reset() {
-	*x = GetProductByCodeResponse{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[30]
+func (x *ListProductTypesResponse) Reset() {
+	*x = ListProductTypesResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductByCodeResponse) String() string {
+func (x *ListProductTypesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductByCodeResponse) ProtoMessage() {}
+func (*ListProductTypesResponse) ProtoMessage() {}
 
-func (x *GetProductByCodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[30]
+func (x *ListProductTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2306,44 +7122,50 @@ func (x *GetProductByCodeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductByCodeResponse.ProtoReflect.Descriptor instead.
-func (*GetProductByCodeResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ListProductTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListProductTypesResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *GetProductByCodeResponse) GetProduct() *InventoryProduct {
+func (x *ListProductTypesResponse) GetProductTypes() []*ProductType {
 	if x != nil {
-		return x.Product
+		return x.ProductTypes
 	}
 	return nil
 }
 
-type ListProductsRequest struct {
+func (x *ListProductTypesResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// Stock Count Operations
+type StartStockCountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pagination    *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	IsActive      *bool                  `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
-	ProductTypeId *int32                 `protobuf:"varint,3,opt,name=product_type_id,json=productTypeId,proto3,oneof" json:"product_type_id,omitempty"`
-	SupplierId    *int32                 `protobuf:"varint,4,opt,name=supplier_id,json=supplierId,proto3,oneof" json:"supplier_id,omitempty"`
-	SearchTerm    *string                `protobuf:"bytes,5,opt,name=search_term,json=searchTerm,proto3,oneof" json:"search_term,omitempty"`
+	WarehouseId   int32                  `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Notes         *string                `protobuf:"bytes,2,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	StartedBy     int64                  `protobuf:"varint,3,opt,name=started_by,json=startedBy,proto3" json:"started_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductsRequest) Reset() {
-	*x = ListProductsRequest{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[31]
+func (x *StartStockCountRequest) Reset() {
+	*x = StartStockCountRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsRequest) String() string {
+func (x *StartStockCountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsRequest) ProtoMessage() {}
+func (*StartStockCountRequest) ProtoMessage() {}
 
-func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[31]
+func (x *StartStockCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2354,69 +7176,54 @@ func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
-func (*ListProductsRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use StartStockCountRequest.ProtoReflect.Descriptor instead.
+func (*StartStockCountRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *ListProductsRequest) GetPagination() *PaginationRequest {
+func (x *StartStockCountRequest) GetWarehouseId() int32 {
 	if x != nil {
-		return x.Pagination
-	}
-	return nil
-}
-
-func (x *ListProductsRequest) GetIsActive() bool {
-	if x != nil && x.IsActive != nil {
-		return *x.IsActive
-	}
-	return false
-}
-
-func (x *ListProductsRequest) GetProductTypeId() int32 {
-	if x != nil && x.ProductTypeId != nil {
-		return *x.ProductTypeId
+		return x.WarehouseId
 	}
 	return 0
 }
 
-func (x *ListProductsRequest) GetSupplierId() int32 {
-	if x != nil && x.SupplierId != nil {
-		return *x.SupplierId
+func (x *StartStockCountRequest) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
 	}
-	return 0
+	return ""
 }
 
-func (x *ListProductsRequest) GetSearchTerm() string {
-	if x != nil && x.SearchTerm != nil {
-		return *x.SearchTerm
+func (x *StartStockCountRequest) GetStartedBy() int64 {
+	if x != nil {
+		return x.StartedBy
 	}
-	return ""
+	return 0
 }
 
-type ListProductsResponse struct {
+type StartStockCountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*InventoryProduct    `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
-	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	StockCount    *StockCount            `protobuf:"bytes,1,opt,name=stock_count,json=stockCount,proto3" json:"stock_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListProductsResponse) Reset() {
-	*x = ListProductsResponse{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[32]
+}
+
+func (x *StartStockCountResponse) Reset() {
+	*x = StartStockCountResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsResponse) String() string {
+func (x *StartStockCountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsResponse) ProtoMessage() {}
+func (*StartStockCountResponse) ProtoMessage() {}
 
-func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[32]
+func (x *StartStockCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2427,51 +7234,43 @@ func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
-func (*ListProductsResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *ListProductsResponse) GetProducts() []*InventoryProduct {
-	if x != nil {
-		return x.Products
-	}
-	return nil
+// Deprecated: Use StartStockCountResponse.ProtoReflect.Descriptor instead.
+func (*StartStockCountResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *ListProductsResponse) GetPagination() *PaginationResponse {
+func (x *StartStockCountResponse) GetStockCount() *StockCount {
 	if x != nil {
-		return x.Pagination
+		return x.StockCount
 	}
 	return nil
 }
 
-// Warehouse Operations
-type CreateWarehouseRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	WarehouseCode string                 `protobuf:"bytes,1,opt,name=warehouse_code,json=warehouseCode,proto3" json:"warehouse_code,omitempty"`
-	WarehouseName string                 `protobuf:"bytes,2,opt,name=warehouse_name,json=warehouseName,proto3" json:"warehouse_name,omitempty"`
-	Location      *string                `protobuf:"bytes,3,opt,name=location,proto3,oneof" json:"location,omitempty"`
-	ManagerId     *int64                 `protobuf:"varint,4,opt,name=manager_id,json=managerId,proto3,oneof" json:"manager_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Resubmitting a product replaces its counted quantity.
+type SubmitStockCountLineRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StockCountId    int64                  `protobuf:"varint,1,opt,name=stock_count_id,json=stockCountId,proto3" json:"stock_count_id,omitempty"`
+	ProductId       int32                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	CountedQuantity int32                  `protobuf:"varint,3,opt,name=counted_quantity,json=countedQuantity,proto3" json:"counted_quantity,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CreateWarehouseRequest) Reset() {
-	*x = CreateWarehouseRequest{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[33]
+func (x *SubmitStockCountLineRequest) Reset() {
+	*x = SubmitStockCountLineRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateWarehouseRequest) String() string {
+func (x *SubmitStockCountLineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateWarehouseRequest) ProtoMessage() {}
+func (*SubmitStockCountLineRequest) ProtoMessage() {}
 
-func (x *CreateWarehouseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[33]
+func (x *SubmitStockCountLineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2482,61 +7281,54 @@ func (x *CreateWarehouseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateWarehouseRequest.ProtoReflect.Descriptor instead.
-func (*CreateWarehouseRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use SubmitStockCountLineRequest.ProtoReflect.Descriptor instead.
+func (*SubmitStockCountLineRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *CreateWarehouseRequest) GetWarehouseCode() string {
+func (x *SubmitStockCountLineRequest) GetStockCountId() int64 {
 	if x != nil {
-		return x.WarehouseCode
+		return x.StockCountId
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateWarehouseRequest) GetWarehouseName() string {
+func (x *SubmitStockCountLineRequest) GetProductId() int32 {
 	if x != nil {
-		return x.WarehouseName
-	}
-	return ""
-}
-
-func (x *CreateWarehouseRequest) GetLocation() string {
-	if x != nil && x.Location != nil {
-		return *x.Location
+		return x.ProductId
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateWarehouseRequest) GetManagerId() int64 {
-	if x != nil && x.ManagerId != nil {
-		return *x.ManagerId
+func (x *SubmitStockCountLineRequest) GetCountedQuantity() int32 {
+	if x != nil {
+		return x.CountedQuantity
 	}
 	return 0
 }
 
-type CreateWarehouseResponse struct {
+type SubmitStockCountLineResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Warehouse     *Warehouse             `protobuf:"bytes,1,opt,name=warehouse,proto3" json:"warehouse,omitempty"`
+	Line          *StockCountLine        `protobuf:"bytes,1,opt,name=line,proto3" json:"line,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateWarehouseResponse) Reset() {
-	*x = CreateWarehouseResponse{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[34]
+func (x *SubmitStockCountLineResponse) Reset() {
+	*x = SubmitStockCountLineResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateWarehouseResponse) String() string {
+func (x *SubmitStockCountLineResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateWarehouseResponse) ProtoMessage() {}
+func (*SubmitStockCountLineResponse) ProtoMessage() {}
 
-func (x *CreateWarehouseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[34]
+func (x *SubmitStockCountLineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2547,40 +7339,43 @@ func (x *CreateWarehouseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateWarehouseResponse.ProtoReflect.Descriptor instead.
-func (*CreateWarehouseResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use SubmitStockCountLineResponse.ProtoReflect.Descriptor instead.
+func (*SubmitStockCountLineResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *CreateWarehouseResponse) GetWarehouse() *Warehouse {
+func (x *SubmitStockCountLineResponse) GetLine() *StockCountLine {
 	if x != nil {
-		return x.Warehouse
+		return x.Line
 	}
 	return nil
 }
 
-type GetWarehouseRequest struct {
+// Writes one adjustment movement per variance, atomically, with the
+// affected stock rows locked.
+type FinalizeStockCountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StockCountId  int64                  `protobuf:"varint,1,opt,name=stock_count_id,json=stockCountId,proto3" json:"stock_count_id,omitempty"`
+	FinalizedBy   int64                  `protobuf:"varint,2,opt,name=finalized_by,json=finalizedBy,proto3" json:"finalized_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWarehouseRequest) Reset() {
-	*x = GetWarehouseRequest{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[35]
+func (x *FinalizeStockCountRequest) Reset() {
+	*x = FinalizeStockCountRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWarehouseRequest) String() string {
+func (x *FinalizeStockCountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWarehouseRequest) ProtoMessage() {}
+func (*FinalizeStockCountRequest) ProtoMessage() {}
 
-func (x *GetWarehouseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[35]
+func (x *FinalizeStockCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2591,40 +7386,48 @@ func (x *GetWarehouseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWarehouseRequest.ProtoReflect.Descriptor instead.
-func (*GetWarehouseRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use FinalizeStockCountRequest.ProtoReflect.Descriptor instead.
+func (*FinalizeStockCountRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *GetWarehouseRequest) GetId() int32 {
+func (x *FinalizeStockCountRequest) GetStockCountId() int64 {
 	if x != nil {
-		return x.Id
+		return x.StockCountId
 	}
 	return 0
 }
 
-type GetWarehouseResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Warehouse     *Warehouse             `protobuf:"bytes,1,opt,name=warehouse,proto3" json:"warehouse,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *FinalizeStockCountRequest) GetFinalizedBy() int64 {
+	if x != nil {
+		return x.FinalizedBy
+	}
+	return 0
 }
 
-func (x *GetWarehouseResponse) Reset() {
-	*x = GetWarehouseResponse{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[36]
+type FinalizeStockCountResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StockCount     *StockCount            `protobuf:"bytes,1,opt,name=stock_count,json=stockCount,proto3" json:"stock_count,omitempty"`
+	StockMovements []*StockMovement       `protobuf:"bytes,2,rep,name=stock_movements,json=stockMovements,proto3" json:"stock_movements,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FinalizeStockCountResponse) Reset() {
+	*x = FinalizeStockCountResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWarehouseResponse) String() string {
+func (x *FinalizeStockCountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWarehouseResponse) ProtoMessage() {}
+func (*FinalizeStockCountResponse) ProtoMessage() {}
 
-func (x *GetWarehouseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[36]
+func (x *FinalizeStockCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2635,41 +7438,50 @@ func (x *GetWarehouseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWarehouseResponse.ProtoReflect.Descriptor instead.
-func (*GetWarehouseResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use FinalizeStockCountResponse.ProtoReflect.Descriptor instead.
+func (*FinalizeStockCountResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *GetWarehouseResponse) GetWarehouse() *Warehouse {
+func (x *FinalizeStockCountResponse) GetStockCount() *StockCount {
 	if x != nil {
-		return x.Warehouse
+		return x.StockCount
 	}
 	return nil
 }
 
-type ListWarehousesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pagination    *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	IsActive      *bool                  `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *FinalizeStockCountResponse) GetStockMovements() []*StockMovement {
+	if x != nil {
+		return x.StockMovements
+	}
+	return nil
 }
 
-func (x *ListWarehousesRequest) Reset() {
-	*x = ListWarehousesRequest{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[37]
+// Purchase Order Operations
+type CreatePurchaseOrderLineRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductId       int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	OrderedQuantity int32                  `protobuf:"varint,2,opt,name=ordered_quantity,json=orderedQuantity,proto3" json:"ordered_quantity,omitempty"`
+	UnitCost        string                 `protobuf:"bytes,3,opt,name=unit_cost,json=unitCost,proto3" json:"unit_cost,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreatePurchaseOrderLineRequest) Reset() {
+	*x = CreatePurchaseOrderLineRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListWarehousesRequest) String() string {
+func (x *CreatePurchaseOrderLineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWarehousesRequest) ProtoMessage() {}
+func (*CreatePurchaseOrderLineRequest) ProtoMessage() {}
 
-func (x *ListWarehousesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[37]
+func (x *CreatePurchaseOrderLineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2680,48 +7492,60 @@ func (x *ListWarehousesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWarehousesRequest.ProtoReflect.Descriptor instead.
-func (*ListWarehousesRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use CreatePurchaseOrderLineRequest.ProtoReflect.Descriptor instead.
+func (*CreatePurchaseOrderLineRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *ListWarehousesRequest) GetPagination() *PaginationRequest {
+func (x *CreatePurchaseOrderLineRequest) GetProductId() int32 {
 	if x != nil {
-		return x.Pagination
+		return x.ProductId
 	}
-	return nil
+	return 0
 }
 
-func (x *ListWarehousesRequest) GetIsActive() bool {
-	if x != nil && x.IsActive != nil {
-		return *x.IsActive
+func (x *CreatePurchaseOrderLineRequest) GetOrderedQuantity() int32 {
+	if x != nil {
+		return x.OrderedQuantity
 	}
-	return false
+	return 0
 }
 
-type ListWarehousesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Warehouses    []*Warehouse           `protobuf:"bytes,1,rep,name=warehouses,proto3" json:"warehouses,omitempty"`
-	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (x *CreatePurchaseOrderLineRequest) GetUnitCost() string {
+	if x != nil {
+		return x.UnitCost
+	}
+	return ""
+}
+
+type CreatePurchaseOrderRequest struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	PoNumber      string                            `protobuf:"bytes,1,opt,name=po_number,json=poNumber,proto3" json:"po_number,omitempty"`
+	SupplierId    int32                             `protobuf:"varint,2,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
+	WarehouseId   int32                             `protobuf:"varint,3,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Lines         []*CreatePurchaseOrderLineRequest `protobuf:"bytes,4,rep,name=lines,proto3" json:"lines,omitempty"`
+	ExpectedDate  *string                           `protobuf:"bytes,5,opt,name=expected_date,json=expectedDate,proto3,oneof" json:"expected_date,omitempty"`
+	Notes         *string                           `protobuf:"bytes,6,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	CreatedBy     int64                             `protobuf:"varint,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListWarehousesResponse) Reset() {
-	*x = ListWarehousesResponse{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[38]
+func (x *CreatePurchaseOrderRequest) Reset() {
+	*x = CreatePurchaseOrderRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListWarehousesResponse) String() string {
+func (x *CreatePurchaseOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWarehousesResponse) ProtoMessage() {}
+func (*CreatePurchaseOrderRequest) ProtoMessage() {}
 
-func (x *ListWarehousesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[38]
+func (x *CreatePurchaseOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2732,53 +7556,82 @@ func (x *ListWarehousesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWarehousesResponse.ProtoReflect.Descriptor instead.
-func (*ListWarehousesResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use CreatePurchaseOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreatePurchaseOrderRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *ListWarehousesResponse) GetWarehouses() []*Warehouse {
+func (x *CreatePurchaseOrderRequest) GetPoNumber() string {
 	if x != nil {
-		return x.Warehouses
+		return x.PoNumber
 	}
-	return nil
+	return ""
 }
 
-func (x *ListWarehousesResponse) GetPagination() *PaginationResponse {
+func (x *CreatePurchaseOrderRequest) GetSupplierId() int32 {
 	if x != nil {
-		return x.Pagination
+		return x.SupplierId
+	}
+	return 0
+}
+
+func (x *CreatePurchaseOrderRequest) GetWarehouseId() int32 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *CreatePurchaseOrderRequest) GetLines() []*CreatePurchaseOrderLineRequest {
+	if x != nil {
+		return x.Lines
 	}
 	return nil
 }
 
-// Supplier Operations
-type CreateSupplierRequest struct {
+func (x *CreatePurchaseOrderRequest) GetExpectedDate() string {
+	if x != nil && x.ExpectedDate != nil {
+		return *x.ExpectedDate
+	}
+	return ""
+}
+
+func (x *CreatePurchaseOrderRequest) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+func (x *CreatePurchaseOrderRequest) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+type CreatePurchaseOrderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SupplierCode  string                 `protobuf:"bytes,1,opt,name=supplier_code,json=supplierCode,proto3" json:"supplier_code,omitempty"`
-	SupplierName  string                 `protobuf:"bytes,2,opt,name=supplier_name,json=supplierName,proto3" json:"supplier_name,omitempty"`
-	ContactPerson *string                `protobuf:"bytes,3,opt,name=contact_person,json=contactPerson,proto3,oneof" json:"contact_person,omitempty"`
-	Phone         *string                `protobuf:"bytes,4,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
-	Email         *string                `protobuf:"bytes,5,opt,name=email,proto3,oneof" json:"email,omitempty"`
-	Address       *string                `protobuf:"bytes,6,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	PurchaseOrder *PurchaseOrder         `protobuf:"bytes,1,opt,name=purchase_order,json=purchaseOrder,proto3" json:"purchase_order,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSupplierRequest) Reset() {
-	*x = CreateSupplierRequest{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[39]
+func (x *CreatePurchaseOrderResponse) Reset() {
+	*x = CreatePurchaseOrderResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSupplierRequest) String() string {
+func (x *CreatePurchaseOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSupplierRequest) ProtoMessage() {}
+func (*CreatePurchaseOrderResponse) ProtoMessage() {}
 
-func (x *CreateSupplierRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[39]
+func (x *CreatePurchaseOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2789,75 +7642,46 @@ func (x *CreateSupplierRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSupplierRequest.ProtoReflect.Descriptor instead.
-func (*CreateSupplierRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{39}
-}
-
-func (x *CreateSupplierRequest) GetSupplierCode() string {
-	if x != nil {
-		return x.SupplierCode
-	}
-	return ""
-}
-
-func (x *CreateSupplierRequest) GetSupplierName() string {
-	if x != nil {
-		return x.SupplierName
-	}
-	return ""
-}
-
-func (x *CreateSupplierRequest) GetContactPerson() string {
-	if x != nil && x.ContactPerson != nil {
-		return *x.ContactPerson
-	}
-	return ""
-}
-
-func (x *CreateSupplierRequest) GetPhone() string {
-	if x != nil && x.Phone != nil {
-		return *x.Phone
-	}
-	return ""
-}
-
-func (x *CreateSupplierRequest) GetEmail() string {
-	if x != nil && x.Email != nil {
-		return *x.Email
-	}
-	return ""
+// Deprecated: Use CreatePurchaseOrderResponse.ProtoReflect.Descriptor instead.
+func (*CreatePurchaseOrderResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *CreateSupplierRequest) GetAddress() string {
-	if x != nil && x.Address != nil {
-		return *x.Address
+func (x *CreatePurchaseOrderResponse) GetPurchaseOrder() *PurchaseOrder {
+	if x != nil {
+		return x.PurchaseOrder
 	}
-	return ""
+	return nil
 }
 
-type CreateSupplierResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Supplier      *Supplier              `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
+type ReceivePurchaseOrderLineRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	PurchaseOrderLineId int64                  `protobuf:"varint,1,opt,name=purchase_order_line_id,json=purchaseOrderLineId,proto3" json:"purchase_order_line_id,omitempty"`
+	ReceivedQuantity    int32                  `protobuf:"varint,2,opt,name=received_quantity,json=receivedQuantity,proto3" json:"received_quantity,omitempty"`
+	// Required for lot-tracked products, as on UpdateStockRequest.
+	LotNumber  *string `protobuf:"bytes,3,opt,name=lot_number,json=lotNumber,proto3,oneof" json:"lot_number,omitempty"`
+	ExpiryDate *string `protobuf:"bytes,4,opt,name=expiry_date,json=expiryDate,proto3,oneof" json:"expiry_date,omitempty"`
+	// Required for serialized products, one per received unit.
+	SerialNumbers []string `protobuf:"bytes,5,rep,name=serial_numbers,json=serialNumbers,proto3" json:"serial_numbers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSupplierResponse) Reset() {
-	*x = CreateSupplierResponse{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[40]
+func (x *ReceivePurchaseOrderLineRequest) Reset() {
+	*x = ReceivePurchaseOrderLineRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSupplierResponse) String() string {
+func (x *ReceivePurchaseOrderLineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSupplierResponse) ProtoMessage() {}
+func (*ReceivePurchaseOrderLineRequest) ProtoMessage() {}
 
-func (x *CreateSupplierResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[40]
+func (x *ReceivePurchaseOrderLineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2868,40 +7692,74 @@ func (x *CreateSupplierResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSupplierResponse.ProtoReflect.Descriptor instead.
-func (*CreateSupplierResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use ReceivePurchaseOrderLineRequest.ProtoReflect.Descriptor instead.
+func (*ReceivePurchaseOrderLineRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *CreateSupplierResponse) GetSupplier() *Supplier {
+func (x *ReceivePurchaseOrderLineRequest) GetPurchaseOrderLineId() int64 {
 	if x != nil {
-		return x.Supplier
+		return x.PurchaseOrderLineId
+	}
+	return 0
+}
+
+func (x *ReceivePurchaseOrderLineRequest) GetReceivedQuantity() int32 {
+	if x != nil {
+		return x.ReceivedQuantity
+	}
+	return 0
+}
+
+func (x *ReceivePurchaseOrderLineRequest) GetLotNumber() string {
+	if x != nil && x.LotNumber != nil {
+		return *x.LotNumber
+	}
+	return ""
+}
+
+func (x *ReceivePurchaseOrderLineRequest) GetExpiryDate() string {
+	if x != nil && x.ExpiryDate != nil {
+		return *x.ExpiryDate
+	}
+	return ""
+}
+
+func (x *ReceivePurchaseOrderLineRequest) GetSerialNumbers() []string {
+	if x != nil {
+		return x.SerialNumbers
 	}
 	return nil
 }
 
-type GetSupplierRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Each received line is booked as a MOVEMENT_TYPE_IN movement with
+// REFERENCE_TYPE_PURCHASE and the PO number as reference_id. Leaving
+// lines empty receives everything still outstanding.
+type ReceivePurchaseOrderRequest struct {
+	state           protoimpl.MessageState             `protogen:"open.v1"`
+	PurchaseOrderId int64                              `protobuf:"varint,1,opt,name=purchase_order_id,json=purchaseOrderId,proto3" json:"purchase_order_id,omitempty"`
+	Lines           []*ReceivePurchaseOrderLineRequest `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	ReceivedBy      int64                              `protobuf:"varint,3,opt,name=received_by,json=receivedBy,proto3" json:"received_by,omitempty"`
+	Notes           *string                            `protobuf:"bytes,4,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetSupplierRequest) Reset() {
-	*x = GetSupplierRequest{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[41]
+func (x *ReceivePurchaseOrderRequest) Reset() {
+	*x = ReceivePurchaseOrderRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSupplierRequest) String() string {
+func (x *ReceivePurchaseOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSupplierRequest) ProtoMessage() {}
+func (*ReceivePurchaseOrderRequest) ProtoMessage() {}
 
-func (x *GetSupplierRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[41]
+func (x *ReceivePurchaseOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2912,40 +7770,62 @@ func (x *GetSupplierRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSupplierRequest.ProtoReflect.Descriptor instead.
-func (*GetSupplierRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use ReceivePurchaseOrderRequest.ProtoReflect.Descriptor instead.
+func (*ReceivePurchaseOrderRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *GetSupplierRequest) GetId() int32 {
+func (x *ReceivePurchaseOrderRequest) GetPurchaseOrderId() int64 {
 	if x != nil {
-		return x.Id
+		return x.PurchaseOrderId
 	}
 	return 0
 }
 
-type GetSupplierResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Supplier      *Supplier              `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ReceivePurchaseOrderRequest) GetLines() []*ReceivePurchaseOrderLineRequest {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
 }
 
-func (x *GetSupplierResponse) Reset() {
-	*x = GetSupplierResponse{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[42]
+func (x *ReceivePurchaseOrderRequest) GetReceivedBy() int64 {
+	if x != nil {
+		return x.ReceivedBy
+	}
+	return 0
+}
+
+func (x *ReceivePurchaseOrderRequest) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+type ReceivePurchaseOrderResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PurchaseOrder  *PurchaseOrder         `protobuf:"bytes,1,opt,name=purchase_order,json=purchaseOrder,proto3" json:"purchase_order,omitempty"`
+	StockMovements []*StockMovement       `protobuf:"bytes,2,rep,name=stock_movements,json=stockMovements,proto3" json:"stock_movements,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReceivePurchaseOrderResponse) Reset() {
+	*x = ReceivePurchaseOrderResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSupplierResponse) String() string {
+func (x *ReceivePurchaseOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSupplierResponse) ProtoMessage() {}
+func (*ReceivePurchaseOrderResponse) ProtoMessage() {}
 
-func (x *GetSupplierResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[42]
+func (x *ReceivePurchaseOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2956,41 +7836,47 @@ func (x *GetSupplierResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSupplierResponse.ProtoReflect.Descriptor instead.
-func (*GetSupplierResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use ReceivePurchaseOrderResponse.ProtoReflect.Descriptor instead.
+func (*ReceivePurchaseOrderResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *GetSupplierResponse) GetSupplier() *Supplier {
+func (x *ReceivePurchaseOrderResponse) GetPurchaseOrder() *PurchaseOrder {
 	if x != nil {
-		return x.Supplier
+		return x.PurchaseOrder
 	}
 	return nil
 }
 
-type ListSuppliersRequest struct {
+func (x *ReceivePurchaseOrderResponse) GetStockMovements() []*StockMovement {
+	if x != nil {
+		return x.StockMovements
+	}
+	return nil
+}
+
+type GetPurchaseOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pagination    *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	IsActive      *bool                  `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSuppliersRequest) Reset() {
-	*x = ListSuppliersRequest{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[43]
+func (x *GetPurchaseOrderRequest) Reset() {
+	*x = GetPurchaseOrderRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSuppliersRequest) String() string {
+func (x *GetPurchaseOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSuppliersRequest) ProtoMessage() {}
+func (*GetPurchaseOrderRequest) ProtoMessage() {}
 
-func (x *ListSuppliersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[43]
+func (x *GetPurchaseOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3001,48 +7887,40 @@ func (x *ListSuppliersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSuppliersRequest.ProtoReflect.Descriptor instead.
-func (*ListSuppliersRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use GetPurchaseOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetPurchaseOrderRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *ListSuppliersRequest) GetPagination() *PaginationRequest {
+func (x *GetPurchaseOrderRequest) GetId() int64 {
 	if x != nil {
-		return x.Pagination
-	}
-	return nil
-}
-
-func (x *ListSuppliersRequest) GetIsActive() bool {
-	if x != nil && x.IsActive != nil {
-		return *x.IsActive
+		return x.Id
 	}
-	return false
+	return 0
 }
 
-type ListSuppliersResponse struct {
+type GetPurchaseOrderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Suppliers     []*Supplier            `protobuf:"bytes,1,rep,name=suppliers,proto3" json:"suppliers,omitempty"`
-	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	PurchaseOrder *PurchaseOrder         `protobuf:"bytes,1,opt,name=purchase_order,json=purchaseOrder,proto3" json:"purchase_order,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSuppliersResponse) Reset() {
-	*x = ListSuppliersResponse{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[44]
+func (x *GetPurchaseOrderResponse) Reset() {
+	*x = GetPurchaseOrderResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSuppliersResponse) String() string {
+func (x *GetPurchaseOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSuppliersResponse) ProtoMessage() {}
+func (*GetPurchaseOrderResponse) ProtoMessage() {}
 
-func (x *ListSuppliersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[44]
+func (x *GetPurchaseOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3053,49 +7931,45 @@ func (x *ListSuppliersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSuppliersResponse.ProtoReflect.Descriptor instead.
-func (*ListSuppliersResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{44}
-}
-
-func (x *ListSuppliersResponse) GetSuppliers() []*Supplier {
-	if x != nil {
-		return x.Suppliers
-	}
-	return nil
+// Deprecated: Use GetPurchaseOrderResponse.ProtoReflect.Descriptor instead.
+func (*GetPurchaseOrderResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *ListSuppliersResponse) GetPagination() *PaginationResponse {
+func (x *GetPurchaseOrderResponse) GetPurchaseOrder() *PurchaseOrder {
 	if x != nil {
-		return x.Pagination
+		return x.PurchaseOrder
 	}
 	return nil
 }
 
-// Product Type Operations
-type CreateProductTypeRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ProductTypeName string                 `protobuf:"bytes,1,opt,name=product_type_name,json=productTypeName,proto3" json:"product_type_name,omitempty"`
-	Description     *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// Only OPEN and PARTIALLY_RECEIVED orders can be cancelled. Quantities
+// already received stay in stock; the outstanding rest is no longer
+// receivable.
+type CancelPurchaseOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CancelledBy   int64                  `protobuf:"varint,2,opt,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProductTypeRequest) Reset() {
-	*x = CreateProductTypeRequest{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[45]
+func (x *CancelPurchaseOrderRequest) Reset() {
+	*x = CancelPurchaseOrderRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProductTypeRequest) String() string {
+func (x *CancelPurchaseOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProductTypeRequest) ProtoMessage() {}
+func (*CancelPurchaseOrderRequest) ProtoMessage() {}
 
-func (x *CreateProductTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[45]
+func (x *CancelPurchaseOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3106,47 +7980,54 @@ func (x *CreateProductTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProductTypeRequest.ProtoReflect.Descriptor instead.
-func (*CreateProductTypeRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use CancelPurchaseOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelPurchaseOrderRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *CreateProductTypeRequest) GetProductTypeName() string {
+func (x *CancelPurchaseOrderRequest) GetId() int64 {
 	if x != nil {
-		return x.ProductTypeName
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateProductTypeRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *CancelPurchaseOrderRequest) GetCancelledBy() int64 {
+	if x != nil {
+		return x.CancelledBy
+	}
+	return 0
+}
+
+func (x *CancelPurchaseOrderRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-type CreateProductTypeResponse struct {
+type CancelPurchaseOrderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductType   *ProductType           `protobuf:"bytes,1,opt,name=product_type,json=productType,proto3" json:"product_type,omitempty"`
+	PurchaseOrder *PurchaseOrder         `protobuf:"bytes,1,opt,name=purchase_order,json=purchaseOrder,proto3" json:"purchase_order,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProductTypeResponse) Reset() {
-	*x = CreateProductTypeResponse{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[46]
+func (x *CancelPurchaseOrderResponse) Reset() {
+	*x = CancelPurchaseOrderResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProductTypeResponse) String() string {
+func (x *CancelPurchaseOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProductTypeResponse) ProtoMessage() {}
+func (*CancelPurchaseOrderResponse) ProtoMessage() {}
 
-func (x *CreateProductTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[46]
+func (x *CancelPurchaseOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3157,40 +8038,44 @@ func (x *CreateProductTypeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProductTypeResponse.ProtoReflect.Descriptor instead.
-func (*CreateProductTypeResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use CancelPurchaseOrderResponse.ProtoReflect.Descriptor instead.
+func (*CancelPurchaseOrderResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *CreateProductTypeResponse) GetProductType() *ProductType {
+func (x *CancelPurchaseOrderResponse) GetPurchaseOrder() *PurchaseOrder {
 	if x != nil {
-		return x.ProductType
+		return x.PurchaseOrder
 	}
 	return nil
 }
 
-type ListProductTypesRequest struct {
+type ListPurchaseOrdersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Pagination    *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	SupplierId    *int32                 `protobuf:"varint,2,opt,name=supplier_id,json=supplierId,proto3,oneof" json:"supplier_id,omitempty"`
+	WarehouseId   *int32                 `protobuf:"varint,3,opt,name=warehouse_id,json=warehouseId,proto3,oneof" json:"warehouse_id,omitempty"`
+	Status        *PurchaseOrderStatus   `protobuf:"varint,4,opt,name=status,proto3,enum=inventory.PurchaseOrderStatus,oneof" json:"status,omitempty"`
+	DateRange     *DateRange             `protobuf:"bytes,5,opt,name=date_range,json=dateRange,proto3,oneof" json:"date_range,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductTypesRequest) Reset() {
-	*x = ListProductTypesRequest{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[47]
+func (x *ListPurchaseOrdersRequest) Reset() {
+	*x = ListPurchaseOrdersRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductTypesRequest) String() string {
+func (x *ListPurchaseOrdersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductTypesRequest) ProtoMessage() {}
+func (*ListPurchaseOrdersRequest) ProtoMessage() {}
 
-func (x *ListProductTypesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[47]
+func (x *ListPurchaseOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3201,41 +8086,69 @@ func (x *ListProductTypesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductTypesRequest.ProtoReflect.Descriptor instead.
-func (*ListProductTypesRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use ListPurchaseOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListPurchaseOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *ListProductTypesRequest) GetPagination() *PaginationRequest {
+func (x *ListPurchaseOrdersRequest) GetPagination() *PaginationRequest {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-type ListProductTypesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductTypes  []*ProductType         `protobuf:"bytes,1,rep,name=product_types,json=productTypes,proto3" json:"product_types,omitempty"`
-	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListPurchaseOrdersRequest) GetSupplierId() int32 {
+	if x != nil && x.SupplierId != nil {
+		return *x.SupplierId
+	}
+	return 0
 }
 
-func (x *ListProductTypesResponse) Reset() {
-	*x = ListProductTypesResponse{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[48]
+func (x *ListPurchaseOrdersRequest) GetWarehouseId() int32 {
+	if x != nil && x.WarehouseId != nil {
+		return *x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ListPurchaseOrdersRequest) GetStatus() PurchaseOrderStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return PurchaseOrderStatus_PURCHASE_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *ListPurchaseOrdersRequest) GetDateRange() *DateRange {
+	if x != nil {
+		return x.DateRange
+	}
+	return nil
+}
+
+type ListPurchaseOrdersResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PurchaseOrders []*PurchaseOrder       `protobuf:"bytes,1,rep,name=purchase_orders,json=purchaseOrders,proto3" json:"purchase_orders,omitempty"`
+	Pagination     *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListPurchaseOrdersResponse) Reset() {
+	*x = ListPurchaseOrdersResponse{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductTypesResponse) String() string {
+func (x *ListPurchaseOrdersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductTypesResponse) ProtoMessage() {}
+func (*ListPurchaseOrdersResponse) ProtoMessage() {}
 
-func (x *ListProductTypesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[48]
+func (x *ListPurchaseOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3246,19 +8159,19 @@ func (x *ListProductTypesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductTypesResponse.ProtoReflect.Descriptor instead.
-func (*ListProductTypesResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use ListPurchaseOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListPurchaseOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *ListProductTypesResponse) GetProductTypes() []*ProductType {
+func (x *ListPurchaseOrdersResponse) GetPurchaseOrders() []*PurchaseOrder {
 	if x != nil {
-		return x.ProductTypes
+		return x.PurchaseOrders
 	}
 	return nil
 }
 
-func (x *ListProductTypesResponse) GetPagination() *PaginationResponse {
+func (x *ListPurchaseOrdersResponse) GetPagination() *PaginationResponse {
 	if x != nil {
 		return x.Pagination
 	}
@@ -3274,13 +8187,17 @@ type TransferStockRequest struct {
 	Quantity        int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
 	Notes           *string                `protobuf:"bytes,5,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
 	TransferredBy   int64                  `protobuf:"varint,6,opt,name=transferred_by,json=transferredBy,proto3" json:"transferred_by,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Lot to move for lot-tracked products; defaults to FEFO picking.
+	LotNumber *string `protobuf:"bytes,7,opt,name=lot_number,json=lotNumber,proto3,oneof" json:"lot_number,omitempty"`
+	// Required for serialized products, one per unit moved.
+	SerialNumbers []string `protobuf:"bytes,8,rep,name=serial_numbers,json=serialNumbers,proto3" json:"serial_numbers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TransferStockRequest) Reset() {
 	*x = TransferStockRequest{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[49]
+	mi := &file_inventory_inventory_service_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3292,7 +8209,7 @@ func (x *TransferStockRequest) String() string {
 func (*TransferStockRequest) ProtoMessage() {}
 
 func (x *TransferStockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[49]
+	mi := &file_inventory_inventory_service_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3305,7 +8222,7 @@ func (x *TransferStockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransferStockRequest.ProtoReflect.Descriptor instead.
 func (*TransferStockRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{49}
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{118}
 }
 
 func (x *TransferStockRequest) GetProductId() int32 {
@@ -3350,6 +8267,20 @@ func (x *TransferStockRequest) GetTransferredBy() int64 {
 	return 0
 }
 
+func (x *TransferStockRequest) GetLotNumber() string {
+	if x != nil && x.LotNumber != nil {
+		return *x.LotNumber
+	}
+	return ""
+}
+
+func (x *TransferStockRequest) GetSerialNumbers() []string {
+	if x != nil {
+		return x.SerialNumbers
+	}
+	return nil
+}
+
 type TransferStockResponse struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	StockMovements   []*StockMovement       `protobuf:"bytes,1,rep,name=stock_movements,json=stockMovements,proto3" json:"stock_movements,omitempty"`
@@ -3361,7 +8292,7 @@ type TransferStockResponse struct {
 
 func (x *TransferStockResponse) Reset() {
 	*x = TransferStockResponse{}
-	mi := &file_inventory_inventory_service_proto_msgTypes[50]
+	mi := &file_inventory_inventory_service_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3373,7 +8304,7 @@ func (x *TransferStockResponse) String() string {
 func (*TransferStockResponse) ProtoMessage() {}
 
 func (x *TransferStockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_inventory_service_proto_msgTypes[50]
+	mi := &file_inventory_inventory_service_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3386,7 +8317,7 @@ func (x *TransferStockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransferStockResponse.ProtoReflect.Descriptor instead.
 func (*TransferStockResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{50}
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{119}
 }
 
 func (x *TransferStockResponse) GetStockMovements() []*StockMovement {
@@ -3410,6 +8341,177 @@ func (x *TransferStockResponse) GetDestinationStock() *Stock {
 	return nil
 }
 
+// Mirrors the JSON published on the inventory:events Redis channels.
+type InventoryEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// inventory.low_stock, published when UpdateStock or TransferStock
+	// moves available_quantity from above reorder_level to at or below it
+	// (the ListLowStock condition); downward crossings only. Otherwise one of
+	// stock.in, stock.out, stock.transfer, stock.adjustment,
+	// stock.reserved and stock.released.
+	EventType string `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// Versioned like OrderEvent.schema_version.
+	SchemaVersion     int32                  `protobuf:"varint,2,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	ProductId         int32                  `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	WarehouseId       int32                  `protobuf:"varint,4,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	AvailableQuantity int32                  `protobuf:"varint,5,opt,name=available_quantity,json=availableQuantity,proto3" json:"available_quantity,omitempty"`
+	ReorderLevel      int32                  `protobuf:"varint,6,opt,name=reorder_level,json=reorderLevel,proto3" json:"reorder_level,omitempty"`
+	OccurredAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// Set on every stock.* event, including stock.reserved and
+	// stock.released (MOVEMENT_TYPE_RESERVE/RELEASE rows); unset only on
+	// inventory.low_stock.
+	Movement      *StockMovement `protobuf:"bytes,8,opt,name=movement,proto3,oneof" json:"movement,omitempty"`
+	ReferenceId   *string        `protobuf:"bytes,9,opt,name=reference_id,json=referenceId,proto3,oneof" json:"reference_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryEvent) Reset() {
+	*x = InventoryEvent{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryEvent) ProtoMessage() {}
+
+func (x *InventoryEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryEvent.ProtoReflect.Descriptor instead.
+func (*InventoryEvent) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *InventoryEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *InventoryEvent) GetSchemaVersion() int32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *InventoryEvent) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *InventoryEvent) GetWarehouseId() int32 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *InventoryEvent) GetAvailableQuantity() int32 {
+	if x != nil {
+		return x.AvailableQuantity
+	}
+	return 0
+}
+
+func (x *InventoryEvent) GetReorderLevel() int32 {
+	if x != nil {
+		return x.ReorderLevel
+	}
+	return 0
+}
+
+func (x *InventoryEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *InventoryEvent) GetMovement() *StockMovement {
+	if x != nil {
+		return x.Movement
+	}
+	return nil
+}
+
+func (x *InventoryEvent) GetReferenceId() string {
+	if x != nil && x.ReferenceId != nil {
+		return *x.ReferenceId
+	}
+	return ""
+}
+
+type StreamInventoryEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Empty subscribes to inventory:events:all.
+	EventTypes    []string `protobuf:"bytes,1,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	WarehouseId   *int32   `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3,oneof" json:"warehouse_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamInventoryEventsRequest) Reset() {
+	*x = StreamInventoryEventsRequest{}
+	mi := &file_inventory_inventory_service_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamInventoryEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamInventoryEventsRequest) ProtoMessage() {}
+
+func (x *StreamInventoryEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_service_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamInventoryEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamInventoryEventsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_service_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *StreamInventoryEventsRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *StreamInventoryEventsRequest) GetWarehouseId() int32 {
+	if x != nil && x.WarehouseId != nil {
+		return *x.WarehouseId
+	}
+	return 0
+}
+
 var File_inventory_inventory_service_proto protoreflect.FileDescriptor
 
 const file_inventory_inventory_service_proto_rawDesc = "" +
@@ -3426,7 +8528,7 @@ const file_inventory_inventory_service_proto_rawDesc = "" +
 	"\tDateRange\x12\x1d\n" +
 	"\n" +
 	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
-	"\bend_date\x18\x02 \x01(\tR\aendDate\"\xf7\x04\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\"\xc2\x05\n" +
 	"\x10InventoryProduct\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12!\n" +
 	"\fproduct_code\x18\x02 \x01(\tR\vproductCode\x12!\n" +
@@ -3445,9 +8547,15 @@ const file_inventory_inventory_service_proto_rawDesc = "" +
 	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12>\n" +
 	"\fproduct_type\x18\f \x01(\v2\x16.inventory.ProductTypeH\x00R\vproductType\x88\x01\x01\x124\n" +
 	"\bsupplier\x18\r \x01(\v2\x13.inventory.SupplierH\x01R\bsupplier\x88\x01\x01\x12(\n" +
-	"\x06stocks\x18\x0e \x03(\v2\x10.inventory.StockR\x06stocksB\x0f\n" +
+	"\x06stocks\x18\x0e \x03(\v2\x10.inventory.StockR\x06stocks\x12#\n" +
+	"\ris_serialized\x18\x0f \x01(\bR\fisSerialized\x12$\n" +
+	"\x0eis_lot_tracked\x18\x10 \x01(\bR\fisLotTrackedB\x0f\n" +
 	"\r_product_typeB\v\n" +
-	"\t_supplier\"\xdd\x02\n" +
+	"\t_supplier\"Z\n" +
+	"\rUnitOfMeasure\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1b\n" +
+	"\tunit_name\x18\x02 \x01(\tR\bunitName\x12\x18\n" +
+	"\aaliases\x18\x03 \x03(\tR\aaliases\"\xc4\x04\n" +
 	"\tWarehouse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12%\n" +
 	"\x0ewarehouse_code\x18\x02 \x01(\tR\rwarehouseCode\x12%\n" +
@@ -3459,9 +8567,20 @@ const file_inventory_inventory_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\v\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x120\n" +
+	"\x14allow_negative_stock\x18\t \x01(\bR\x12allowNegativeStock\x12>\n" +
+	"\n" +
+	"deleted_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampH\x02R\tdeletedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_by\x18\v \x01(\x03H\x03R\tcreatedBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"updated_by\x18\f \x01(\x03H\x04R\tupdatedBy\x88\x01\x01B\v\n" +
 	"\t_locationB\r\n" +
-	"\v_manager_id\"\xf6\x01\n" +
+	"\v_manager_idB\r\n" +
+	"\v_deleted_atB\r\n" +
+	"\v_created_byB\r\n" +
+	"\v_updated_by\"\x93\x02\n" +
 	"\vProductType\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12*\n" +
 	"\x11product_type_name\x18\x02 \x01(\tR\x0fproductTypeName\x12%\n" +
@@ -3469,8 +8588,9 @@ const file_inventory_inventory_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0e\n" +
-	"\f_description\"\xab\x03\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1b\n" +
+	"\tis_active\x18\x06 \x01(\bR\bisActiveB\x0e\n" +
+	"\f_description\"\xe0\x04\n" +
 	"\bSupplier\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12#\n" +
 	"\rsupplier_code\x18\x02 \x01(\tR\fsupplierCode\x12#\n" +
@@ -3484,12 +8604,21 @@ const file_inventory_inventory_service_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x11\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12>\n" +
+	"\n" +
+	"deleted_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampH\x04R\tdeletedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_by\x18\f \x01(\x03H\x05R\tcreatedBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"updated_by\x18\r \x01(\x03H\x06R\tupdatedBy\x88\x01\x01B\x11\n" +
 	"\x0f_contact_personB\b\n" +
 	"\x06_phoneB\b\n" +
 	"\x06_emailB\n" +
 	"\n" +
-	"\b_address\"\x9e\x04\n" +
+	"\b_addressB\r\n" +
+	"\v_deleted_atB\r\n" +
+	"\v_created_byB\r\n" +
+	"\v_updated_by\"\x9e\x04\n" +
 	"\x05Stock\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
 	"\n" +
@@ -3510,7 +8639,7 @@ const file_inventory_inventory_service_proto_rawDesc = "" +
 	"\n" +
 	"\b_productB\f\n" +
 	"\n" +
-	"_warehouse\"\xe4\x03\n" +
+	"_warehouse\"\xf2\x04\n" +
 	"\rStockMovement\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
 	"\n" +
@@ -3526,11 +8655,107 @@ const file_inventory_inventory_service_proto_rawDesc = "" +
 	"created_by\x18\n" +
 	" \x01(\x03R\tcreatedBy\x129\n" +
 	"\n" +
-	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\f\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12'\n" +
+	"\rcost_of_goods\x18\f \x01(\tH\x03R\vcostOfGoods\x88\x01\x01\x12A\n" +
+	"\vreason_code\x18\r \x01(\x0e2\x1b.inventory.AdjustmentReasonH\x04R\n" +
+	"reasonCode\x88\x01\x01B\f\n" +
 	"\n" +
 	"_unit_costB\x0f\n" +
 	"\r_reference_idB\b\n" +
-	"\x06_notes\"\x98\x01\n" +
+	"\x06_notesB\x10\n" +
+	"\x0e_cost_of_goodsB\x0e\n" +
+	"\f_reason_code\"\xae\x02\n" +
+	"\bStockLot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x05R\tproductId\x12!\n" +
+	"\fwarehouse_id\x18\x03 \x01(\x05R\vwarehouseId\x12\x1d\n" +
+	"\n" +
+	"lot_number\x18\x04 \x01(\tR\tlotNumber\x12\x1f\n" +
+	"\vexpiry_date\x18\x05 \x01(\tR\n" +
+	"expiryDate\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x05R\bquantity\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xf2\x02\n" +
+	"\vStockSerial\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x05R\tproductId\x12!\n" +
+	"\fwarehouse_id\x18\x03 \x01(\x05R\vwarehouseId\x12#\n" +
+	"\rserial_number\x18\x04 \x01(\tR\fserialNumber\x12/\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x17.inventory.SerialStatusR\x06status\x12/\n" +
+	"\x11sold_reference_id\x18\x06 \x01(\tH\x00R\x0fsoldReferenceId\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x14\n" +
+	"\x12_sold_reference_id\"\xa5\x04\n" +
+	"\rPurchaseOrder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tpo_number\x18\x02 \x01(\tR\bpoNumber\x12\x1f\n" +
+	"\vsupplier_id\x18\x03 \x01(\x05R\n" +
+	"supplierId\x12!\n" +
+	"\fwarehouse_id\x18\x04 \x01(\x05R\vwarehouseId\x126\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1e.inventory.PurchaseOrderStatusR\x06status\x12(\n" +
+	"\rexpected_date\x18\x06 \x01(\tH\x00R\fexpectedDate\x88\x01\x01\x12\x19\n" +
+	"\x05notes\x18\a \x01(\tH\x01R\x05notes\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\b \x01(\x03R\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x122\n" +
+	"\x05lines\x18\v \x03(\v2\x1c.inventory.PurchaseOrderLineR\x05lines\x124\n" +
+	"\bsupplier\x18\f \x01(\v2\x13.inventory.SupplierH\x02R\bsupplier\x88\x01\x01B\x10\n" +
+	"\x0e_expected_dateB\b\n" +
+	"\x06_notesB\v\n" +
+	"\t_supplier\"\xd9\x02\n" +
+	"\x11PurchaseOrderLine\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12*\n" +
+	"\x11purchase_order_id\x18\x02 \x01(\x03R\x0fpurchaseOrderId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\x05R\tproductId\x12)\n" +
+	"\x10ordered_quantity\x18\x04 \x01(\x05R\x0forderedQuantity\x12+\n" +
+	"\x11received_quantity\x18\x05 \x01(\x05R\x10receivedQuantity\x12\x1b\n" +
+	"\tunit_cost\x18\x06 \x01(\tR\bunitCost\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xed\x03\n" +
+	"\n" +
+	"StockCount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\fwarehouse_id\x18\x02 \x01(\x05R\vwarehouseId\x123\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1b.inventory.StockCountStatusR\x06status\x12\x19\n" +
+	"\x05notes\x18\x04 \x01(\tH\x00R\x05notes\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"started_by\x18\x05 \x01(\x03R\tstartedBy\x12&\n" +
+	"\ffinalized_by\x18\x06 \x01(\x03H\x01R\vfinalizedBy\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12B\n" +
+	"\ffinalized_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x02R\vfinalizedAt\x88\x01\x01\x12/\n" +
+	"\x05lines\x18\n" +
+	" \x03(\v2\x19.inventory.StockCountLineR\x05linesB\b\n" +
+	"\x06_notesB\x0f\n" +
+	"\r_finalized_byB\x0f\n" +
+	"\r_finalized_at\"\xcf\x02\n" +
+	"\x0eStockCountLine\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12$\n" +
+	"\x0estock_count_id\x18\x02 \x01(\x03R\fstockCountId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\x05R\tproductId\x12+\n" +
+	"\x11expected_quantity\x18\x04 \x01(\x05R\x10expectedQuantity\x12)\n" +
+	"\x10counted_quantity\x18\x05 \x01(\x05R\x0fcountedQuantity\x12\x1a\n" +
+	"\bvariance\x18\x06 \x01(\x05R\bvariance\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x98\x01\n" +
 	"\x11CheckStockRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x05R\tproductId\x12&\n" +
@@ -3540,7 +8765,26 @@ const file_inventory_inventory_service_proto_rawDesc = "" +
 	"\x12CheckStockResponse\x12!\n" +
 	"\fis_available\x18\x01 \x01(\bR\visAvailable\x128\n" +
 	"\x18total_available_quantity\x18\x02 \x01(\x05R\x16totalAvailableQuantity\x125\n" +
-	"\rstock_details\x18\x03 \x03(\v2\x10.inventory.StockR\fstockDetails\"\xb7\x01\n" +
+	"\rstock_details\x18\x03 \x03(\v2\x10.inventory.StockR\fstockDetails\"\x9a\x01\n" +
+	"\x13CheckStockBatchLine\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x05R\tproductId\x12+\n" +
+	"\x11required_quantity\x18\x02 \x01(\x05R\x10requiredQuantity\x12&\n" +
+	"\fwarehouse_id\x18\x03 \x01(\x05H\x00R\vwarehouseId\x88\x01\x01B\x0f\n" +
+	"\r_warehouse_id\"N\n" +
+	"\x16CheckStockBatchRequest\x124\n" +
+	"\x05lines\x18\x01 \x03(\v2\x1e.inventory.CheckStockBatchLineR\x05lines\"\xf9\x01\n" +
+	"\x15CheckStockBatchResult\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x05R\tproductId\x12&\n" +
+	"\fwarehouse_id\x18\x02 \x01(\x05H\x00R\vwarehouseId\x88\x01\x01\x12+\n" +
+	"\x11required_quantity\x18\x03 \x01(\x05R\x10requiredQuantity\x128\n" +
+	"\x18total_available_quantity\x18\x04 \x01(\x05R\x16totalAvailableQuantity\x12!\n" +
+	"\fis_available\x18\x05 \x01(\bR\visAvailableB\x0f\n" +
+	"\r_warehouse_id\"z\n" +
+	"\x17CheckStockBatchResponse\x12:\n" +
+	"\aresults\x18\x01 \x03(\v2 .inventory.CheckStockBatchResultR\aresults\x12#\n" +
+	"\rall_available\x18\x02 \x01(\bR\fallAvailable\"\x86\x02\n" +
 	"\x13ReserveStockRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x05R\tproductId\x12!\n" +
@@ -3548,7 +8792,10 @@ const file_inventory_inventory_service_proto_rawDesc = "" +
 	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12!\n" +
 	"\freference_id\x18\x04 \x01(\tR\vreferenceId\x12\x1f\n" +
 	"\vreserved_by\x18\x05 \x01(\x03R\n" +
-	"reservedBy\"\x92\x01\n" +
+	"reservedBy\x12>\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\texpiresAt\x88\x01\x01B\r\n" +
+	"\v_expires_at\"\x92\x01\n" +
 	"\x14ReserveStockResponse\x125\n" +
 	"\rupdated_stock\x18\x01 \x01(\v2\x10.inventory.StockR\fupdatedStock\x12\x18\n" +
 	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x1d\n" +
@@ -3568,7 +8815,69 @@ const file_inventory_inventory_service_proto_rawDesc = "" +
 	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x1d\n" +
 	"\amessage\x18\x03 \x01(\tH\x00R\amessage\x88\x01\x01B\n" +
 	"\n" +
-	"\b_message\"\x9e\x03\n" +
+	"\b_message\"`\n" +
+	"\x1aReleaseReservationsRequest\x12!\n" +
+	"\freference_id\x18\x01 \x01(\tR\vreferenceId\x12\x1f\n" +
+	"\vreleased_by\x18\x02 \x01(\x03R\n" +
+	"releasedBy\"\xbb\x01\n" +
+	"\x13ReleasedReservation\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x05R\tproductId\x12!\n" +
+	"\fwarehouse_id\x18\x02 \x01(\x05R\vwarehouseId\x12+\n" +
+	"\x11released_quantity\x18\x03 \x01(\x05R\x10releasedQuantity\x125\n" +
+	"\rupdated_stock\x18\x04 \x01(\v2\x10.inventory.StockR\fupdatedStock\"\xb7\x01\n" +
+	"\x1bReleaseReservationsResponse\x12S\n" +
+	"\x15released_reservations\x18\x01 \x03(\v2\x1e.inventory.ReleasedReservationR\x14releasedReservations\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x1d\n" +
+	"\amessage\x18\x03 \x01(\tH\x00R\amessage\x88\x01\x01B\n" +
+	"\n" +
+	"\b_message\"u\n" +
+	"\x15BatchReserveStockLine\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x05R\tproductId\x12!\n" +
+	"\fwarehouse_id\x18\x02 \x01(\x05R\vwarehouseId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\"\xe5\x01\n" +
+	"\x18BatchReserveStockRequest\x126\n" +
+	"\x05lines\x18\x01 \x03(\v2 .inventory.BatchReserveStockLineR\x05lines\x12!\n" +
+	"\freference_id\x18\x02 \x01(\tR\vreferenceId\x12\x1f\n" +
+	"\vreserved_by\x18\x03 \x01(\x03R\n" +
+	"reservedBy\x12>\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\texpiresAt\x88\x01\x01B\r\n" +
+	"\v_expires_at\"\xa3\x02\n" +
+	"\x19BatchReserveStockResponse\x127\n" +
+	"\x0eupdated_stocks\x18\x01 \x03(\v2\x10.inventory.StockR\rupdatedStocks\x12A\n" +
+	"\x0fstock_movements\x18\x02 \x03(\v2\x18.inventory.StockMovementR\x0estockMovements\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12\x1d\n" +
+	"\amessage\x18\x04 \x01(\tH\x00R\amessage\x88\x01\x01\x12/\n" +
+	"\x11failed_line_index\x18\x05 \x01(\x05H\x01R\x0ffailedLineIndex\x88\x01\x01B\n" +
+	"\n" +
+	"\b_messageB\x14\n" +
+	"\x12_failed_line_index\"\x9f\x01\n" +
+	"!ReleaseExpiredReservationsRequest\x12F\n" +
+	"\x0eexpired_before\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\rexpiredBefore\x88\x01\x01\x12\x1f\n" +
+	"\vreleased_by\x18\x02 \x01(\x03R\n" +
+	"releasedByB\x11\n" +
+	"\x0f_expired_before\"\xa0\x01\n" +
+	"\"ReleaseExpiredReservationsResponse\x12S\n" +
+	"\x15released_reservations\x18\x01 \x03(\v2\x1e.inventory.ReleasedReservationR\x14releasedReservations\x12%\n" +
+	"\x0ereleased_count\x18\x02 \x01(\x05R\rreleasedCount\"a\n" +
+	"\x19CommitReservationsRequest\x12!\n" +
+	"\freference_id\x18\x01 \x01(\tR\vreferenceId\x12!\n" +
+	"\fcommitted_by\x18\x02 \x01(\x03R\vcommittedBy\"\xff\x01\n" +
+	"\x14CommittedReservation\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x05R\tproductId\x12!\n" +
+	"\fwarehouse_id\x18\x02 \x01(\x05R\vwarehouseId\x12-\n" +
+	"\x12committed_quantity\x18\x03 \x01(\x05R\x11committedQuantity\x125\n" +
+	"\rupdated_stock\x18\x04 \x01(\v2\x10.inventory.StockR\fupdatedStock\x12?\n" +
+	"\x0estock_movement\x18\x05 \x01(\v2\x18.inventory.StockMovementR\rstockMovement\"\xb9\x01\n" +
+	"\x1aCommitReservationsResponse\x12V\n" +
+	"\x16committed_reservations\x18\x01 \x03(\v2\x1f.inventory.CommittedReservationR\x15committedReservations\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x1d\n" +
+	"\amessage\x18\x03 \x01(\tH\x00R\amessage\x88\x01\x01B\n" +
+	"\n" +
+	"\b_message\"\xae\x04\n" +
 	"\x12UpdateStockRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x05R\tproductId\x12!\n" +
@@ -3580,14 +8889,82 @@ const file_inventory_inventory_service_proto_rawDesc = "" +
 	"\freference_id\x18\a \x01(\tH\x01R\vreferenceId\x88\x01\x01\x12\x19\n" +
 	"\x05notes\x18\b \x01(\tH\x02R\x05notes\x88\x01\x01\x12\x1d\n" +
 	"\n" +
-	"created_by\x18\t \x01(\x03R\tcreatedByB\f\n" +
+	"created_by\x18\t \x01(\x03R\tcreatedBy\x12\"\n" +
+	"\n" +
+	"lot_number\x18\n" +
+	" \x01(\tH\x03R\tlotNumber\x88\x01\x01\x12$\n" +
+	"\vexpiry_date\x18\v \x01(\tH\x04R\n" +
+	"expiryDate\x88\x01\x01\x12%\n" +
+	"\x0eserial_numbers\x18\f \x03(\tR\rserialNumbersB\f\n" +
 	"\n" +
 	"_unit_costB\x0f\n" +
 	"\r_reference_idB\b\n" +
-	"\x06_notes\"\x8d\x01\n" +
+	"\x06_notesB\r\n" +
+	"\v_lot_numberB\x0e\n" +
+	"\f_expiry_date\"\x8d\x01\n" +
 	"\x13UpdateStockResponse\x12?\n" +
 	"\x0estock_movement\x18\x01 \x01(\v2\x18.inventory.StockMovementR\rstockMovement\x125\n" +
-	"\rupdated_stock\x18\x02 \x01(\v2\x10.inventory.StockR\fupdatedStock\"i\n" +
+	"\rupdated_stock\x18\x02 \x01(\v2\x10.inventory.StockR\fupdatedStock\"\xf4\x01\n" +
+	"\x12AdjustStockRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x05R\tproductId\x12!\n" +
+	"\fwarehouse_id\x18\x02 \x01(\x05R\vwarehouseId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12<\n" +
+	"\vreason_code\x18\x04 \x01(\x0e2\x1b.inventory.AdjustmentReasonR\n" +
+	"reasonCode\x12\x19\n" +
+	"\x05notes\x18\x05 \x01(\tH\x00R\x05notes\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\x03R\tcreatedByB\b\n" +
+	"\x06_notes\"\x8d\x01\n" +
+	"\x13AdjustStockResponse\x12?\n" +
+	"\x0estock_movement\x18\x01 \x01(\v2\x18.inventory.StockMovementR\rstockMovement\x125\n" +
+	"\rupdated_stock\x18\x02 \x01(\v2\x10.inventory.StockR\fupdatedStock\"\x8f\x01\n" +
+	"\x1fGetStockAdjustmentReportRequest\x123\n" +
+	"\n" +
+	"date_range\x18\x01 \x01(\v2\x14.inventory.DateRangeR\tdateRange\x12&\n" +
+	"\fwarehouse_id\x18\x02 \x01(\x05H\x00R\vwarehouseId\x88\x01\x01B\x0f\n" +
+	"\r_warehouse_id\"\xc4\x01\n" +
+	"\x15AdjustmentReasonTotal\x12<\n" +
+	"\vreason_code\x18\x01 \x01(\x0e2\x1b.inventory.AdjustmentReasonR\n" +
+	"reasonCode\x12%\n" +
+	"\x0emovement_count\x18\x02 \x01(\x05R\rmovementCount\x12%\n" +
+	"\x0etotal_quantity\x18\x03 \x01(\x05R\rtotalQuantity\x12\x1f\n" +
+	"\vtotal_value\x18\x04 \x01(\tR\n" +
+	"totalValue\"i\n" +
+	" GetStockAdjustmentReportResponse\x12E\n" +
+	"\rreason_totals\x18\x01 \x03(\v2 .inventory.AdjustmentReasonTotalR\freasonTotals\"\xb2\x01\n" +
+	"\x18ListExpiringStockRequest\x12\x1f\n" +
+	"\vwithin_days\x18\x01 \x01(\x05R\n" +
+	"withinDays\x12&\n" +
+	"\fwarehouse_id\x18\x02 \x01(\x05H\x00R\vwarehouseId\x88\x01\x01\x12<\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x1c.inventory.PaginationRequestR\n" +
+	"paginationB\x0f\n" +
+	"\r_warehouse_id\"\x8e\x01\n" +
+	"\x19ListExpiringStockResponse\x122\n" +
+	"\n" +
+	"stock_lots\x18\x01 \x03(\v2\x13.inventory.StockLotR\tstockLots\x12=\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1d.inventory.PaginationResponseR\n" +
+	"pagination\"\xc0\x02\n" +
+	"\x17ListStockSerialsRequest\x12<\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x1c.inventory.PaginationRequestR\n" +
+	"pagination\x12\"\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x05H\x00R\tproductId\x88\x01\x01\x12&\n" +
+	"\fwarehouse_id\x18\x03 \x01(\x05H\x01R\vwarehouseId\x88\x01\x01\x124\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x17.inventory.SerialStatusH\x02R\x06status\x88\x01\x01\x12(\n" +
+	"\rserial_number\x18\x05 \x01(\tH\x03R\fserialNumber\x88\x01\x01B\r\n" +
+	"\v_product_idB\x0f\n" +
+	"\r_warehouse_idB\t\n" +
+	"\a_statusB\x10\n" +
+	"\x0e_serial_number\"\x96\x01\n" +
+	"\x18ListStockSerialsResponse\x12;\n" +
+	"\rstock_serials\x18\x01 \x03(\v2\x16.inventory.StockSerialR\fstockSerials\x12=\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1d.inventory.PaginationResponseR\n" +
+	"pagination\"i\n" +
 	"\x0fGetStockRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x05R\tproductId\x12&\n" +
@@ -3606,7 +8983,72 @@ const file_inventory_inventory_service_proto_rawDesc = "" +
 	"low_stocks\x18\x01 \x03(\v2\x10.inventory.StockR\tlowStocks\x12=\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x1d.inventory.PaginationResponseR\n" +
-	"pagination\"\xe3\x02\n" +
+	"pagination\"\xe0\x01\n" +
+	"\x1cGetReorderSuggestionsRequest\x12#\n" +
+	"\rlookback_days\x18\x01 \x01(\x05R\flookbackDays\x12$\n" +
+	"\x0elead_time_days\x18\x02 \x01(\x05R\fleadTimeDays\x12&\n" +
+	"\fwarehouse_id\x18\x03 \x01(\x05H\x00R\vwarehouseId\x88\x01\x01\x12<\n" +
+	"\n" +
+	"pagination\x18\x04 \x01(\v2\x1c.inventory.PaginationRequestR\n" +
+	"paginationB\x0f\n" +
+	"\r_warehouse_id\"\xe6\x03\n" +
+	"\x11ReorderSuggestion\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x05R\tproductId\x12!\n" +
+	"\fproduct_code\x18\x02 \x01(\tR\vproductCode\x12!\n" +
+	"\fproduct_name\x18\x03 \x01(\tR\vproductName\x12.\n" +
+	"\x13average_daily_sales\x18\x04 \x01(\tR\x11averageDailySales\x128\n" +
+	"\x18total_available_quantity\x18\x05 \x01(\x05R\x16totalAvailableQuantity\x12#\n" +
+	"\rreorder_level\x18\x06 \x01(\x05R\freorderLevel\x12&\n" +
+	"\x0fmax_stock_level\x18\a \x01(\x05R\rmaxStockLevel\x12-\n" +
+	"\x12suggested_quantity\x18\b \x01(\x05R\x11suggestedQuantity\x123\n" +
+	"\x13days_until_stockout\x18\t \x01(\tH\x00R\x11daysUntilStockout\x88\x01\x01\x129\n" +
+	"\x19stockout_before_lead_time\x18\n" +
+	" \x01(\bR\x16stockoutBeforeLeadTimeB\x16\n" +
+	"\x14_days_until_stockout\"\x9e\x01\n" +
+	"\x1dGetReorderSuggestionsResponse\x12>\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x1c.inventory.ReorderSuggestionR\vsuggestions\x12=\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1d.inventory.PaginationResponseR\n" +
+	"pagination\"\xcd\x01\n" +
+	"\x12WarehouseValuation\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x05R\vwarehouseId\x12%\n" +
+	"\x0ewarehouse_code\x18\x02 \x01(\tR\rwarehouseCode\x12%\n" +
+	"\x0ewarehouse_name\x18\x03 \x01(\tR\rwarehouseName\x12%\n" +
+	"\x0etotal_quantity\x18\x04 \x01(\x03R\rtotalQuantity\x12\x1f\n" +
+	"\vtotal_value\x18\x05 \x01(\tR\n" +
+	"totalValue\"u\n" +
+	"\x1cGetStockValuationAsOfRequest\x12\x1c\n" +
+	"\n" +
+	"as_of_date\x18\x01 \x01(\tR\basOfDate\x12&\n" +
+	"\fwarehouse_id\x18\x02 \x01(\x05H\x00R\vwarehouseId\x88\x01\x01B\x0f\n" +
+	"\r_warehouse_id\"\xd7\x01\n" +
+	"\x1dGetStockValuationAsOfResponse\x12\x1c\n" +
+	"\n" +
+	"as_of_date\x18\x01 \x01(\tR\basOfDate\x12P\n" +
+	"\x14warehouse_valuations\x18\x02 \x03(\v2\x1d.inventory.WarehouseValuationR\x13warehouseValuations\x12%\n" +
+	"\x0etotal_quantity\x18\x03 \x01(\x03R\rtotalQuantity\x12\x1f\n" +
+	"\vtotal_value\x18\x04 \x01(\tR\n" +
+	"totalValue\"\xd5\x01\n" +
+	"\x14ProductTypeValuation\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x05R\vwarehouseId\x12&\n" +
+	"\x0fproduct_type_id\x18\x02 \x01(\x05R\rproductTypeId\x12*\n" +
+	"\x11product_type_name\x18\x03 \x01(\tR\x0fproductTypeName\x12%\n" +
+	"\x0etotal_quantity\x18\x04 \x01(\x03R\rtotalQuantity\x12\x1f\n" +
+	"\vtotal_value\x18\x05 \x01(\tR\n" +
+	"totalValue\"\xa9\x01\n" +
+	"\x1cGetInventoryValuationRequest\x12&\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x05H\x00R\vwarehouseId\x88\x01\x01\x126\n" +
+	"\x15group_by_product_type\x18\x02 \x01(\bH\x01R\x12groupByProductType\x88\x01\x01B\x0f\n" +
+	"\r_warehouse_idB\x18\n" +
+	"\x16_group_by_product_type\"\xcd\x02\n" +
+	"\x1dGetInventoryValuationResponse\x12P\n" +
+	"\x14warehouse_valuations\x18\x01 \x03(\v2\x1d.inventory.WarehouseValuationR\x13warehouseValuations\x12W\n" +
+	"\x17product_type_valuations\x18\x02 \x03(\v2\x1f.inventory.ProductTypeValuationR\x15productTypeValuations\x12%\n" +
+	"\x0etotal_quantity\x18\x03 \x01(\x03R\rtotalQuantity\x12\x1f\n" +
+	"\vtotal_value\x18\x04 \x01(\tR\n" +
+	"totalValue\x129\n" +
+	"\x0funvalued_stocks\x18\x05 \x03(\v2\x10.inventory.StockR\x0eunvaluedStocks\"\x9c\x04\n" +
 	"\x19ListStockMovementsRequest\x12<\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x1c.inventory.PaginationRequestR\n" +
@@ -3616,16 +9058,45 @@ const file_inventory_inventory_service_proto_rawDesc = "" +
 	"\fwarehouse_id\x18\x03 \x01(\x05H\x01R\vwarehouseId\x88\x01\x01\x12A\n" +
 	"\rmovement_type\x18\x04 \x01(\x0e2\x17.inventory.MovementTypeH\x02R\fmovementType\x88\x01\x01\x128\n" +
 	"\n" +
-	"date_range\x18\x05 \x01(\v2\x14.inventory.DateRangeH\x03R\tdateRange\x88\x01\x01B\r\n" +
+	"date_range\x18\x05 \x01(\v2\x14.inventory.DateRangeH\x03R\tdateRange\x88\x01\x01\x123\n" +
+	"\x13include_total_count\x18\x06 \x01(\bH\x04R\x11includeTotalCount\x88\x01\x01\x12&\n" +
+	"\freference_id\x18\a \x01(\tH\x05R\vreferenceId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_by\x18\b \x01(\x03H\x06R\tcreatedBy\x88\x01\x01B\r\n" +
 	"\v_product_idB\x0f\n" +
 	"\r_warehouse_idB\x10\n" +
 	"\x0e_movement_typeB\r\n" +
-	"\v_date_range\"\x9e\x01\n" +
+	"\v_date_rangeB\x16\n" +
+	"\x14_include_total_countB\x0f\n" +
+	"\r_reference_idB\r\n" +
+	"\v_created_by\"\x9e\x01\n" +
 	"\x1aListStockMovementsResponse\x12A\n" +
 	"\x0fstock_movements\x18\x01 \x03(\v2\x18.inventory.StockMovementR\x0estockMovements\x12=\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x1d.inventory.PaginationResponseR\n" +
-	"pagination\"\xe3\x02\n" +
+	"pagination\"\xb2\x03\n" +
+	"\x1bStreamStockMovementsRequest\x12\"\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x05H\x00R\tproductId\x88\x01\x01\x12&\n" +
+	"\fwarehouse_id\x18\x02 \x01(\x05H\x01R\vwarehouseId\x88\x01\x01\x12A\n" +
+	"\rmovement_type\x18\x03 \x01(\x0e2\x17.inventory.MovementTypeH\x02R\fmovementType\x88\x01\x01\x128\n" +
+	"\n" +
+	"date_range\x18\x04 \x01(\v2\x14.inventory.DateRangeH\x03R\tdateRange\x88\x01\x01\x12&\n" +
+	"\freference_id\x18\x05 \x01(\tH\x04R\vreferenceId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\x03H\x05R\tcreatedBy\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\a \x01(\x05R\tbatchSizeB\r\n" +
+	"\v_product_idB\x0f\n" +
+	"\r_warehouse_idB\x10\n" +
+	"\x0e_movement_typeB\r\n" +
+	"\v_date_rangeB\x0f\n" +
+	"\r_reference_idB\r\n" +
+	"\v_created_by\")\n" +
+	"\x17GetStockMovementRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"[\n" +
+	"\x18GetStockMovementResponse\x12?\n" +
+	"\x0estock_movement\x18\x01 \x01(\v2\x18.inventory.StockMovementR\rstockMovement\"\xdd\x03\n" +
 	"\x14CreateProductRequest\x12!\n" +
 	"\fproduct_code\x18\x01 \x01(\tR\vproductCode\x12!\n" +
 	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12&\n" +
@@ -3634,10 +9105,14 @@ const file_inventory_inventory_service_proto_rawDesc = "" +
 	"supplierId\x12+\n" +
 	"\x0funit_of_measure\x18\x05 \x01(\tH\x00R\runitOfMeasure\x88\x01\x01\x12(\n" +
 	"\rreorder_level\x18\x06 \x01(\x05H\x01R\freorderLevel\x88\x01\x01\x12+\n" +
-	"\x0fmax_stock_level\x18\a \x01(\x05H\x02R\rmaxStockLevel\x88\x01\x01B\x12\n" +
+	"\x0fmax_stock_level\x18\a \x01(\x05H\x02R\rmaxStockLevel\x88\x01\x01\x12(\n" +
+	"\ris_serialized\x18\b \x01(\bH\x03R\fisSerialized\x88\x01\x01\x12)\n" +
+	"\x0eis_lot_tracked\x18\t \x01(\bH\x04R\fisLotTracked\x88\x01\x01B\x12\n" +
 	"\x10_unit_of_measureB\x10\n" +
 	"\x0e_reorder_levelB\x12\n" +
-	"\x10_max_stock_level\"N\n" +
+	"\x10_max_stock_levelB\x10\n" +
+	"\x0e_is_serializedB\x11\n" +
+	"\x0f_is_lot_tracked\"N\n" +
 	"\x15CreateProductResponse\x125\n" +
 	"\aproduct\x18\x01 \x01(\v2\x1b.inventory.InventoryProductR\aproduct\"\xc4\x03\n" +
 	"\x14UpdateProductRequest\x12\x0e\n" +
@@ -3687,28 +9162,51 @@ const file_inventory_inventory_service_proto_rawDesc = "" +
 	"\bproducts\x18\x01 \x03(\v2\x1b.inventory.InventoryProductR\bproducts\x12=\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x1d.inventory.PaginationResponseR\n" +
-	"pagination\"\xc7\x01\n" +
+	"pagination\"\x1b\n" +
+	"\x19ListUnitsOfMeasureRequest\"`\n" +
+	"\x1aListUnitsOfMeasureResponse\x12B\n" +
+	"\x10units_of_measure\x18\x01 \x03(\v2\x18.inventory.UnitOfMeasureR\x0eunitsOfMeasure\"\x97\x02\n" +
 	"\x16CreateWarehouseRequest\x12%\n" +
 	"\x0ewarehouse_code\x18\x01 \x01(\tR\rwarehouseCode\x12%\n" +
 	"\x0ewarehouse_name\x18\x02 \x01(\tR\rwarehouseName\x12\x1f\n" +
 	"\blocation\x18\x03 \x01(\tH\x00R\blocation\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"manager_id\x18\x04 \x01(\x03H\x01R\tmanagerId\x88\x01\x01B\v\n" +
+	"manager_id\x18\x04 \x01(\x03H\x01R\tmanagerId\x88\x01\x01\x125\n" +
+	"\x14allow_negative_stock\x18\x05 \x01(\bH\x02R\x12allowNegativeStock\x88\x01\x01B\v\n" +
 	"\t_locationB\r\n" +
-	"\v_manager_id\"M\n" +
+	"\v_manager_idB\x17\n" +
+	"\x15_allow_negative_stock\"M\n" +
 	"\x17CreateWarehouseResponse\x122\n" +
+	"\twarehouse\x18\x01 \x01(\v2\x14.inventory.WarehouseR\twarehouse\"\xc8\x02\n" +
+	"\x16UpdateWarehouseRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12*\n" +
+	"\x0ewarehouse_name\x18\x02 \x01(\tH\x00R\rwarehouseName\x88\x01\x01\x12\x1f\n" +
+	"\blocation\x18\x03 \x01(\tH\x01R\blocation\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"manager_id\x18\x04 \x01(\x03H\x02R\tmanagerId\x88\x01\x01\x12 \n" +
+	"\tis_active\x18\x05 \x01(\bH\x03R\bisActive\x88\x01\x01\x125\n" +
+	"\x14allow_negative_stock\x18\x06 \x01(\bH\x04R\x12allowNegativeStock\x88\x01\x01B\x11\n" +
+	"\x0f_warehouse_nameB\v\n" +
+	"\t_locationB\r\n" +
+	"\v_manager_idB\f\n" +
+	"\n" +
+	"_is_activeB\x17\n" +
+	"\x15_allow_negative_stock\"M\n" +
+	"\x17UpdateWarehouseResponse\x122\n" +
 	"\twarehouse\x18\x01 \x01(\v2\x14.inventory.WarehouseR\twarehouse\"%\n" +
 	"\x13GetWarehouseRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"J\n" +
 	"\x14GetWarehouseResponse\x122\n" +
-	"\twarehouse\x18\x01 \x01(\v2\x14.inventory.WarehouseR\twarehouse\"\x85\x01\n" +
+	"\twarehouse\x18\x01 \x01(\v2\x14.inventory.WarehouseR\twarehouse\"\xc7\x01\n" +
 	"\x15ListWarehousesRequest\x12<\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x1c.inventory.PaginationRequestR\n" +
 	"pagination\x12 \n" +
-	"\tis_active\x18\x02 \x01(\bH\x00R\bisActive\x88\x01\x01B\f\n" +
+	"\tis_active\x18\x02 \x01(\bH\x00R\bisActive\x88\x01\x01\x12,\n" +
+	"\x0finclude_deleted\x18\x03 \x01(\bH\x01R\x0eincludeDeleted\x88\x01\x01B\f\n" +
 	"\n" +
-	"_is_active\"\x8d\x01\n" +
+	"_is_activeB\x12\n" +
+	"\x10_include_deleted\"\x8d\x01\n" +
 	"\x16ListWarehousesResponse\x124\n" +
 	"\n" +
 	"warehouses\x18\x01 \x03(\v2\x14.inventory.WarehouseR\n" +
@@ -3729,18 +9227,38 @@ const file_inventory_inventory_service_proto_rawDesc = "" +
 	"\n" +
 	"\b_address\"I\n" +
 	"\x16CreateSupplierResponse\x12/\n" +
+	"\bsupplier\x18\x01 \x01(\v2\x13.inventory.SupplierR\bsupplier\"\xc7\x02\n" +
+	"\x15UpdateSupplierRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12(\n" +
+	"\rsupplier_name\x18\x02 \x01(\tH\x00R\fsupplierName\x88\x01\x01\x12*\n" +
+	"\x0econtact_person\x18\x03 \x01(\tH\x01R\rcontactPerson\x88\x01\x01\x12\x19\n" +
+	"\x05phone\x18\x04 \x01(\tH\x02R\x05phone\x88\x01\x01\x12\x19\n" +
+	"\x05email\x18\x05 \x01(\tH\x03R\x05email\x88\x01\x01\x12\x1d\n" +
+	"\aaddress\x18\x06 \x01(\tH\x04R\aaddress\x88\x01\x01\x12 \n" +
+	"\tis_active\x18\a \x01(\bH\x05R\bisActive\x88\x01\x01B\x10\n" +
+	"\x0e_supplier_nameB\x11\n" +
+	"\x0f_contact_personB\b\n" +
+	"\x06_phoneB\b\n" +
+	"\x06_emailB\n" +
+	"\n" +
+	"\b_addressB\f\n" +
+	"\n" +
+	"_is_active\"I\n" +
+	"\x16UpdateSupplierResponse\x12/\n" +
 	"\bsupplier\x18\x01 \x01(\v2\x13.inventory.SupplierR\bsupplier\"$\n" +
 	"\x12GetSupplierRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"F\n" +
 	"\x13GetSupplierResponse\x12/\n" +
-	"\bsupplier\x18\x01 \x01(\v2\x13.inventory.SupplierR\bsupplier\"\x84\x01\n" +
+	"\bsupplier\x18\x01 \x01(\v2\x13.inventory.SupplierR\bsupplier\"\xc6\x01\n" +
 	"\x14ListSuppliersRequest\x12<\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x1c.inventory.PaginationRequestR\n" +
 	"pagination\x12 \n" +
-	"\tis_active\x18\x02 \x01(\bH\x00R\bisActive\x88\x01\x01B\f\n" +
+	"\tis_active\x18\x02 \x01(\bH\x00R\bisActive\x88\x01\x01\x12,\n" +
+	"\x0finclude_deleted\x18\x03 \x01(\bH\x01R\x0eincludeDeleted\x88\x01\x01B\f\n" +
 	"\n" +
-	"_is_active\"\x89\x01\n" +
+	"_is_activeB\x12\n" +
+	"\x10_include_deleted\"\x89\x01\n" +
 	"\x15ListSuppliersResponse\x121\n" +
 	"\tsuppliers\x18\x01 \x03(\v2\x13.inventory.SupplierR\tsuppliers\x12=\n" +
 	"\n" +
@@ -3751,16 +9269,121 @@ const file_inventory_inventory_service_proto_rawDesc = "" +
 	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01B\x0e\n" +
 	"\f_description\"V\n" +
 	"\x19CreateProductTypeResponse\x129\n" +
-	"\fproduct_type\x18\x01 \x01(\v2\x16.inventory.ProductTypeR\vproductType\"W\n" +
+	"\fproduct_type\x18\x01 \x01(\v2\x16.inventory.ProductTypeR\vproductType\"\xd8\x01\n" +
+	"\x18UpdateProductTypeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12/\n" +
+	"\x11product_type_name\x18\x02 \x01(\tH\x00R\x0fproductTypeName\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12 \n" +
+	"\tis_active\x18\x04 \x01(\bH\x02R\bisActive\x88\x01\x01B\x14\n" +
+	"\x12_product_type_nameB\x0e\n" +
+	"\f_descriptionB\f\n" +
+	"\n" +
+	"_is_active\"V\n" +
+	"\x19UpdateProductTypeResponse\x129\n" +
+	"\fproduct_type\x18\x01 \x01(\v2\x16.inventory.ProductTypeR\vproductType\"\x87\x01\n" +
 	"\x17ListProductTypesRequest\x12<\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x1c.inventory.PaginationRequestR\n" +
-	"pagination\"\x96\x01\n" +
+	"pagination\x12 \n" +
+	"\tis_active\x18\x02 \x01(\bH\x00R\bisActive\x88\x01\x01B\f\n" +
+	"\n" +
+	"_is_active\"\x96\x01\n" +
 	"\x18ListProductTypesResponse\x12;\n" +
 	"\rproduct_types\x18\x01 \x03(\v2\x16.inventory.ProductTypeR\fproductTypes\x12=\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x1d.inventory.PaginationResponseR\n" +
-	"pagination\"\xf1\x01\n" +
+	"pagination\"\x7f\n" +
+	"\x16StartStockCountRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x05R\vwarehouseId\x12\x19\n" +
+	"\x05notes\x18\x02 \x01(\tH\x00R\x05notes\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"started_by\x18\x03 \x01(\x03R\tstartedByB\b\n" +
+	"\x06_notes\"Q\n" +
+	"\x17StartStockCountResponse\x126\n" +
+	"\vstock_count\x18\x01 \x01(\v2\x15.inventory.StockCountR\n" +
+	"stockCount\"\x8d\x01\n" +
+	"\x1bSubmitStockCountLineRequest\x12$\n" +
+	"\x0estock_count_id\x18\x01 \x01(\x03R\fstockCountId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x05R\tproductId\x12)\n" +
+	"\x10counted_quantity\x18\x03 \x01(\x05R\x0fcountedQuantity\"M\n" +
+	"\x1cSubmitStockCountLineResponse\x12-\n" +
+	"\x04line\x18\x01 \x01(\v2\x19.inventory.StockCountLineR\x04line\"d\n" +
+	"\x19FinalizeStockCountRequest\x12$\n" +
+	"\x0estock_count_id\x18\x01 \x01(\x03R\fstockCountId\x12!\n" +
+	"\ffinalized_by\x18\x02 \x01(\x03R\vfinalizedBy\"\x97\x01\n" +
+	"\x1aFinalizeStockCountResponse\x126\n" +
+	"\vstock_count\x18\x01 \x01(\v2\x15.inventory.StockCountR\n" +
+	"stockCount\x12A\n" +
+	"\x0fstock_movements\x18\x02 \x03(\v2\x18.inventory.StockMovementR\x0estockMovements\"\x87\x01\n" +
+	"\x1eCreatePurchaseOrderLineRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x05R\tproductId\x12)\n" +
+	"\x10ordered_quantity\x18\x02 \x01(\x05R\x0forderedQuantity\x12\x1b\n" +
+	"\tunit_cost\x18\x03 \x01(\tR\bunitCost\"\xbe\x02\n" +
+	"\x1aCreatePurchaseOrderRequest\x12\x1b\n" +
+	"\tpo_number\x18\x01 \x01(\tR\bpoNumber\x12\x1f\n" +
+	"\vsupplier_id\x18\x02 \x01(\x05R\n" +
+	"supplierId\x12!\n" +
+	"\fwarehouse_id\x18\x03 \x01(\x05R\vwarehouseId\x12?\n" +
+	"\x05lines\x18\x04 \x03(\v2).inventory.CreatePurchaseOrderLineRequestR\x05lines\x12(\n" +
+	"\rexpected_date\x18\x05 \x01(\tH\x00R\fexpectedDate\x88\x01\x01\x12\x19\n" +
+	"\x05notes\x18\x06 \x01(\tH\x01R\x05notes\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\x03R\tcreatedByB\x10\n" +
+	"\x0e_expected_dateB\b\n" +
+	"\x06_notes\"^\n" +
+	"\x1bCreatePurchaseOrderResponse\x12?\n" +
+	"\x0epurchase_order\x18\x01 \x01(\v2\x18.inventory.PurchaseOrderR\rpurchaseOrder\"\x93\x02\n" +
+	"\x1fReceivePurchaseOrderLineRequest\x123\n" +
+	"\x16purchase_order_line_id\x18\x01 \x01(\x03R\x13purchaseOrderLineId\x12+\n" +
+	"\x11received_quantity\x18\x02 \x01(\x05R\x10receivedQuantity\x12\"\n" +
+	"\n" +
+	"lot_number\x18\x03 \x01(\tH\x00R\tlotNumber\x88\x01\x01\x12$\n" +
+	"\vexpiry_date\x18\x04 \x01(\tH\x01R\n" +
+	"expiryDate\x88\x01\x01\x12%\n" +
+	"\x0eserial_numbers\x18\x05 \x03(\tR\rserialNumbersB\r\n" +
+	"\v_lot_numberB\x0e\n" +
+	"\f_expiry_date\"\xd1\x01\n" +
+	"\x1bReceivePurchaseOrderRequest\x12*\n" +
+	"\x11purchase_order_id\x18\x01 \x01(\x03R\x0fpurchaseOrderId\x12@\n" +
+	"\x05lines\x18\x02 \x03(\v2*.inventory.ReceivePurchaseOrderLineRequestR\x05lines\x12\x1f\n" +
+	"\vreceived_by\x18\x03 \x01(\x03R\n" +
+	"receivedBy\x12\x19\n" +
+	"\x05notes\x18\x04 \x01(\tH\x00R\x05notes\x88\x01\x01B\b\n" +
+	"\x06_notes\"\xa2\x01\n" +
+	"\x1cReceivePurchaseOrderResponse\x12?\n" +
+	"\x0epurchase_order\x18\x01 \x01(\v2\x18.inventory.PurchaseOrderR\rpurchaseOrder\x12A\n" +
+	"\x0fstock_movements\x18\x02 \x03(\v2\x18.inventory.StockMovementR\x0estockMovements\")\n" +
+	"\x17GetPurchaseOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"[\n" +
+	"\x18GetPurchaseOrderResponse\x12?\n" +
+	"\x0epurchase_order\x18\x01 \x01(\v2\x18.inventory.PurchaseOrderR\rpurchaseOrder\"g\n" +
+	"\x1aCancelPurchaseOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\fcancelled_by\x18\x02 \x01(\x03R\vcancelledBy\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"^\n" +
+	"\x1bCancelPurchaseOrderResponse\x12?\n" +
+	"\x0epurchase_order\x18\x01 \x01(\v2\x18.inventory.PurchaseOrderR\rpurchaseOrder\"\xd9\x02\n" +
+	"\x19ListPurchaseOrdersRequest\x12<\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x1c.inventory.PaginationRequestR\n" +
+	"pagination\x12$\n" +
+	"\vsupplier_id\x18\x02 \x01(\x05H\x00R\n" +
+	"supplierId\x88\x01\x01\x12&\n" +
+	"\fwarehouse_id\x18\x03 \x01(\x05H\x01R\vwarehouseId\x88\x01\x01\x12;\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1e.inventory.PurchaseOrderStatusH\x02R\x06status\x88\x01\x01\x128\n" +
+	"\n" +
+	"date_range\x18\x05 \x01(\v2\x14.inventory.DateRangeH\x03R\tdateRange\x88\x01\x01B\x0e\n" +
+	"\f_supplier_idB\x0f\n" +
+	"\r_warehouse_idB\t\n" +
+	"\a_statusB\r\n" +
+	"\v_date_range\"\x9e\x01\n" +
+	"\x1aListPurchaseOrdersResponse\x12A\n" +
+	"\x0fpurchase_orders\x18\x01 \x03(\v2\x18.inventory.PurchaseOrderR\x0epurchaseOrders\x12=\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1d.inventory.PaginationResponseR\n" +
+	"pagination\"\xcb\x02\n" +
 	"\x14TransferStockRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x05R\tproductId\x12*\n" +
@@ -3768,48 +9391,122 @@ const file_inventory_inventory_service_proto_rawDesc = "" +
 	"\x0fto_warehouse_id\x18\x03 \x01(\x05R\rtoWarehouseId\x12\x1a\n" +
 	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x19\n" +
 	"\x05notes\x18\x05 \x01(\tH\x00R\x05notes\x88\x01\x01\x12%\n" +
-	"\x0etransferred_by\x18\x06 \x01(\x03R\rtransferredByB\b\n" +
-	"\x06_notes\"\xce\x01\n" +
+	"\x0etransferred_by\x18\x06 \x01(\x03R\rtransferredBy\x12\"\n" +
+	"\n" +
+	"lot_number\x18\a \x01(\tH\x01R\tlotNumber\x88\x01\x01\x12%\n" +
+	"\x0eserial_numbers\x18\b \x03(\tR\rserialNumbersB\b\n" +
+	"\x06_notesB\r\n" +
+	"\v_lot_number\"\xce\x01\n" +
 	"\x15TransferStockResponse\x12A\n" +
 	"\x0fstock_movements\x18\x01 \x03(\v2\x18.inventory.StockMovementR\x0estockMovements\x123\n" +
 	"\fsource_stock\x18\x02 \x01(\v2\x10.inventory.StockR\vsourceStock\x12=\n" +
-	"\x11destination_stock\x18\x03 \x01(\v2\x10.inventory.StockR\x10destinationStock*\x94\x01\n" +
+	"\x11destination_stock\x18\x03 \x01(\v2\x10.inventory.StockR\x10destinationStock\"\xaa\x03\n" +
+	"\x0eInventoryEvent\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x01 \x01(\tR\teventType\x12%\n" +
+	"\x0eschema_version\x18\x02 \x01(\x05R\rschemaVersion\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\x05R\tproductId\x12!\n" +
+	"\fwarehouse_id\x18\x04 \x01(\x05R\vwarehouseId\x12-\n" +
+	"\x12available_quantity\x18\x05 \x01(\x05R\x11availableQuantity\x12#\n" +
+	"\rreorder_level\x18\x06 \x01(\x05R\freorderLevel\x12;\n" +
+	"\voccurred_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x129\n" +
+	"\bmovement\x18\b \x01(\v2\x18.inventory.StockMovementH\x00R\bmovement\x88\x01\x01\x12&\n" +
+	"\freference_id\x18\t \x01(\tH\x01R\vreferenceId\x88\x01\x01B\v\n" +
+	"\t_movementB\x0f\n" +
+	"\r_reference_id\"x\n" +
+	"\x1cStreamInventoryEventsRequest\x12\x1f\n" +
+	"\vevent_types\x18\x01 \x03(\tR\n" +
+	"eventTypes\x12&\n" +
+	"\fwarehouse_id\x18\x02 \x01(\x05H\x00R\vwarehouseId\x88\x01\x01B\x0f\n" +
+	"\r_warehouse_id*\xca\x01\n" +
 	"\fMovementType\x12\x1d\n" +
 	"\x19MOVEMENT_TYPE_UNSPECIFIED\x10\x00\x12\x14\n" +
 	"\x10MOVEMENT_TYPE_IN\x10\x01\x12\x15\n" +
 	"\x11MOVEMENT_TYPE_OUT\x10\x02\x12\x1c\n" +
 	"\x18MOVEMENT_TYPE_ADJUSTMENT\x10\x03\x12\x1a\n" +
-	"\x16MOVEMENT_TYPE_TRANSFER\x10\x04*\xbc\x01\n" +
+	"\x16MOVEMENT_TYPE_TRANSFER\x10\x04\x12\x19\n" +
+	"\x15MOVEMENT_TYPE_RESERVE\x10\x05\x12\x19\n" +
+	"\x15MOVEMENT_TYPE_RELEASE\x10\x06*\xdc\x01\n" +
 	"\rReferenceType\x12\x1e\n" +
 	"\x1aREFERENCE_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
 	"\x17REFERENCE_TYPE_PURCHASE\x10\x01\x12\x17\n" +
 	"\x13REFERENCE_TYPE_SALE\x10\x02\x12\x1d\n" +
 	"\x19REFERENCE_TYPE_ADJUSTMENT\x10\x03\x12\x1b\n" +
 	"\x17REFERENCE_TYPE_TRANSFER\x10\x04\x12\x19\n" +
-	"\x15REFERENCE_TYPE_RETURN\x10\x052\xf3\r\n" +
+	"\x15REFERENCE_TYPE_RETURN\x10\x05\x12\x1e\n" +
+	"\x1aREFERENCE_TYPE_RESERVATION\x10\x06*\xb6\x01\n" +
+	"\x10AdjustmentReason\x12!\n" +
+	"\x1dADJUSTMENT_REASON_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18ADJUSTMENT_REASON_DAMAGE\x10\x01\x12\x1b\n" +
+	"\x17ADJUSTMENT_REASON_THEFT\x10\x02\x12&\n" +
+	"\"ADJUSTMENT_REASON_COUNT_CORRECTION\x10\x03\x12\x1c\n" +
+	"\x18ADJUSTMENT_REASON_EXPIRY\x10\x04*a\n" +
+	"\fSerialStatus\x12\x1d\n" +
+	"\x19SERIAL_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16SERIAL_STATUS_IN_STOCK\x10\x01\x12\x16\n" +
+	"\x12SERIAL_STATUS_SOLD\x10\x02*\xd3\x01\n" +
+	"\x13PurchaseOrderStatus\x12%\n" +
+	"!PURCHASE_ORDER_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aPURCHASE_ORDER_STATUS_OPEN\x10\x01\x12,\n" +
+	"(PURCHASE_ORDER_STATUS_PARTIALLY_RECEIVED\x10\x02\x12\"\n" +
+	"\x1ePURCHASE_ORDER_STATUS_RECEIVED\x10\x03\x12#\n" +
+	"\x1fPURCHASE_ORDER_STATUS_CANCELLED\x10\x04*u\n" +
+	"\x10StockCountStatus\x12\"\n" +
+	"\x1eSTOCK_COUNT_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17STOCK_COUNT_STATUS_OPEN\x10\x01\x12 \n" +
+	"\x1cSTOCK_COUNT_STATUS_FINALIZED\x10\x022\xdc\"\n" +
 	"\x10InventoryService\x12I\n" +
 	"\n" +
-	"CheckStock\x12\x1c.inventory.CheckStockRequest\x1a\x1d.inventory.CheckStockResponse\x12O\n" +
-	"\fReserveStock\x12\x1e.inventory.ReserveStockRequest\x1a\x1f.inventory.ReserveStockResponse\x12O\n" +
-	"\fReleaseStock\x12\x1e.inventory.ReleaseStockRequest\x1a\x1f.inventory.ReleaseStockResponse\x12L\n" +
-	"\vUpdateStock\x12\x1d.inventory.UpdateStockRequest\x1a\x1e.inventory.UpdateStockResponse\x12C\n" +
+	"CheckStock\x12\x1c.inventory.CheckStockRequest\x1a\x1d.inventory.CheckStockResponse\x12X\n" +
+	"\x0fCheckStockBatch\x12!.inventory.CheckStockBatchRequest\x1a\".inventory.CheckStockBatchResponse\x12O\n" +
+	"\fReserveStock\x12\x1e.inventory.ReserveStockRequest\x1a\x1f.inventory.ReserveStockResponse\x12^\n" +
+	"\x11BatchReserveStock\x12#.inventory.BatchReserveStockRequest\x1a$.inventory.BatchReserveStockResponse\x12O\n" +
+	"\fReleaseStock\x12\x1e.inventory.ReleaseStockRequest\x1a\x1f.inventory.ReleaseStockResponse\x12d\n" +
+	"\x13ReleaseReservations\x12%.inventory.ReleaseReservationsRequest\x1a&.inventory.ReleaseReservationsResponse\x12y\n" +
+	"\x1aReleaseExpiredReservations\x12,.inventory.ReleaseExpiredReservationsRequest\x1a-.inventory.ReleaseExpiredReservationsResponse\x12a\n" +
+	"\x12CommitReservations\x12$.inventory.CommitReservationsRequest\x1a%.inventory.CommitReservationsResponse\x12L\n" +
+	"\vUpdateStock\x12\x1d.inventory.UpdateStockRequest\x1a\x1e.inventory.UpdateStockResponse\x12L\n" +
+	"\vAdjustStock\x12\x1d.inventory.AdjustStockRequest\x1a\x1e.inventory.AdjustStockResponse\x12s\n" +
+	"\x18GetStockAdjustmentReport\x12*.inventory.GetStockAdjustmentReportRequest\x1a+.inventory.GetStockAdjustmentReportResponse\x12C\n" +
 	"\bGetStock\x12\x1a.inventory.GetStockRequest\x1a\x1b.inventory.GetStockResponse\x12O\n" +
-	"\fListLowStock\x12\x1e.inventory.ListLowStockRequest\x1a\x1f.inventory.ListLowStockResponse\x12R\n" +
-	"\rTransferStock\x12\x1f.inventory.TransferStockRequest\x1a .inventory.TransferStockResponse\x12a\n" +
-	"\x12ListStockMovements\x12$.inventory.ListStockMovementsRequest\x1a%.inventory.ListStockMovementsResponse\x12R\n" +
+	"\fListLowStock\x12\x1e.inventory.ListLowStockRequest\x1a\x1f.inventory.ListLowStockResponse\x12^\n" +
+	"\x11ListExpiringStock\x12#.inventory.ListExpiringStockRequest\x1a$.inventory.ListExpiringStockResponse\x12[\n" +
+	"\x10ListStockSerials\x12\".inventory.ListStockSerialsRequest\x1a#.inventory.ListStockSerialsResponse\x12j\n" +
+	"\x15GetReorderSuggestions\x12'.inventory.GetReorderSuggestionsRequest\x1a(.inventory.GetReorderSuggestionsResponse\x12R\n" +
+	"\rTransferStock\x12\x1f.inventory.TransferStockRequest\x1a .inventory.TransferStockResponse\x12d\n" +
+	"\x13CreatePurchaseOrder\x12%.inventory.CreatePurchaseOrderRequest\x1a&.inventory.CreatePurchaseOrderResponse\x12g\n" +
+	"\x14ReceivePurchaseOrder\x12&.inventory.ReceivePurchaseOrderRequest\x1a'.inventory.ReceivePurchaseOrderResponse\x12[\n" +
+	"\x10GetPurchaseOrder\x12\".inventory.GetPurchaseOrderRequest\x1a#.inventory.GetPurchaseOrderResponse\x12d\n" +
+	"\x13CancelPurchaseOrder\x12%.inventory.CancelPurchaseOrderRequest\x1a&.inventory.CancelPurchaseOrderResponse\x12a\n" +
+	"\x12ListPurchaseOrders\x12$.inventory.ListPurchaseOrdersRequest\x1a%.inventory.ListPurchaseOrdersResponse\x12X\n" +
+	"\x0fStartStockCount\x12!.inventory.StartStockCountRequest\x1a\".inventory.StartStockCountResponse\x12g\n" +
+	"\x14SubmitStockCountLine\x12&.inventory.SubmitStockCountLineRequest\x1a'.inventory.SubmitStockCountLineResponse\x12a\n" +
+	"\x12FinalizeStockCount\x12$.inventory.FinalizeStockCountRequest\x1a%.inventory.FinalizeStockCountResponse\x12j\n" +
+	"\x15GetInventoryValuation\x12'.inventory.GetInventoryValuationRequest\x1a(.inventory.GetInventoryValuationResponse\x12j\n" +
+	"\x15GetStockValuationAsOf\x12'.inventory.GetStockValuationAsOfRequest\x1a(.inventory.GetStockValuationAsOfResponse\x12a\n" +
+	"\x12ListStockMovements\x12$.inventory.ListStockMovementsRequest\x1a%.inventory.ListStockMovementsResponse\x12[\n" +
+	"\x10GetStockMovement\x12\".inventory.GetStockMovementRequest\x1a#.inventory.GetStockMovementResponse\x12Z\n" +
+	"\x14StreamStockMovements\x12&.inventory.StreamStockMovementsRequest\x1a\x18.inventory.StockMovement0\x01\x12]\n" +
+	"\x15StreamInventoryEvents\x12'.inventory.StreamInventoryEventsRequest\x1a\x19.inventory.InventoryEvent0\x01\x12R\n" +
 	"\rCreateProduct\x12\x1f.inventory.CreateProductRequest\x1a .inventory.CreateProductResponse\x12R\n" +
 	"\rUpdateProduct\x12\x1f.inventory.UpdateProductRequest\x1a .inventory.UpdateProductResponse\x12I\n" +
 	"\n" +
 	"GetProduct\x12\x1c.inventory.GetProductRequest\x1a\x1d.inventory.GetProductResponse\x12[\n" +
 	"\x10GetProductByCode\x12\".inventory.GetProductByCodeRequest\x1a#.inventory.GetProductByCodeResponse\x12O\n" +
-	"\fListProducts\x12\x1e.inventory.ListProductsRequest\x1a\x1f.inventory.ListProductsResponse\x12X\n" +
-	"\x0fCreateWarehouse\x12!.inventory.CreateWarehouseRequest\x1a\".inventory.CreateWarehouseResponse\x12O\n" +
+	"\fListProducts\x12\x1e.inventory.ListProductsRequest\x1a\x1f.inventory.ListProductsResponse\x12a\n" +
+	"\x12ListUnitsOfMeasure\x12$.inventory.ListUnitsOfMeasureRequest\x1a%.inventory.ListUnitsOfMeasureResponse\x12X\n" +
+	"\x0fCreateWarehouse\x12!.inventory.CreateWarehouseRequest\x1a\".inventory.CreateWarehouseResponse\x12X\n" +
+	"\x0fUpdateWarehouse\x12!.inventory.UpdateWarehouseRequest\x1a\".inventory.UpdateWarehouseResponse\x12O\n" +
 	"\fGetWarehouse\x12\x1e.inventory.GetWarehouseRequest\x1a\x1f.inventory.GetWarehouseResponse\x12U\n" +
 	"\x0eListWarehouses\x12 .inventory.ListWarehousesRequest\x1a!.inventory.ListWarehousesResponse\x12U\n" +
-	"\x0eCreateSupplier\x12 .inventory.CreateSupplierRequest\x1a!.inventory.CreateSupplierResponse\x12L\n" +
+	"\x0eCreateSupplier\x12 .inventory.CreateSupplierRequest\x1a!.inventory.CreateSupplierResponse\x12U\n" +
+	"\x0eUpdateSupplier\x12 .inventory.UpdateSupplierRequest\x1a!.inventory.UpdateSupplierResponse\x12L\n" +
 	"\vGetSupplier\x12\x1d.inventory.GetSupplierRequest\x1a\x1e.inventory.GetSupplierResponse\x12R\n" +
 	"\rListSuppliers\x12\x1f.inventory.ListSuppliersRequest\x1a .inventory.ListSuppliersResponse\x12^\n" +
-	"\x11CreateProductType\x12#.inventory.CreateProductTypeRequest\x1a$.inventory.CreateProductTypeResponse\x12[\n" +
+	"\x11CreateProductType\x12#.inventory.CreateProductTypeRequest\x1a$.inventory.CreateProductTypeResponse\x12^\n" +
+	"\x11UpdateProductType\x12#.inventory.UpdateProductTypeRequest\x1a$.inventory.UpdateProductTypeResponse\x12[\n" +
 	"\x10ListProductTypes\x12\".inventory.ListProductTypesRequest\x1a#.inventory.ListProductTypesResponseB'Z%syntra-system/proto/protogen;protogenb\x06proto3"
 
 var (
@@ -3824,170 +9521,380 @@ func file_inventory_inventory_service_proto_rawDescGZIP() []byte {
 	return file_inventory_inventory_service_proto_rawDescData
 }
 
-var file_inventory_inventory_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_inventory_inventory_service_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
+var file_inventory_inventory_service_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_inventory_inventory_service_proto_msgTypes = make([]protoimpl.MessageInfo, 122)
 var file_inventory_inventory_service_proto_goTypes = []any{
-	(MovementType)(0),                  // 0: inventory.MovementType
-	(ReferenceType)(0),                 // 1: inventory.ReferenceType
-	(*PaginationRequest)(nil),          // 2: inventory.PaginationRequest
-	(*PaginationResponse)(nil),         // 3: inventory.PaginationResponse
-	(*DateRange)(nil),                  // 4: inventory.DateRange
-	(*InventoryProduct)(nil),           // 5: inventory.InventoryProduct
-	(*Warehouse)(nil),                  // 6: inventory.Warehouse
-	(*ProductType)(nil),                // 7: inventory.ProductType
-	(*Supplier)(nil),                   // 8: inventory.Supplier
-	(*Stock)(nil),                      // 9: inventory.Stock
-	(*StockMovement)(nil),              // 10: inventory.StockMovement
-	(*CheckStockRequest)(nil),          // 11: inventory.CheckStockRequest
-	(*CheckStockResponse)(nil),         // 12: inventory.CheckStockResponse
-	(*ReserveStockRequest)(nil),        // 13: inventory.ReserveStockRequest
-	(*ReserveStockResponse)(nil),       // 14: inventory.ReserveStockResponse
-	(*ReleaseStockRequest)(nil),        // 15: inventory.ReleaseStockRequest
-	(*ReleaseStockResponse)(nil),       // 16: inventory.ReleaseStockResponse
-	(*UpdateStockRequest)(nil),         // 17: inventory.UpdateStockRequest
-	(*UpdateStockResponse)(nil),        // 18: inventory.UpdateStockResponse
-	(*GetStockRequest)(nil),            // 19: inventory.GetStockRequest
-	(*GetStockResponse)(nil),           // 20: inventory.GetStockResponse
-	(*ListLowStockRequest)(nil),        // 21: inventory.ListLowStockRequest
-	(*ListLowStockResponse)(nil),       // 22: inventory.ListLowStockResponse
-	(*ListStockMovementsRequest)(nil),  // 23: inventory.ListStockMovementsRequest
-	(*ListStockMovementsResponse)(nil), // 24: inventory.ListStockMovementsResponse
-	(*CreateProductRequest)(nil),       // 25: inventory.CreateProductRequest
-	(*CreateProductResponse)(nil),      // 26: inventory.CreateProductResponse
-	(*UpdateProductRequest)(nil),       // 27: inventory.UpdateProductRequest
-	(*UpdateProductResponse)(nil),      // 28: inventory.UpdateProductResponse
-	(*GetProductRequest)(nil),          // 29: inventory.GetProductRequest
-	(*GetProductResponse)(nil),         // 30: inventory.GetProductResponse
-	(*GetProductByCodeRequest)(nil),    // 31: inventory.GetProductByCodeRequest
-	(*GetProductByCodeResponse)(nil),   // 32: inventory.GetProductByCodeResponse
-	(*ListProductsRequest)(nil),        // 33: inventory.ListProductsRequest
-	(*ListProductsResponse)(nil),       // 34: inventory.ListProductsResponse
-	(*CreateWarehouseRequest)(nil),     // 35: inventory.CreateWarehouseRequest
-	(*CreateWarehouseResponse)(nil),    // 36: inventory.CreateWarehouseResponse
-	(*GetWarehouseRequest)(nil),        // 37: inventory.GetWarehouseRequest
-	(*GetWarehouseResponse)(nil),       // 38: inventory.GetWarehouseResponse
-	(*ListWarehousesRequest)(nil),      // 39: inventory.ListWarehousesRequest
-	(*ListWarehousesResponse)(nil),     // 40: inventory.ListWarehousesResponse
-	(*CreateSupplierRequest)(nil),      // 41: inventory.CreateSupplierRequest
-	(*CreateSupplierResponse)(nil),     // 42: inventory.CreateSupplierResponse
-	(*GetSupplierRequest)(nil),         // 43: inventory.GetSupplierRequest
-	(*GetSupplierResponse)(nil),        // 44: inventory.GetSupplierResponse
-	(*ListSuppliersRequest)(nil),       // 45: inventory.ListSuppliersRequest
-	(*ListSuppliersResponse)(nil),      // 46: inventory.ListSuppliersResponse
-	(*CreateProductTypeRequest)(nil),   // 47: inventory.CreateProductTypeRequest
-	(*CreateProductTypeResponse)(nil),  // 48: inventory.CreateProductTypeResponse
-	(*ListProductTypesRequest)(nil),    // 49: inventory.ListProductTypesRequest
-	(*ListProductTypesResponse)(nil),   // 50: inventory.ListProductTypesResponse
-	(*TransferStockRequest)(nil),       // 51: inventory.TransferStockRequest
-	(*TransferStockResponse)(nil),      // 52: inventory.TransferStockResponse
-	(*timestamppb.Timestamp)(nil),      // 53: google.protobuf.Timestamp
+	(MovementType)(0),                          // 0: inventory.MovementType
+	(ReferenceType)(0),                         // 1: inventory.ReferenceType
+	(AdjustmentReason)(0),                      // 2: inventory.AdjustmentReason
+	(SerialStatus)(0),                          // 3: inventory.SerialStatus
+	(PurchaseOrderStatus)(0),                   // 4: inventory.PurchaseOrderStatus
+	(StockCountStatus)(0),                      // 5: inventory.StockCountStatus
+	(*PaginationRequest)(nil),                  // 6: inventory.PaginationRequest
+	(*PaginationResponse)(nil),                 // 7: inventory.PaginationResponse
+	(*DateRange)(nil),                          // 8: inventory.DateRange
+	(*InventoryProduct)(nil),                   // 9: inventory.InventoryProduct
+	(*UnitOfMeasure)(nil),                      // 10: inventory.UnitOfMeasure
+	(*Warehouse)(nil),                          // 11: inventory.Warehouse
+	(*ProductType)(nil),                        // 12: inventory.ProductType
+	(*Supplier)(nil),                           // 13: inventory.Supplier
+	(*Stock)(nil),                              // 14: inventory.Stock
+	(*StockMovement)(nil),                      // 15: inventory.StockMovement
+	(*StockLot)(nil),                           // 16: inventory.StockLot
+	(*StockSerial)(nil),                        // 17: inventory.StockSerial
+	(*PurchaseOrder)(nil),                      // 18: inventory.PurchaseOrder
+	(*PurchaseOrderLine)(nil),                  // 19: inventory.PurchaseOrderLine
+	(*StockCount)(nil),                         // 20: inventory.StockCount
+	(*StockCountLine)(nil),                     // 21: inventory.StockCountLine
+	(*CheckStockRequest)(nil),                  // 22: inventory.CheckStockRequest
+	(*CheckStockResponse)(nil),                 // 23: inventory.CheckStockResponse
+	(*CheckStockBatchLine)(nil),                // 24: inventory.CheckStockBatchLine
+	(*CheckStockBatchRequest)(nil),             // 25: inventory.CheckStockBatchRequest
+	(*CheckStockBatchResult)(nil),              // 26: inventory.CheckStockBatchResult
+	(*CheckStockBatchResponse)(nil),            // 27: inventory.CheckStockBatchResponse
+	(*ReserveStockRequest)(nil),                // 28: inventory.ReserveStockRequest
+	(*ReserveStockResponse)(nil),               // 29: inventory.ReserveStockResponse
+	(*ReleaseStockRequest)(nil),                // 30: inventory.ReleaseStockRequest
+	(*ReleaseStockResponse)(nil),               // 31: inventory.ReleaseStockResponse
+	(*ReleaseReservationsRequest)(nil),         // 32: inventory.ReleaseReservationsRequest
+	(*ReleasedReservation)(nil),                // 33: inventory.ReleasedReservation
+	(*ReleaseReservationsResponse)(nil),        // 34: inventory.ReleaseReservationsResponse
+	(*BatchReserveStockLine)(nil),              // 35: inventory.BatchReserveStockLine
+	(*BatchReserveStockRequest)(nil),           // 36: inventory.BatchReserveStockRequest
+	(*BatchReserveStockResponse)(nil),          // 37: inventory.BatchReserveStockResponse
+	(*ReleaseExpiredReservationsRequest)(nil),  // 38: inventory.ReleaseExpiredReservationsRequest
+	(*ReleaseExpiredReservationsResponse)(nil), // 39: inventory.ReleaseExpiredReservationsResponse
+	(*CommitReservationsRequest)(nil),          // 40: inventory.CommitReservationsRequest
+	(*CommittedReservation)(nil),               // 41: inventory.CommittedReservation
+	(*CommitReservationsResponse)(nil),         // 42: inventory.CommitReservationsResponse
+	(*UpdateStockRequest)(nil),                 // 43: inventory.UpdateStockRequest
+	(*UpdateStockResponse)(nil),                // 44: inventory.UpdateStockResponse
+	(*AdjustStockRequest)(nil),                 // 45: inventory.AdjustStockRequest
+	(*AdjustStockResponse)(nil),                // 46: inventory.AdjustStockResponse
+	(*GetStockAdjustmentReportRequest)(nil),    // 47: inventory.GetStockAdjustmentReportRequest
+	(*AdjustmentReasonTotal)(nil),              // 48: inventory.AdjustmentReasonTotal
+	(*GetStockAdjustmentReportResponse)(nil),   // 49: inventory.GetStockAdjustmentReportResponse
+	(*ListExpiringStockRequest)(nil),           // 50: inventory.ListExpiringStockRequest
+	(*ListExpiringStockResponse)(nil),          // 51: inventory.ListExpiringStockResponse
+	(*ListStockSerialsRequest)(nil),            // 52: inventory.ListStockSerialsRequest
+	(*ListStockSerialsResponse)(nil),           // 53: inventory.ListStockSerialsResponse
+	(*GetStockRequest)(nil),                    // 54: inventory.GetStockRequest
+	(*GetStockResponse)(nil),                   // 55: inventory.GetStockResponse
+	(*ListLowStockRequest)(nil),                // 56: inventory.ListLowStockRequest
+	(*ListLowStockResponse)(nil),               // 57: inventory.ListLowStockResponse
+	(*GetReorderSuggestionsRequest)(nil),       // 58: inventory.GetReorderSuggestionsRequest
+	(*ReorderSuggestion)(nil),                  // 59: inventory.ReorderSuggestion
+	(*GetReorderSuggestionsResponse)(nil),      // 60: inventory.GetReorderSuggestionsResponse
+	(*WarehouseValuation)(nil),                 // 61: inventory.WarehouseValuation
+	(*GetStockValuationAsOfRequest)(nil),       // 62: inventory.GetStockValuationAsOfRequest
+	(*GetStockValuationAsOfResponse)(nil),      // 63: inventory.GetStockValuationAsOfResponse
+	(*ProductTypeValuation)(nil),               // 64: inventory.ProductTypeValuation
+	(*GetInventoryValuationRequest)(nil),       // 65: inventory.GetInventoryValuationRequest
+	(*GetInventoryValuationResponse)(nil),      // 66: inventory.GetInventoryValuationResponse
+	(*ListStockMovementsRequest)(nil),          // 67: inventory.ListStockMovementsRequest
+	(*ListStockMovementsResponse)(nil),         // 68: inventory.ListStockMovementsResponse
+	(*StreamStockMovementsRequest)(nil),        // 69: inventory.StreamStockMovementsRequest
+	(*GetStockMovementRequest)(nil),            // 70: inventory.GetStockMovementRequest
+	(*GetStockMovementResponse)(nil),           // 71: inventory.GetStockMovementResponse
+	(*CreateProductRequest)(nil),               // 72: inventory.CreateProductRequest
+	(*CreateProductResponse)(nil),              // 73: inventory.CreateProductResponse
+	(*UpdateProductRequest)(nil),               // 74: inventory.UpdateProductRequest
+	(*UpdateProductResponse)(nil),              // 75: inventory.UpdateProductResponse
+	(*GetProductRequest)(nil),                  // 76: inventory.GetProductRequest
+	(*GetProductResponse)(nil),                 // 77: inventory.GetProductResponse
+	(*GetProductByCodeRequest)(nil),            // 78: inventory.GetProductByCodeRequest
+	(*GetProductByCodeResponse)(nil),           // 79: inventory.GetProductByCodeResponse
+	(*ListProductsRequest)(nil),                // 80: inventory.ListProductsRequest
+	(*ListProductsResponse)(nil),               // 81: inventory.ListProductsResponse
+	(*ListUnitsOfMeasureRequest)(nil),          // 82: inventory.ListUnitsOfMeasureRequest
+	(*ListUnitsOfMeasureResponse)(nil),         // 83: inventory.ListUnitsOfMeasureResponse
+	(*CreateWarehouseRequest)(nil),             // 84: inventory.CreateWarehouseRequest
+	(*CreateWarehouseResponse)(nil),            // 85: inventory.CreateWarehouseResponse
+	(*UpdateWarehouseRequest)(nil),             // 86: inventory.UpdateWarehouseRequest
+	(*UpdateWarehouseResponse)(nil),            // 87: inventory.UpdateWarehouseResponse
+	(*GetWarehouseRequest)(nil),                // 88: inventory.GetWarehouseRequest
+	(*GetWarehouseResponse)(nil),               // 89: inventory.GetWarehouseResponse
+	(*ListWarehousesRequest)(nil),              // 90: inventory.ListWarehousesRequest
+	(*ListWarehousesResponse)(nil),             // 91: inventory.ListWarehousesResponse
+	(*CreateSupplierRequest)(nil),              // 92: inventory.CreateSupplierRequest
+	(*CreateSupplierResponse)(nil),             // 93: inventory.CreateSupplierResponse
+	(*UpdateSupplierRequest)(nil),              // 94: inventory.UpdateSupplierRequest
+	(*UpdateSupplierResponse)(nil),             // 95: inventory.UpdateSupplierResponse
+	(*GetSupplierRequest)(nil),                 // 96: inventory.GetSupplierRequest
+	(*GetSupplierResponse)(nil),                // 97: inventory.GetSupplierResponse
+	(*ListSuppliersRequest)(nil),               // 98: inventory.ListSuppliersRequest
+	(*ListSuppliersResponse)(nil),              // 99: inventory.ListSuppliersResponse
+	(*CreateProductTypeRequest)(nil),           // 100: inventory.CreateProductTypeRequest
+	(*CreateProductTypeResponse)(nil),          // 101: inventory.CreateProductTypeResponse
+	(*UpdateProductTypeRequest)(nil),           // 102: inventory.UpdateProductTypeRequest
+	(*UpdateProductTypeResponse)(nil),          // 103: inventory.UpdateProductTypeResponse
+	(*ListProductTypesRequest)(nil),            // 104: inventory.ListProductTypesRequest
+	(*ListProductTypesResponse)(nil),           // 105: inventory.ListProductTypesResponse
+	(*StartStockCountRequest)(nil),             // 106: inventory.StartStockCountRequest
+	(*StartStockCountResponse)(nil),            // 107: inventory.StartStockCountResponse
+	(*SubmitStockCountLineRequest)(nil),        // 108: inventory.SubmitStockCountLineRequest
+	(*SubmitStockCountLineResponse)(nil),       // 109: inventory.SubmitStockCountLineResponse
+	(*FinalizeStockCountRequest)(nil),          // 110: inventory.FinalizeStockCountRequest
+	(*FinalizeStockCountResponse)(nil),         // 111: inventory.FinalizeStockCountResponse
+	(*CreatePurchaseOrderLineRequest)(nil),     // 112: inventory.CreatePurchaseOrderLineRequest
+	(*CreatePurchaseOrderRequest)(nil),         // 113: inventory.CreatePurchaseOrderRequest
+	(*CreatePurchaseOrderResponse)(nil),        // 114: inventory.CreatePurchaseOrderResponse
+	(*ReceivePurchaseOrderLineRequest)(nil),    // 115: inventory.ReceivePurchaseOrderLineRequest
+	(*ReceivePurchaseOrderRequest)(nil),        // 116: inventory.ReceivePurchaseOrderRequest
+	(*ReceivePurchaseOrderResponse)(nil),       // 117: inventory.ReceivePurchaseOrderResponse
+	(*GetPurchaseOrderRequest)(nil),            // 118: inventory.GetPurchaseOrderRequest
+	(*GetPurchaseOrderResponse)(nil),           // 119: inventory.GetPurchaseOrderResponse
+	(*CancelPurchaseOrderRequest)(nil),         // 120: inventory.CancelPurchaseOrderRequest
+	(*CancelPurchaseOrderResponse)(nil),        // 121: inventory.CancelPurchaseOrderResponse
+	(*ListPurchaseOrdersRequest)(nil),          // 122: inventory.ListPurchaseOrdersRequest
+	(*ListPurchaseOrdersResponse)(nil),         // 123: inventory.ListPurchaseOrdersResponse
+	(*TransferStockRequest)(nil),               // 124: inventory.TransferStockRequest
+	(*TransferStockResponse)(nil),              // 125: inventory.TransferStockResponse
+	(*InventoryEvent)(nil),                     // 126: inventory.InventoryEvent
+	(*StreamInventoryEventsRequest)(nil),       // 127: inventory.StreamInventoryEventsRequest
+	(*timestamppb.Timestamp)(nil),              // 128: google.protobuf.Timestamp
 }
 var file_inventory_inventory_service_proto_depIdxs = []int32{
-	53, // 0: inventory.InventoryProduct.created_at:type_name -> google.protobuf.Timestamp
-	53, // 1: inventory.InventoryProduct.updated_at:type_name -> google.protobuf.Timestamp
-	7,  // 2: inventory.InventoryProduct.product_type:type_name -> inventory.ProductType
-	8,  // 3: inventory.InventoryProduct.supplier:type_name -> inventory.Supplier
-	9,  // 4: inventory.InventoryProduct.stocks:type_name -> inventory.Stock
-	53, // 5: inventory.Warehouse.created_at:type_name -> google.protobuf.Timestamp
-	53, // 6: inventory.Warehouse.updated_at:type_name -> google.protobuf.Timestamp
-	53, // 7: inventory.ProductType.created_at:type_name -> google.protobuf.Timestamp
-	53, // 8: inventory.ProductType.updated_at:type_name -> google.protobuf.Timestamp
-	53, // 9: inventory.Supplier.created_at:type_name -> google.protobuf.Timestamp
-	53, // 10: inventory.Supplier.updated_at:type_name -> google.protobuf.Timestamp
-	53, // 11: inventory.Stock.created_at:type_name -> google.protobuf.Timestamp
-	53, // 12: inventory.Stock.updated_at:type_name -> google.protobuf.Timestamp
-	5,  // 13: inventory.Stock.product:type_name -> inventory.InventoryProduct
-	6,  // 14: inventory.Stock.warehouse:type_name -> inventory.Warehouse
-	0,  // 15: inventory.StockMovement.movement_type:type_name -> inventory.MovementType
-	1,  // 16: inventory.StockMovement.reference_type:type_name -> inventory.ReferenceType
-	53, // 17: inventory.StockMovement.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 18: inventory.CheckStockResponse.stock_details:type_name -> inventory.Stock
-	9,  // 19: inventory.ReserveStockResponse.updated_stock:type_name -> inventory.Stock
-	9,  // 20: inventory.ReleaseStockResponse.updated_stock:type_name -> inventory.Stock
-	0,  // 21: inventory.UpdateStockRequest.movement_type:type_name -> inventory.MovementType
-	1,  // 22: inventory.UpdateStockRequest.reference_type:type_name -> inventory.ReferenceType
-	10, // 23: inventory.UpdateStockResponse.stock_movement:type_name -> inventory.StockMovement
-	9,  // 24: inventory.UpdateStockResponse.updated_stock:type_name -> inventory.Stock
-	9,  // 25: inventory.GetStockResponse.stocks:type_name -> inventory.Stock
-	2,  // 26: inventory.ListLowStockRequest.pagination:type_name -> inventory.PaginationRequest
-	9,  // 27: inventory.ListLowStockResponse.low_stocks:type_name -> inventory.Stock
-	3,  // 28: inventory.ListLowStockResponse.pagination:type_name -> inventory.PaginationResponse
-	2,  // 29: inventory.ListStockMovementsRequest.pagination:type_name -> inventory.PaginationRequest
-	0,  // 30: inventory.ListStockMovementsRequest.movement_type:type_name -> inventory.MovementType
-	4,  // 31: inventory.ListStockMovementsRequest.date_range:type_name -> inventory.DateRange
-	10, // 32: inventory.ListStockMovementsResponse.stock_movements:type_name -> inventory.StockMovement
-	3,  // 33: inventory.ListStockMovementsResponse.pagination:type_name -> inventory.PaginationResponse
-	5,  // 34: inventory.CreateProductResponse.product:type_name -> inventory.InventoryProduct
-	5,  // 35: inventory.UpdateProductResponse.product:type_name -> inventory.InventoryProduct
-	5,  // 36: inventory.GetProductResponse.product:type_name -> inventory.InventoryProduct
-	5,  // 37: inventory.GetProductByCodeResponse.product:type_name -> inventory.InventoryProduct
-	2,  // 38: inventory.ListProductsRequest.pagination:type_name -> inventory.PaginationRequest
-	5,  // 39: inventory.ListProductsResponse.products:type_name -> inventory.InventoryProduct
-	3,  // 40: inventory.ListProductsResponse.pagination:type_name -> inventory.PaginationResponse
-	6,  // 41: inventory.CreateWarehouseResponse.warehouse:type_name -> inventory.Warehouse
-	6,  // 42: inventory.GetWarehouseResponse.warehouse:type_name -> inventory.Warehouse
-	2,  // 43: inventory.ListWarehousesRequest.pagination:type_name -> inventory.PaginationRequest
-	6,  // 44: inventory.ListWarehousesResponse.warehouses:type_name -> inventory.Warehouse
-	3,  // 45: inventory.ListWarehousesResponse.pagination:type_name -> inventory.PaginationResponse
-	8,  // 46: inventory.CreateSupplierResponse.supplier:type_name -> inventory.Supplier
-	8,  // 47: inventory.GetSupplierResponse.supplier:type_name -> inventory.Supplier
-	2,  // 48: inventory.ListSuppliersRequest.pagination:type_name -> inventory.PaginationRequest
-	8,  // 49: inventory.ListSuppliersResponse.suppliers:type_name -> inventory.Supplier
-	3,  // 50: inventory.ListSuppliersResponse.pagination:type_name -> inventory.PaginationResponse
-	7,  // 51: inventory.CreateProductTypeResponse.product_type:type_name -> inventory.ProductType
-	2,  // 52: inventory.ListProductTypesRequest.pagination:type_name -> inventory.PaginationRequest
-	7,  // 53: inventory.ListProductTypesResponse.product_types:type_name -> inventory.ProductType
-	3,  // 54: inventory.ListProductTypesResponse.pagination:type_name -> inventory.PaginationResponse
-	10, // 55: inventory.TransferStockResponse.stock_movements:type_name -> inventory.StockMovement
-	9,  // 56: inventory.TransferStockResponse.source_stock:type_name -> inventory.Stock
-	9,  // 57: inventory.TransferStockResponse.destination_stock:type_name -> inventory.Stock
-	11, // 58: inventory.InventoryService.CheckStock:input_type -> inventory.CheckStockRequest
-	13, // 59: inventory.InventoryService.ReserveStock:input_type -> inventory.ReserveStockRequest
-	15, // 60: inventory.InventoryService.ReleaseStock:input_type -> inventory.ReleaseStockRequest
-	17, // 61: inventory.InventoryService.UpdateStock:input_type -> inventory.UpdateStockRequest
-	19, // 62: inventory.InventoryService.GetStock:input_type -> inventory.GetStockRequest
-	21, // 63: inventory.InventoryService.ListLowStock:input_type -> inventory.ListLowStockRequest
-	51, // 64: inventory.InventoryService.TransferStock:input_type -> inventory.TransferStockRequest
-	23, // 65: inventory.InventoryService.ListStockMovements:input_type -> inventory.ListStockMovementsRequest
-	25, // 66: inventory.InventoryService.CreateProduct:input_type -> inventory.CreateProductRequest
-	27, // 67: inventory.InventoryService.UpdateProduct:input_type -> inventory.UpdateProductRequest
-	29, // 68: inventory.InventoryService.GetProduct:input_type -> inventory.GetProductRequest
-	31, // 69: inventory.InventoryService.GetProductByCode:input_type -> inventory.GetProductByCodeRequest
-	33, // 70: inventory.InventoryService.ListProducts:input_type -> inventory.ListProductsRequest
-	35, // 71: inventory.InventoryService.CreateWarehouse:input_type -> inventory.CreateWarehouseRequest
-	37, // 72: inventory.InventoryService.GetWarehouse:input_type -> inventory.GetWarehouseRequest
-	39, // 73: inventory.InventoryService.ListWarehouses:input_type -> inventory.ListWarehousesRequest
-	41, // 74: inventory.InventoryService.CreateSupplier:input_type -> inventory.CreateSupplierRequest
-	43, // 75: inventory.InventoryService.GetSupplier:input_type -> inventory.GetSupplierRequest
-	45, // 76: inventory.InventoryService.ListSuppliers:input_type -> inventory.ListSuppliersRequest
-	47, // 77: inventory.InventoryService.CreateProductType:input_type -> inventory.CreateProductTypeRequest
-	49, // 78: inventory.InventoryService.ListProductTypes:input_type -> inventory.ListProductTypesRequest
-	12, // 79: inventory.InventoryService.CheckStock:output_type -> inventory.CheckStockResponse
-	14, // 80: inventory.InventoryService.ReserveStock:output_type -> inventory.ReserveStockResponse
-	16, // 81: inventory.InventoryService.ReleaseStock:output_type -> inventory.ReleaseStockResponse
-	18, // 82: inventory.InventoryService.UpdateStock:output_type -> inventory.UpdateStockResponse
-	20, // 83: inventory.InventoryService.GetStock:output_type -> inventory.GetStockResponse
-	22, // 84: inventory.InventoryService.ListLowStock:output_type -> inventory.ListLowStockResponse
-	52, // 85: inventory.InventoryService.TransferStock:output_type -> inventory.TransferStockResponse
-	24, // 86: inventory.InventoryService.ListStockMovements:output_type -> inventory.ListStockMovementsResponse
-	26, // 87: inventory.InventoryService.CreateProduct:output_type -> inventory.CreateProductResponse
-	28, // 88: inventory.InventoryService.UpdateProduct:output_type -> inventory.UpdateProductResponse
-	30, // 89: inventory.InventoryService.GetProduct:output_type -> inventory.GetProductResponse
-	32, // 90: inventory.InventoryService.GetProductByCode:output_type -> inventory.GetProductByCodeResponse
-	34, // 91: inventory.InventoryService.ListProducts:output_type -> inventory.ListProductsResponse
-	36, // 92: inventory.InventoryService.CreateWarehouse:output_type -> inventory.CreateWarehouseResponse
-	38, // 93: inventory.InventoryService.GetWarehouse:output_type -> inventory.GetWarehouseResponse
-	40, // 94: inventory.InventoryService.ListWarehouses:output_type -> inventory.ListWarehousesResponse
-	42, // 95: inventory.InventoryService.CreateSupplier:output_type -> inventory.CreateSupplierResponse
-	44, // 96: inventory.InventoryService.GetSupplier:output_type -> inventory.GetSupplierResponse
-	46, // 97: inventory.InventoryService.ListSuppliers:output_type -> inventory.ListSuppliersResponse
-	48, // 98: inventory.InventoryService.CreateProductType:output_type -> inventory.CreateProductTypeResponse
-	50, // 99: inventory.InventoryService.ListProductTypes:output_type -> inventory.ListProductTypesResponse
-	79, // [79:100] is the sub-list for method output_type
-	58, // [58:79] is the sub-list for method input_type
-	58, // [58:58] is the sub-list for extension type_name
-	58, // [58:58] is the sub-list for extension extendee
-	0,  // [0:58] is the sub-list for field type_name
+	128, // 0: inventory.InventoryProduct.created_at:type_name -> google.protobuf.Timestamp
+	128, // 1: inventory.InventoryProduct.updated_at:type_name -> google.protobuf.Timestamp
+	12,  // 2: inventory.InventoryProduct.product_type:type_name -> inventory.ProductType
+	13,  // 3: inventory.InventoryProduct.supplier:type_name -> inventory.Supplier
+	14,  // 4: inventory.InventoryProduct.stocks:type_name -> inventory.Stock
+	128, // 5: inventory.Warehouse.created_at:type_name -> google.protobuf.Timestamp
+	128, // 6: inventory.Warehouse.updated_at:type_name -> google.protobuf.Timestamp
+	128, // 7: inventory.Warehouse.deleted_at:type_name -> google.protobuf.Timestamp
+	128, // 8: inventory.ProductType.created_at:type_name -> google.protobuf.Timestamp
+	128, // 9: inventory.ProductType.updated_at:type_name -> google.protobuf.Timestamp
+	128, // 10: inventory.Supplier.created_at:type_name -> google.protobuf.Timestamp
+	128, // 11: inventory.Supplier.updated_at:type_name -> google.protobuf.Timestamp
+	128, // 12: inventory.Supplier.deleted_at:type_name -> google.protobuf.Timestamp
+	128, // 13: inventory.Stock.created_at:type_name -> google.protobuf.Timestamp
+	128, // 14: inventory.Stock.updated_at:type_name -> google.protobuf.Timestamp
+	9,   // 15: inventory.Stock.product:type_name -> inventory.InventoryProduct
+	11,  // 16: inventory.Stock.warehouse:type_name -> inventory.Warehouse
+	0,   // 17: inventory.StockMovement.movement_type:type_name -> inventory.MovementType
+	1,   // 18: inventory.StockMovement.reference_type:type_name -> inventory.ReferenceType
+	128, // 19: inventory.StockMovement.created_at:type_name -> google.protobuf.Timestamp
+	2,   // 20: inventory.StockMovement.reason_code:type_name -> inventory.AdjustmentReason
+	128, // 21: inventory.StockLot.created_at:type_name -> google.protobuf.Timestamp
+	128, // 22: inventory.StockLot.updated_at:type_name -> google.protobuf.Timestamp
+	3,   // 23: inventory.StockSerial.status:type_name -> inventory.SerialStatus
+	128, // 24: inventory.StockSerial.created_at:type_name -> google.protobuf.Timestamp
+	128, // 25: inventory.StockSerial.updated_at:type_name -> google.protobuf.Timestamp
+	4,   // 26: inventory.PurchaseOrder.status:type_name -> inventory.PurchaseOrderStatus
+	128, // 27: inventory.PurchaseOrder.created_at:type_name -> google.protobuf.Timestamp
+	128, // 28: inventory.PurchaseOrder.updated_at:type_name -> google.protobuf.Timestamp
+	19,  // 29: inventory.PurchaseOrder.lines:type_name -> inventory.PurchaseOrderLine
+	13,  // 30: inventory.PurchaseOrder.supplier:type_name -> inventory.Supplier
+	128, // 31: inventory.PurchaseOrderLine.created_at:type_name -> google.protobuf.Timestamp
+	128, // 32: inventory.PurchaseOrderLine.updated_at:type_name -> google.protobuf.Timestamp
+	5,   // 33: inventory.StockCount.status:type_name -> inventory.StockCountStatus
+	128, // 34: inventory.StockCount.created_at:type_name -> google.protobuf.Timestamp
+	128, // 35: inventory.StockCount.updated_at:type_name -> google.protobuf.Timestamp
+	128, // 36: inventory.StockCount.finalized_at:type_name -> google.protobuf.Timestamp
+	21,  // 37: inventory.StockCount.lines:type_name -> inventory.StockCountLine
+	128, // 38: inventory.StockCountLine.created_at:type_name -> google.protobuf.Timestamp
+	128, // 39: inventory.StockCountLine.updated_at:type_name -> google.protobuf.Timestamp
+	14,  // 40: inventory.CheckStockResponse.stock_details:type_name -> inventory.Stock
+	24,  // 41: inventory.CheckStockBatchRequest.lines:type_name -> inventory.CheckStockBatchLine
+	26,  // 42: inventory.CheckStockBatchResponse.results:type_name -> inventory.CheckStockBatchResult
+	128, // 43: inventory.ReserveStockRequest.expires_at:type_name -> google.protobuf.Timestamp
+	14,  // 44: inventory.ReserveStockResponse.updated_stock:type_name -> inventory.Stock
+	14,  // 45: inventory.ReleaseStockResponse.updated_stock:type_name -> inventory.Stock
+	14,  // 46: inventory.ReleasedReservation.updated_stock:type_name -> inventory.Stock
+	33,  // 47: inventory.ReleaseReservationsResponse.released_reservations:type_name -> inventory.ReleasedReservation
+	35,  // 48: inventory.BatchReserveStockRequest.lines:type_name -> inventory.BatchReserveStockLine
+	128, // 49: inventory.BatchReserveStockRequest.expires_at:type_name -> google.protobuf.Timestamp
+	14,  // 50: inventory.BatchReserveStockResponse.updated_stocks:type_name -> inventory.Stock
+	15,  // 51: inventory.BatchReserveStockResponse.stock_movements:type_name -> inventory.StockMovement
+	128, // 52: inventory.ReleaseExpiredReservationsRequest.expired_before:type_name -> google.protobuf.Timestamp
+	33,  // 53: inventory.ReleaseExpiredReservationsResponse.released_reservations:type_name -> inventory.ReleasedReservation
+	14,  // 54: inventory.CommittedReservation.updated_stock:type_name -> inventory.Stock
+	15,  // 55: inventory.CommittedReservation.stock_movement:type_name -> inventory.StockMovement
+	41,  // 56: inventory.CommitReservationsResponse.committed_reservations:type_name -> inventory.CommittedReservation
+	0,   // 57: inventory.UpdateStockRequest.movement_type:type_name -> inventory.MovementType
+	1,   // 58: inventory.UpdateStockRequest.reference_type:type_name -> inventory.ReferenceType
+	15,  // 59: inventory.UpdateStockResponse.stock_movement:type_name -> inventory.StockMovement
+	14,  // 60: inventory.UpdateStockResponse.updated_stock:type_name -> inventory.Stock
+	2,   // 61: inventory.AdjustStockRequest.reason_code:type_name -> inventory.AdjustmentReason
+	15,  // 62: inventory.AdjustStockResponse.stock_movement:type_name -> inventory.StockMovement
+	14,  // 63: inventory.AdjustStockResponse.updated_stock:type_name -> inventory.Stock
+	8,   // 64: inventory.GetStockAdjustmentReportRequest.date_range:type_name -> inventory.DateRange
+	2,   // 65: inventory.AdjustmentReasonTotal.reason_code:type_name -> inventory.AdjustmentReason
+	48,  // 66: inventory.GetStockAdjustmentReportResponse.reason_totals:type_name -> inventory.AdjustmentReasonTotal
+	6,   // 67: inventory.ListExpiringStockRequest.pagination:type_name -> inventory.PaginationRequest
+	16,  // 68: inventory.ListExpiringStockResponse.stock_lots:type_name -> inventory.StockLot
+	7,   // 69: inventory.ListExpiringStockResponse.pagination:type_name -> inventory.PaginationResponse
+	6,   // 70: inventory.ListStockSerialsRequest.pagination:type_name -> inventory.PaginationRequest
+	3,   // 71: inventory.ListStockSerialsRequest.status:type_name -> inventory.SerialStatus
+	17,  // 72: inventory.ListStockSerialsResponse.stock_serials:type_name -> inventory.StockSerial
+	7,   // 73: inventory.ListStockSerialsResponse.pagination:type_name -> inventory.PaginationResponse
+	14,  // 74: inventory.GetStockResponse.stocks:type_name -> inventory.Stock
+	6,   // 75: inventory.ListLowStockRequest.pagination:type_name -> inventory.PaginationRequest
+	14,  // 76: inventory.ListLowStockResponse.low_stocks:type_name -> inventory.Stock
+	7,   // 77: inventory.ListLowStockResponse.pagination:type_name -> inventory.PaginationResponse
+	6,   // 78: inventory.GetReorderSuggestionsRequest.pagination:type_name -> inventory.PaginationRequest
+	59,  // 79: inventory.GetReorderSuggestionsResponse.suggestions:type_name -> inventory.ReorderSuggestion
+	7,   // 80: inventory.GetReorderSuggestionsResponse.pagination:type_name -> inventory.PaginationResponse
+	61,  // 81: inventory.GetStockValuationAsOfResponse.warehouse_valuations:type_name -> inventory.WarehouseValuation
+	61,  // 82: inventory.GetInventoryValuationResponse.warehouse_valuations:type_name -> inventory.WarehouseValuation
+	64,  // 83: inventory.GetInventoryValuationResponse.product_type_valuations:type_name -> inventory.ProductTypeValuation
+	14,  // 84: inventory.GetInventoryValuationResponse.unvalued_stocks:type_name -> inventory.Stock
+	6,   // 85: inventory.ListStockMovementsRequest.pagination:type_name -> inventory.PaginationRequest
+	0,   // 86: inventory.ListStockMovementsRequest.movement_type:type_name -> inventory.MovementType
+	8,   // 87: inventory.ListStockMovementsRequest.date_range:type_name -> inventory.DateRange
+	15,  // 88: inventory.ListStockMovementsResponse.stock_movements:type_name -> inventory.StockMovement
+	7,   // 89: inventory.ListStockMovementsResponse.pagination:type_name -> inventory.PaginationResponse
+	0,   // 90: inventory.StreamStockMovementsRequest.movement_type:type_name -> inventory.MovementType
+	8,   // 91: inventory.StreamStockMovementsRequest.date_range:type_name -> inventory.DateRange
+	15,  // 92: inventory.GetStockMovementResponse.stock_movement:type_name -> inventory.StockMovement
+	9,   // 93: inventory.CreateProductResponse.product:type_name -> inventory.InventoryProduct
+	9,   // 94: inventory.UpdateProductResponse.product:type_name -> inventory.InventoryProduct
+	9,   // 95: inventory.GetProductResponse.product:type_name -> inventory.InventoryProduct
+	9,   // 96: inventory.GetProductByCodeResponse.product:type_name -> inventory.InventoryProduct
+	6,   // 97: inventory.ListProductsRequest.pagination:type_name -> inventory.PaginationRequest
+	9,   // 98: inventory.ListProductsResponse.products:type_name -> inventory.InventoryProduct
+	7,   // 99: inventory.ListProductsResponse.pagination:type_name -> inventory.PaginationResponse
+	10,  // 100: inventory.ListUnitsOfMeasureResponse.units_of_measure:type_name -> inventory.UnitOfMeasure
+	11,  // 101: inventory.CreateWarehouseResponse.warehouse:type_name -> inventory.Warehouse
+	11,  // 102: inventory.UpdateWarehouseResponse.warehouse:type_name -> inventory.Warehouse
+	11,  // 103: inventory.GetWarehouseResponse.warehouse:type_name -> inventory.Warehouse
+	6,   // 104: inventory.ListWarehousesRequest.pagination:type_name -> inventory.PaginationRequest
+	11,  // 105: inventory.ListWarehousesResponse.warehouses:type_name -> inventory.Warehouse
+	7,   // 106: inventory.ListWarehousesResponse.pagination:type_name -> inventory.PaginationResponse
+	13,  // 107: inventory.CreateSupplierResponse.supplier:type_name -> inventory.Supplier
+	13,  // 108: inventory.UpdateSupplierResponse.supplier:type_name -> inventory.Supplier
+	13,  // 109: inventory.GetSupplierResponse.supplier:type_name -> inventory.Supplier
+	6,   // 110: inventory.ListSuppliersRequest.pagination:type_name -> inventory.PaginationRequest
+	13,  // 111: inventory.ListSuppliersResponse.suppliers:type_name -> inventory.Supplier
+	7,   // 112: inventory.ListSuppliersResponse.pagination:type_name -> inventory.PaginationResponse
+	12,  // 113: inventory.CreateProductTypeResponse.product_type:type_name -> inventory.ProductType
+	12,  // 114: inventory.UpdateProductTypeResponse.product_type:type_name -> inventory.ProductType
+	6,   // 115: inventory.ListProductTypesRequest.pagination:type_name -> inventory.PaginationRequest
+	12,  // 116: inventory.ListProductTypesResponse.product_types:type_name -> inventory.ProductType
+	7,   // 117: inventory.ListProductTypesResponse.pagination:type_name -> inventory.PaginationResponse
+	20,  // 118: inventory.StartStockCountResponse.stock_count:type_name -> inventory.StockCount
+	21,  // 119: inventory.SubmitStockCountLineResponse.line:type_name -> inventory.StockCountLine
+	20,  // 120: inventory.FinalizeStockCountResponse.stock_count:type_name -> inventory.StockCount
+	15,  // 121: inventory.FinalizeStockCountResponse.stock_movements:type_name -> inventory.StockMovement
+	112, // 122: inventory.CreatePurchaseOrderRequest.lines:type_name -> inventory.CreatePurchaseOrderLineRequest
+	18,  // 123: inventory.CreatePurchaseOrderResponse.purchase_order:type_name -> inventory.PurchaseOrder
+	115, // 124: inventory.ReceivePurchaseOrderRequest.lines:type_name -> inventory.ReceivePurchaseOrderLineRequest
+	18,  // 125: inventory.ReceivePurchaseOrderResponse.purchase_order:type_name -> inventory.PurchaseOrder
+	15,  // 126: inventory.ReceivePurchaseOrderResponse.stock_movements:type_name -> inventory.StockMovement
+	18,  // 127: inventory.GetPurchaseOrderResponse.purchase_order:type_name -> inventory.PurchaseOrder
+	18,  // 128: inventory.CancelPurchaseOrderResponse.purchase_order:type_name -> inventory.PurchaseOrder
+	6,   // 129: inventory.ListPurchaseOrdersRequest.pagination:type_name -> inventory.PaginationRequest
+	4,   // 130: inventory.ListPurchaseOrdersRequest.status:type_name -> inventory.PurchaseOrderStatus
+	8,   // 131: inventory.ListPurchaseOrdersRequest.date_range:type_name -> inventory.DateRange
+	18,  // 132: inventory.ListPurchaseOrdersResponse.purchase_orders:type_name -> inventory.PurchaseOrder
+	7,   // 133: inventory.ListPurchaseOrdersResponse.pagination:type_name -> inventory.PaginationResponse
+	15,  // 134: inventory.TransferStockResponse.stock_movements:type_name -> inventory.StockMovement
+	14,  // 135: inventory.TransferStockResponse.source_stock:type_name -> inventory.Stock
+	14,  // 136: inventory.TransferStockResponse.destination_stock:type_name -> inventory.Stock
+	128, // 137: inventory.InventoryEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	15,  // 138: inventory.InventoryEvent.movement:type_name -> inventory.StockMovement
+	22,  // 139: inventory.InventoryService.CheckStock:input_type -> inventory.CheckStockRequest
+	25,  // 140: inventory.InventoryService.CheckStockBatch:input_type -> inventory.CheckStockBatchRequest
+	28,  // 141: inventory.InventoryService.ReserveStock:input_type -> inventory.ReserveStockRequest
+	36,  // 142: inventory.InventoryService.BatchReserveStock:input_type -> inventory.BatchReserveStockRequest
+	30,  // 143: inventory.InventoryService.ReleaseStock:input_type -> inventory.ReleaseStockRequest
+	32,  // 144: inventory.InventoryService.ReleaseReservations:input_type -> inventory.ReleaseReservationsRequest
+	38,  // 145: inventory.InventoryService.ReleaseExpiredReservations:input_type -> inventory.ReleaseExpiredReservationsRequest
+	40,  // 146: inventory.InventoryService.CommitReservations:input_type -> inventory.CommitReservationsRequest
+	43,  // 147: inventory.InventoryService.UpdateStock:input_type -> inventory.UpdateStockRequest
+	45,  // 148: inventory.InventoryService.AdjustStock:input_type -> inventory.AdjustStockRequest
+	47,  // 149: inventory.InventoryService.GetStockAdjustmentReport:input_type -> inventory.GetStockAdjustmentReportRequest
+	54,  // 150: inventory.InventoryService.GetStock:input_type -> inventory.GetStockRequest
+	56,  // 151: inventory.InventoryService.ListLowStock:input_type -> inventory.ListLowStockRequest
+	50,  // 152: inventory.InventoryService.ListExpiringStock:input_type -> inventory.ListExpiringStockRequest
+	52,  // 153: inventory.InventoryService.ListStockSerials:input_type -> inventory.ListStockSerialsRequest
+	58,  // 154: inventory.InventoryService.GetReorderSuggestions:input_type -> inventory.GetReorderSuggestionsRequest
+	124, // 155: inventory.InventoryService.TransferStock:input_type -> inventory.TransferStockRequest
+	113, // 156: inventory.InventoryService.CreatePurchaseOrder:input_type -> inventory.CreatePurchaseOrderRequest
+	116, // 157: inventory.InventoryService.ReceivePurchaseOrder:input_type -> inventory.ReceivePurchaseOrderRequest
+	118, // 158: inventory.InventoryService.GetPurchaseOrder:input_type -> inventory.GetPurchaseOrderRequest
+	120, // 159: inventory.InventoryService.CancelPurchaseOrder:input_type -> inventory.CancelPurchaseOrderRequest
+	122, // 160: inventory.InventoryService.ListPurchaseOrders:input_type -> inventory.ListPurchaseOrdersRequest
+	106, // 161: inventory.InventoryService.StartStockCount:input_type -> inventory.StartStockCountRequest
+	108, // 162: inventory.InventoryService.SubmitStockCountLine:input_type -> inventory.SubmitStockCountLineRequest
+	110, // 163: inventory.InventoryService.FinalizeStockCount:input_type -> inventory.FinalizeStockCountRequest
+	65,  // 164: inventory.InventoryService.GetInventoryValuation:input_type -> inventory.GetInventoryValuationRequest
+	62,  // 165: inventory.InventoryService.GetStockValuationAsOf:input_type -> inventory.GetStockValuationAsOfRequest
+	67,  // 166: inventory.InventoryService.ListStockMovements:input_type -> inventory.ListStockMovementsRequest
+	70,  // 167: inventory.InventoryService.GetStockMovement:input_type -> inventory.GetStockMovementRequest
+	69,  // 168: inventory.InventoryService.StreamStockMovements:input_type -> inventory.StreamStockMovementsRequest
+	127, // 169: inventory.InventoryService.StreamInventoryEvents:input_type -> inventory.StreamInventoryEventsRequest
+	72,  // 170: inventory.InventoryService.CreateProduct:input_type -> inventory.CreateProductRequest
+	74,  // 171: inventory.InventoryService.UpdateProduct:input_type -> inventory.UpdateProductRequest
+	76,  // 172: inventory.InventoryService.GetProduct:input_type -> inventory.GetProductRequest
+	78,  // 173: inventory.InventoryService.GetProductByCode:input_type -> inventory.GetProductByCodeRequest
+	80,  // 174: inventory.InventoryService.ListProducts:input_type -> inventory.ListProductsRequest
+	82,  // 175: inventory.InventoryService.ListUnitsOfMeasure:input_type -> inventory.ListUnitsOfMeasureRequest
+	84,  // 176: inventory.InventoryService.CreateWarehouse:input_type -> inventory.CreateWarehouseRequest
+	86,  // 177: inventory.InventoryService.UpdateWarehouse:input_type -> inventory.UpdateWarehouseRequest
+	88,  // 178: inventory.InventoryService.GetWarehouse:input_type -> inventory.GetWarehouseRequest
+	90,  // 179: inventory.InventoryService.ListWarehouses:input_type -> inventory.ListWarehousesRequest
+	92,  // 180: inventory.InventoryService.CreateSupplier:input_type -> inventory.CreateSupplierRequest
+	94,  // 181: inventory.InventoryService.UpdateSupplier:input_type -> inventory.UpdateSupplierRequest
+	96,  // 182: inventory.InventoryService.GetSupplier:input_type -> inventory.GetSupplierRequest
+	98,  // 183: inventory.InventoryService.ListSuppliers:input_type -> inventory.ListSuppliersRequest
+	100, // 184: inventory.InventoryService.CreateProductType:input_type -> inventory.CreateProductTypeRequest
+	102, // 185: inventory.InventoryService.UpdateProductType:input_type -> inventory.UpdateProductTypeRequest
+	104, // 186: inventory.InventoryService.ListProductTypes:input_type -> inventory.ListProductTypesRequest
+	23,  // 187: inventory.InventoryService.CheckStock:output_type -> inventory.CheckStockResponse
+	27,  // 188: inventory.InventoryService.CheckStockBatch:output_type -> inventory.CheckStockBatchResponse
+	29,  // 189: inventory.InventoryService.ReserveStock:output_type -> inventory.ReserveStockResponse
+	37,  // 190: inventory.InventoryService.BatchReserveStock:output_type -> inventory.BatchReserveStockResponse
+	31,  // 191: inventory.InventoryService.ReleaseStock:output_type -> inventory.ReleaseStockResponse
+	34,  // 192: inventory.InventoryService.ReleaseReservations:output_type -> inventory.ReleaseReservationsResponse
+	39,  // 193: inventory.InventoryService.ReleaseExpiredReservations:output_type -> inventory.ReleaseExpiredReservationsResponse
+	42,  // 194: inventory.InventoryService.CommitReservations:output_type -> inventory.CommitReservationsResponse
+	44,  // 195: inventory.InventoryService.UpdateStock:output_type -> inventory.UpdateStockResponse
+	46,  // 196: inventory.InventoryService.AdjustStock:output_type -> inventory.AdjustStockResponse
+	49,  // 197: inventory.InventoryService.GetStockAdjustmentReport:output_type -> inventory.GetStockAdjustmentReportResponse
+	55,  // 198: inventory.InventoryService.GetStock:output_type -> inventory.GetStockResponse
+	57,  // 199: inventory.InventoryService.ListLowStock:output_type -> inventory.ListLowStockResponse
+	51,  // 200: inventory.InventoryService.ListExpiringStock:output_type -> inventory.ListExpiringStockResponse
+	53,  // 201: inventory.InventoryService.ListStockSerials:output_type -> inventory.ListStockSerialsResponse
+	60,  // 202: inventory.InventoryService.GetReorderSuggestions:output_type -> inventory.GetReorderSuggestionsResponse
+	125, // 203: inventory.InventoryService.TransferStock:output_type -> inventory.TransferStockResponse
+	114, // 204: inventory.InventoryService.CreatePurchaseOrder:output_type -> inventory.CreatePurchaseOrderResponse
+	117, // 205: inventory.InventoryService.ReceivePurchaseOrder:output_type -> inventory.ReceivePurchaseOrderResponse
+	119, // 206: inventory.InventoryService.GetPurchaseOrder:output_type -> inventory.GetPurchaseOrderResponse
+	121, // 207: inventory.InventoryService.CancelPurchaseOrder:output_type -> inventory.CancelPurchaseOrderResponse
+	123, // 208: inventory.InventoryService.ListPurchaseOrders:output_type -> inventory.ListPurchaseOrdersResponse
+	107, // 209: inventory.InventoryService.StartStockCount:output_type -> inventory.StartStockCountResponse
+	109, // 210: inventory.InventoryService.SubmitStockCountLine:output_type -> inventory.SubmitStockCountLineResponse
+	111, // 211: inventory.InventoryService.FinalizeStockCount:output_type -> inventory.FinalizeStockCountResponse
+	66,  // 212: inventory.InventoryService.GetInventoryValuation:output_type -> inventory.GetInventoryValuationResponse
+	63,  // 213: inventory.InventoryService.GetStockValuationAsOf:output_type -> inventory.GetStockValuationAsOfResponse
+	68,  // 214: inventory.InventoryService.ListStockMovements:output_type -> inventory.ListStockMovementsResponse
+	71,  // 215: inventory.InventoryService.GetStockMovement:output_type -> inventory.GetStockMovementResponse
+	15,  // 216: inventory.InventoryService.StreamStockMovements:output_type -> inventory.StockMovement
+	126, // 217: inventory.InventoryService.StreamInventoryEvents:output_type -> inventory.InventoryEvent
+	73,  // 218: inventory.InventoryService.CreateProduct:output_type -> inventory.CreateProductResponse
+	75,  // 219: inventory.InventoryService.UpdateProduct:output_type -> inventory.UpdateProductResponse
+	77,  // 220: inventory.InventoryService.GetProduct:output_type -> inventory.GetProductResponse
+	79,  // 221: inventory.InventoryService.GetProductByCode:output_type -> inventory.GetProductByCodeResponse
+	81,  // 222: inventory.InventoryService.ListProducts:output_type -> inventory.ListProductsResponse
+	83,  // 223: inventory.InventoryService.ListUnitsOfMeasure:output_type -> inventory.ListUnitsOfMeasureResponse
+	85,  // 224: inventory.InventoryService.CreateWarehouse:output_type -> inventory.CreateWarehouseResponse
+	87,  // 225: inventory.InventoryService.UpdateWarehouse:output_type -> inventory.UpdateWarehouseResponse
+	89,  // 226: inventory.InventoryService.GetWarehouse:output_type -> inventory.GetWarehouseResponse
+	91,  // 227: inventory.InventoryService.ListWarehouses:output_type -> inventory.ListWarehousesResponse
+	93,  // 228: inventory.InventoryService.CreateSupplier:output_type -> inventory.CreateSupplierResponse
+	95,  // 229: inventory.InventoryService.UpdateSupplier:output_type -> inventory.UpdateSupplierResponse
+	97,  // 230: inventory.InventoryService.GetSupplier:output_type -> inventory.GetSupplierResponse
+	99,  // 231: inventory.InventoryService.ListSuppliers:output_type -> inventory.ListSuppliersResponse
+	101, // 232: inventory.InventoryService.CreateProductType:output_type -> inventory.CreateProductTypeResponse
+	103, // 233: inventory.InventoryService.UpdateProductType:output_type -> inventory.UpdateProductTypeResponse
+	105, // 234: inventory.InventoryService.ListProductTypes:output_type -> inventory.ListProductTypesResponse
+	187, // [187:235] is the sub-list for method output_type
+	139, // [139:187] is the sub-list for method input_type
+	139, // [139:139] is the sub-list for extension type_name
+	139, // [139:139] is the sub-list for extension extendee
+	0,   // [0:139] is the sub-list for field type_name
 }
 
 func init() { file_inventory_inventory_service_proto_init() }
@@ -3996,34 +9903,65 @@ func file_inventory_inventory_service_proto_init() {
 		return
 	}
 	file_inventory_inventory_service_proto_msgTypes[3].OneofWrappers = []any{}
-	file_inventory_inventory_service_proto_msgTypes[4].OneofWrappers = []any{}
 	file_inventory_inventory_service_proto_msgTypes[5].OneofWrappers = []any{}
 	file_inventory_inventory_service_proto_msgTypes[6].OneofWrappers = []any{}
 	file_inventory_inventory_service_proto_msgTypes[7].OneofWrappers = []any{}
 	file_inventory_inventory_service_proto_msgTypes[8].OneofWrappers = []any{}
 	file_inventory_inventory_service_proto_msgTypes[9].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[11].OneofWrappers = []any{}
 	file_inventory_inventory_service_proto_msgTypes[12].OneofWrappers = []any{}
 	file_inventory_inventory_service_proto_msgTypes[14].OneofWrappers = []any{}
-	file_inventory_inventory_service_proto_msgTypes[15].OneofWrappers = []any{}
-	file_inventory_inventory_service_proto_msgTypes[17].OneofWrappers = []any{}
-	file_inventory_inventory_service_proto_msgTypes[19].OneofWrappers = []any{}
-	file_inventory_inventory_service_proto_msgTypes[21].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[16].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[18].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[20].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[22].OneofWrappers = []any{}
 	file_inventory_inventory_service_proto_msgTypes[23].OneofWrappers = []any{}
 	file_inventory_inventory_service_proto_msgTypes[25].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[28].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[30].OneofWrappers = []any{}
 	file_inventory_inventory_service_proto_msgTypes[31].OneofWrappers = []any{}
-	file_inventory_inventory_service_proto_msgTypes[33].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[32].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[36].OneofWrappers = []any{}
 	file_inventory_inventory_service_proto_msgTypes[37].OneofWrappers = []any{}
 	file_inventory_inventory_service_proto_msgTypes[39].OneofWrappers = []any{}
-	file_inventory_inventory_service_proto_msgTypes[43].OneofWrappers = []any{}
-	file_inventory_inventory_service_proto_msgTypes[45].OneofWrappers = []any{}
-	file_inventory_inventory_service_proto_msgTypes[49].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[41].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[44].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[46].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[48].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[50].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[52].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[53].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[56].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[59].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[61].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[63].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[66].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[68].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[74].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[78].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[80].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[84].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[86].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[88].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[92].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[94].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[96].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[98].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[100].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[107].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[109].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[110].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[116].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[118].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[120].OneofWrappers = []any{}
+	file_inventory_inventory_service_proto_msgTypes[121].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_inventory_inventory_service_proto_rawDesc), len(file_inventory_inventory_service_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   51,
+			NumEnums:      6,
+			NumMessages:   122,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
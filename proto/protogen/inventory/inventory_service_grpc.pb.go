@@ -19,27 +19,54 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	InventoryService_CheckStock_FullMethodName         = "/inventory.InventoryService/CheckStock"
-	InventoryService_ReserveStock_FullMethodName       = "/inventory.InventoryService/ReserveStock"
-	InventoryService_ReleaseStock_FullMethodName       = "/inventory.InventoryService/ReleaseStock"
-	InventoryService_UpdateStock_FullMethodName        = "/inventory.InventoryService/UpdateStock"
-	InventoryService_GetStock_FullMethodName           = "/inventory.InventoryService/GetStock"
-	InventoryService_ListLowStock_FullMethodName       = "/inventory.InventoryService/ListLowStock"
-	InventoryService_TransferStock_FullMethodName      = "/inventory.InventoryService/TransferStock"
-	InventoryService_ListStockMovements_FullMethodName = "/inventory.InventoryService/ListStockMovements"
-	InventoryService_CreateProduct_FullMethodName      = "/inventory.InventoryService/CreateProduct"
-	InventoryService_UpdateProduct_FullMethodName      = "/inventory.InventoryService/UpdateProduct"
-	InventoryService_GetProduct_FullMethodName         = "/inventory.InventoryService/GetProduct"
-	InventoryService_GetProductByCode_FullMethodName   = "/inventory.InventoryService/GetProductByCode"
-	InventoryService_ListProducts_FullMethodName       = "/inventory.InventoryService/ListProducts"
-	InventoryService_CreateWarehouse_FullMethodName    = "/inventory.InventoryService/CreateWarehouse"
-	InventoryService_GetWarehouse_FullMethodName       = "/inventory.InventoryService/GetWarehouse"
-	InventoryService_ListWarehouses_FullMethodName     = "/inventory.InventoryService/ListWarehouses"
-	InventoryService_CreateSupplier_FullMethodName     = "/inventory.InventoryService/CreateSupplier"
-	InventoryService_GetSupplier_FullMethodName        = "/inventory.InventoryService/GetSupplier"
-	InventoryService_ListSuppliers_FullMethodName      = "/inventory.InventoryService/ListSuppliers"
-	InventoryService_CreateProductType_FullMethodName  = "/inventory.InventoryService/CreateProductType"
-	InventoryService_ListProductTypes_FullMethodName   = "/inventory.InventoryService/ListProductTypes"
+	InventoryService_CheckStock_FullMethodName                 = "/inventory.InventoryService/CheckStock"
+	InventoryService_CheckStockBatch_FullMethodName            = "/inventory.InventoryService/CheckStockBatch"
+	InventoryService_ReserveStock_FullMethodName               = "/inventory.InventoryService/ReserveStock"
+	InventoryService_BatchReserveStock_FullMethodName          = "/inventory.InventoryService/BatchReserveStock"
+	InventoryService_ReleaseStock_FullMethodName               = "/inventory.InventoryService/ReleaseStock"
+	InventoryService_ReleaseReservations_FullMethodName        = "/inventory.InventoryService/ReleaseReservations"
+	InventoryService_ReleaseExpiredReservations_FullMethodName = "/inventory.InventoryService/ReleaseExpiredReservations"
+	InventoryService_CommitReservations_FullMethodName         = "/inventory.InventoryService/CommitReservations"
+	InventoryService_UpdateStock_FullMethodName                = "/inventory.InventoryService/UpdateStock"
+	InventoryService_AdjustStock_FullMethodName                = "/inventory.InventoryService/AdjustStock"
+	InventoryService_GetStockAdjustmentReport_FullMethodName   = "/inventory.InventoryService/GetStockAdjustmentReport"
+	InventoryService_GetStock_FullMethodName                   = "/inventory.InventoryService/GetStock"
+	InventoryService_ListLowStock_FullMethodName               = "/inventory.InventoryService/ListLowStock"
+	InventoryService_ListExpiringStock_FullMethodName          = "/inventory.InventoryService/ListExpiringStock"
+	InventoryService_ListStockSerials_FullMethodName           = "/inventory.InventoryService/ListStockSerials"
+	InventoryService_GetReorderSuggestions_FullMethodName      = "/inventory.InventoryService/GetReorderSuggestions"
+	InventoryService_TransferStock_FullMethodName              = "/inventory.InventoryService/TransferStock"
+	InventoryService_CreatePurchaseOrder_FullMethodName        = "/inventory.InventoryService/CreatePurchaseOrder"
+	InventoryService_ReceivePurchaseOrder_FullMethodName       = "/inventory.InventoryService/ReceivePurchaseOrder"
+	InventoryService_GetPurchaseOrder_FullMethodName           = "/inventory.InventoryService/GetPurchaseOrder"
+	InventoryService_CancelPurchaseOrder_FullMethodName        = "/inventory.InventoryService/CancelPurchaseOrder"
+	InventoryService_ListPurchaseOrders_FullMethodName         = "/inventory.InventoryService/ListPurchaseOrders"
+	InventoryService_StartStockCount_FullMethodName            = "/inventory.InventoryService/StartStockCount"
+	InventoryService_SubmitStockCountLine_FullMethodName       = "/inventory.InventoryService/SubmitStockCountLine"
+	InventoryService_FinalizeStockCount_FullMethodName         = "/inventory.InventoryService/FinalizeStockCount"
+	InventoryService_GetInventoryValuation_FullMethodName      = "/inventory.InventoryService/GetInventoryValuation"
+	InventoryService_GetStockValuationAsOf_FullMethodName      = "/inventory.InventoryService/GetStockValuationAsOf"
+	InventoryService_ListStockMovements_FullMethodName         = "/inventory.InventoryService/ListStockMovements"
+	InventoryService_GetStockMovement_FullMethodName           = "/inventory.InventoryService/GetStockMovement"
+	InventoryService_StreamStockMovements_FullMethodName       = "/inventory.InventoryService/StreamStockMovements"
+	InventoryService_StreamInventoryEvents_FullMethodName      = "/inventory.InventoryService/StreamInventoryEvents"
+	InventoryService_CreateProduct_FullMethodName              = "/inventory.InventoryService/CreateProduct"
+	InventoryService_UpdateProduct_FullMethodName              = "/inventory.InventoryService/UpdateProduct"
+	InventoryService_GetProduct_FullMethodName                 = "/inventory.InventoryService/GetProduct"
+	InventoryService_GetProductByCode_FullMethodName           = "/inventory.InventoryService/GetProductByCode"
+	InventoryService_ListProducts_FullMethodName               = "/inventory.InventoryService/ListProducts"
+	InventoryService_ListUnitsOfMeasure_FullMethodName         = "/inventory.InventoryService/ListUnitsOfMeasure"
+	InventoryService_CreateWarehouse_FullMethodName            = "/inventory.InventoryService/CreateWarehouse"
+	InventoryService_UpdateWarehouse_FullMethodName            = "/inventory.InventoryService/UpdateWarehouse"
+	InventoryService_GetWarehouse_FullMethodName               = "/inventory.InventoryService/GetWarehouse"
+	InventoryService_ListWarehouses_FullMethodName             = "/inventory.InventoryService/ListWarehouses"
+	InventoryService_CreateSupplier_FullMethodName             = "/inventory.InventoryService/CreateSupplier"
+	InventoryService_UpdateSupplier_FullMethodName             = "/inventory.InventoryService/UpdateSupplier"
+	InventoryService_GetSupplier_FullMethodName                = "/inventory.InventoryService/GetSupplier"
+	InventoryService_ListSuppliers_FullMethodName              = "/inventory.InventoryService/ListSuppliers"
+	InventoryService_CreateProductType_FullMethodName          = "/inventory.InventoryService/CreateProductType"
+	InventoryService_UpdateProductType_FullMethodName          = "/inventory.InventoryService/UpdateProductType"
+	InventoryService_ListProductTypes_FullMethodName           = "/inventory.InventoryService/ListProductTypes"
 )
 
 // InventoryServiceClient is the client API for InventoryService service.
@@ -48,30 +75,60 @@ const (
 type InventoryServiceClient interface {
 	// Stock Operations
 	CheckStock(ctx context.Context, in *CheckStockRequest, opts ...grpc.CallOption) (*CheckStockResponse, error)
+	CheckStockBatch(ctx context.Context, in *CheckStockBatchRequest, opts ...grpc.CallOption) (*CheckStockBatchResponse, error)
 	ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*ReserveStockResponse, error)
+	BatchReserveStock(ctx context.Context, in *BatchReserveStockRequest, opts ...grpc.CallOption) (*BatchReserveStockResponse, error)
 	ReleaseStock(ctx context.Context, in *ReleaseStockRequest, opts ...grpc.CallOption) (*ReleaseStockResponse, error)
+	ReleaseReservations(ctx context.Context, in *ReleaseReservationsRequest, opts ...grpc.CallOption) (*ReleaseReservationsResponse, error)
+	ReleaseExpiredReservations(ctx context.Context, in *ReleaseExpiredReservationsRequest, opts ...grpc.CallOption) (*ReleaseExpiredReservationsResponse, error)
+	CommitReservations(ctx context.Context, in *CommitReservationsRequest, opts ...grpc.CallOption) (*CommitReservationsResponse, error)
 	UpdateStock(ctx context.Context, in *UpdateStockRequest, opts ...grpc.CallOption) (*UpdateStockResponse, error)
+	AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...grpc.CallOption) (*AdjustStockResponse, error)
+	GetStockAdjustmentReport(ctx context.Context, in *GetStockAdjustmentReportRequest, opts ...grpc.CallOption) (*GetStockAdjustmentReportResponse, error)
 	GetStock(ctx context.Context, in *GetStockRequest, opts ...grpc.CallOption) (*GetStockResponse, error)
 	ListLowStock(ctx context.Context, in *ListLowStockRequest, opts ...grpc.CallOption) (*ListLowStockResponse, error)
+	ListExpiringStock(ctx context.Context, in *ListExpiringStockRequest, opts ...grpc.CallOption) (*ListExpiringStockResponse, error)
+	ListStockSerials(ctx context.Context, in *ListStockSerialsRequest, opts ...grpc.CallOption) (*ListStockSerialsResponse, error)
+	GetReorderSuggestions(ctx context.Context, in *GetReorderSuggestionsRequest, opts ...grpc.CallOption) (*GetReorderSuggestionsResponse, error)
 	TransferStock(ctx context.Context, in *TransferStockRequest, opts ...grpc.CallOption) (*TransferStockResponse, error)
+	// Purchase Order Operations
+	CreatePurchaseOrder(ctx context.Context, in *CreatePurchaseOrderRequest, opts ...grpc.CallOption) (*CreatePurchaseOrderResponse, error)
+	ReceivePurchaseOrder(ctx context.Context, in *ReceivePurchaseOrderRequest, opts ...grpc.CallOption) (*ReceivePurchaseOrderResponse, error)
+	GetPurchaseOrder(ctx context.Context, in *GetPurchaseOrderRequest, opts ...grpc.CallOption) (*GetPurchaseOrderResponse, error)
+	CancelPurchaseOrder(ctx context.Context, in *CancelPurchaseOrderRequest, opts ...grpc.CallOption) (*CancelPurchaseOrderResponse, error)
+	ListPurchaseOrders(ctx context.Context, in *ListPurchaseOrdersRequest, opts ...grpc.CallOption) (*ListPurchaseOrdersResponse, error)
+	// Stock Count Operations
+	StartStockCount(ctx context.Context, in *StartStockCountRequest, opts ...grpc.CallOption) (*StartStockCountResponse, error)
+	SubmitStockCountLine(ctx context.Context, in *SubmitStockCountLineRequest, opts ...grpc.CallOption) (*SubmitStockCountLineResponse, error)
+	FinalizeStockCount(ctx context.Context, in *FinalizeStockCountRequest, opts ...grpc.CallOption) (*FinalizeStockCountResponse, error)
+	// Valuation Operations
+	GetInventoryValuation(ctx context.Context, in *GetInventoryValuationRequest, opts ...grpc.CallOption) (*GetInventoryValuationResponse, error)
+	GetStockValuationAsOf(ctx context.Context, in *GetStockValuationAsOfRequest, opts ...grpc.CallOption) (*GetStockValuationAsOfResponse, error)
 	// Stock Movement Operations
 	ListStockMovements(ctx context.Context, in *ListStockMovementsRequest, opts ...grpc.CallOption) (*ListStockMovementsResponse, error)
+	GetStockMovement(ctx context.Context, in *GetStockMovementRequest, opts ...grpc.CallOption) (*GetStockMovementResponse, error)
+	StreamStockMovements(ctx context.Context, in *StreamStockMovementsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StockMovement], error)
+	StreamInventoryEvents(ctx context.Context, in *StreamInventoryEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[InventoryEvent], error)
 	// Product Operations
 	CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*CreateProductResponse, error)
 	UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*UpdateProductResponse, error)
 	GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductResponse, error)
 	GetProductByCode(ctx context.Context, in *GetProductByCodeRequest, opts ...grpc.CallOption) (*GetProductByCodeResponse, error)
 	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error)
+	ListUnitsOfMeasure(ctx context.Context, in *ListUnitsOfMeasureRequest, opts ...grpc.CallOption) (*ListUnitsOfMeasureResponse, error)
 	// Warehouse Operations
 	CreateWarehouse(ctx context.Context, in *CreateWarehouseRequest, opts ...grpc.CallOption) (*CreateWarehouseResponse, error)
+	UpdateWarehouse(ctx context.Context, in *UpdateWarehouseRequest, opts ...grpc.CallOption) (*UpdateWarehouseResponse, error)
 	GetWarehouse(ctx context.Context, in *GetWarehouseRequest, opts ...grpc.CallOption) (*GetWarehouseResponse, error)
 	ListWarehouses(ctx context.Context, in *ListWarehousesRequest, opts ...grpc.CallOption) (*ListWarehousesResponse, error)
 	// Supplier Operations
 	CreateSupplier(ctx context.Context, in *CreateSupplierRequest, opts ...grpc.CallOption) (*CreateSupplierResponse, error)
+	UpdateSupplier(ctx context.Context, in *UpdateSupplierRequest, opts ...grpc.CallOption) (*UpdateSupplierResponse, error)
 	GetSupplier(ctx context.Context, in *GetSupplierRequest, opts ...grpc.CallOption) (*GetSupplierResponse, error)
 	ListSuppliers(ctx context.Context, in *ListSuppliersRequest, opts ...grpc.CallOption) (*ListSuppliersResponse, error)
 	// Product Type Operations
 	CreateProductType(ctx context.Context, in *CreateProductTypeRequest, opts ...grpc.CallOption) (*CreateProductTypeResponse, error)
+	UpdateProductType(ctx context.Context, in *UpdateProductTypeRequest, opts ...grpc.CallOption) (*UpdateProductTypeResponse, error)
 	ListProductTypes(ctx context.Context, in *ListProductTypesRequest, opts ...grpc.CallOption) (*ListProductTypesResponse, error)
 }
 
@@ -93,6 +150,16 @@ func (c *inventoryServiceClient) CheckStock(ctx context.Context, in *CheckStockR
 	return out, nil
 }
 
+func (c *inventoryServiceClient) CheckStockBatch(ctx context.Context, in *CheckStockBatchRequest, opts ...grpc.CallOption) (*CheckStockBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckStockBatchResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CheckStockBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *inventoryServiceClient) ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*ReserveStockResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ReserveStockResponse)
@@ -103,6 +170,16 @@ func (c *inventoryServiceClient) ReserveStock(ctx context.Context, in *ReserveSt
 	return out, nil
 }
 
+func (c *inventoryServiceClient) BatchReserveStock(ctx context.Context, in *BatchReserveStockRequest, opts ...grpc.CallOption) (*BatchReserveStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchReserveStockResponse)
+	err := c.cc.Invoke(ctx, InventoryService_BatchReserveStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *inventoryServiceClient) ReleaseStock(ctx context.Context, in *ReleaseStockRequest, opts ...grpc.CallOption) (*ReleaseStockResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ReleaseStockResponse)
@@ -113,6 +190,36 @@ func (c *inventoryServiceClient) ReleaseStock(ctx context.Context, in *ReleaseSt
 	return out, nil
 }
 
+func (c *inventoryServiceClient) ReleaseReservations(ctx context.Context, in *ReleaseReservationsRequest, opts ...grpc.CallOption) (*ReleaseReservationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseReservationsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ReleaseReservations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ReleaseExpiredReservations(ctx context.Context, in *ReleaseExpiredReservationsRequest, opts ...grpc.CallOption) (*ReleaseExpiredReservationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseExpiredReservationsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ReleaseExpiredReservations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CommitReservations(ctx context.Context, in *CommitReservationsRequest, opts ...grpc.CallOption) (*CommitReservationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommitReservationsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CommitReservations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *inventoryServiceClient) UpdateStock(ctx context.Context, in *UpdateStockRequest, opts ...grpc.CallOption) (*UpdateStockResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateStockResponse)
@@ -123,6 +230,26 @@ func (c *inventoryServiceClient) UpdateStock(ctx context.Context, in *UpdateStoc
 	return out, nil
 }
 
+func (c *inventoryServiceClient) AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...grpc.CallOption) (*AdjustStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdjustStockResponse)
+	err := c.cc.Invoke(ctx, InventoryService_AdjustStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetStockAdjustmentReport(ctx context.Context, in *GetStockAdjustmentReportRequest, opts ...grpc.CallOption) (*GetStockAdjustmentReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStockAdjustmentReportResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetStockAdjustmentReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *inventoryServiceClient) GetStock(ctx context.Context, in *GetStockRequest, opts ...grpc.CallOption) (*GetStockResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetStockResponse)
@@ -143,6 +270,36 @@ func (c *inventoryServiceClient) ListLowStock(ctx context.Context, in *ListLowSt
 	return out, nil
 }
 
+func (c *inventoryServiceClient) ListExpiringStock(ctx context.Context, in *ListExpiringStockRequest, opts ...grpc.CallOption) (*ListExpiringStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExpiringStockResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListExpiringStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListStockSerials(ctx context.Context, in *ListStockSerialsRequest, opts ...grpc.CallOption) (*ListStockSerialsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStockSerialsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListStockSerials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetReorderSuggestions(ctx context.Context, in *GetReorderSuggestionsRequest, opts ...grpc.CallOption) (*GetReorderSuggestionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReorderSuggestionsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetReorderSuggestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *inventoryServiceClient) TransferStock(ctx context.Context, in *TransferStockRequest, opts ...grpc.CallOption) (*TransferStockResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TransferStockResponse)
@@ -153,6 +310,106 @@ func (c *inventoryServiceClient) TransferStock(ctx context.Context, in *Transfer
 	return out, nil
 }
 
+func (c *inventoryServiceClient) CreatePurchaseOrder(ctx context.Context, in *CreatePurchaseOrderRequest, opts ...grpc.CallOption) (*CreatePurchaseOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CreatePurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ReceivePurchaseOrder(ctx context.Context, in *ReceivePurchaseOrderRequest, opts ...grpc.CallOption) (*ReceivePurchaseOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReceivePurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ReceivePurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetPurchaseOrder(ctx context.Context, in *GetPurchaseOrderRequest, opts ...grpc.CallOption) (*GetPurchaseOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetPurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CancelPurchaseOrder(ctx context.Context, in *CancelPurchaseOrderRequest, opts ...grpc.CallOption) (*CancelPurchaseOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelPurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CancelPurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListPurchaseOrders(ctx context.Context, in *ListPurchaseOrdersRequest, opts ...grpc.CallOption) (*ListPurchaseOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPurchaseOrdersResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListPurchaseOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) StartStockCount(ctx context.Context, in *StartStockCountRequest, opts ...grpc.CallOption) (*StartStockCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartStockCountResponse)
+	err := c.cc.Invoke(ctx, InventoryService_StartStockCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SubmitStockCountLine(ctx context.Context, in *SubmitStockCountLineRequest, opts ...grpc.CallOption) (*SubmitStockCountLineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitStockCountLineResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SubmitStockCountLine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) FinalizeStockCount(ctx context.Context, in *FinalizeStockCountRequest, opts ...grpc.CallOption) (*FinalizeStockCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinalizeStockCountResponse)
+	err := c.cc.Invoke(ctx, InventoryService_FinalizeStockCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetInventoryValuation(ctx context.Context, in *GetInventoryValuationRequest, opts ...grpc.CallOption) (*GetInventoryValuationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInventoryValuationResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetInventoryValuation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetStockValuationAsOf(ctx context.Context, in *GetStockValuationAsOfRequest, opts ...grpc.CallOption) (*GetStockValuationAsOfResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStockValuationAsOfResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetStockValuationAsOf_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *inventoryServiceClient) ListStockMovements(ctx context.Context, in *ListStockMovementsRequest, opts ...grpc.CallOption) (*ListStockMovementsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListStockMovementsResponse)
@@ -163,6 +420,54 @@ func (c *inventoryServiceClient) ListStockMovements(ctx context.Context, in *Lis
 	return out, nil
 }
 
+func (c *inventoryServiceClient) GetStockMovement(ctx context.Context, in *GetStockMovementRequest, opts ...grpc.CallOption) (*GetStockMovementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStockMovementResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetStockMovement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) StreamStockMovements(ctx context.Context, in *StreamStockMovementsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StockMovement], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InventoryService_ServiceDesc.Streams[0], InventoryService_StreamStockMovements_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamStockMovementsRequest, StockMovement]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_StreamStockMovementsClient = grpc.ServerStreamingClient[StockMovement]
+
+func (c *inventoryServiceClient) StreamInventoryEvents(ctx context.Context, in *StreamInventoryEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[InventoryEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InventoryService_ServiceDesc.Streams[1], InventoryService_StreamInventoryEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamInventoryEventsRequest, InventoryEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_StreamInventoryEventsClient = grpc.ServerStreamingClient[InventoryEvent]
+
 func (c *inventoryServiceClient) CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*CreateProductResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateProductResponse)
@@ -213,6 +518,16 @@ func (c *inventoryServiceClient) ListProducts(ctx context.Context, in *ListProdu
 	return out, nil
 }
 
+func (c *inventoryServiceClient) ListUnitsOfMeasure(ctx context.Context, in *ListUnitsOfMeasureRequest, opts ...grpc.CallOption) (*ListUnitsOfMeasureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUnitsOfMeasureResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListUnitsOfMeasure_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *inventoryServiceClient) CreateWarehouse(ctx context.Context, in *CreateWarehouseRequest, opts ...grpc.CallOption) (*CreateWarehouseResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateWarehouseResponse)
@@ -223,6 +538,16 @@ func (c *inventoryServiceClient) CreateWarehouse(ctx context.Context, in *Create
 	return out, nil
 }
 
+func (c *inventoryServiceClient) UpdateWarehouse(ctx context.Context, in *UpdateWarehouseRequest, opts ...grpc.CallOption) (*UpdateWarehouseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateWarehouseResponse)
+	err := c.cc.Invoke(ctx, InventoryService_UpdateWarehouse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *inventoryServiceClient) GetWarehouse(ctx context.Context, in *GetWarehouseRequest, opts ...grpc.CallOption) (*GetWarehouseResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetWarehouseResponse)
@@ -253,6 +578,16 @@ func (c *inventoryServiceClient) CreateSupplier(ctx context.Context, in *CreateS
 	return out, nil
 }
 
+func (c *inventoryServiceClient) UpdateSupplier(ctx context.Context, in *UpdateSupplierRequest, opts ...grpc.CallOption) (*UpdateSupplierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSupplierResponse)
+	err := c.cc.Invoke(ctx, InventoryService_UpdateSupplier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *inventoryServiceClient) GetSupplier(ctx context.Context, in *GetSupplierRequest, opts ...grpc.CallOption) (*GetSupplierResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetSupplierResponse)
@@ -283,6 +618,16 @@ func (c *inventoryServiceClient) CreateProductType(ctx context.Context, in *Crea
 	return out, nil
 }
 
+func (c *inventoryServiceClient) UpdateProductType(ctx context.Context, in *UpdateProductTypeRequest, opts ...grpc.CallOption) (*UpdateProductTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProductTypeResponse)
+	err := c.cc.Invoke(ctx, InventoryService_UpdateProductType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *inventoryServiceClient) ListProductTypes(ctx context.Context, in *ListProductTypesRequest, opts ...grpc.CallOption) (*ListProductTypesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListProductTypesResponse)
@@ -299,30 +644,60 @@ func (c *inventoryServiceClient) ListProductTypes(ctx context.Context, in *ListP
 type InventoryServiceServer interface {
 	// Stock Operations
 	CheckStock(context.Context, *CheckStockRequest) (*CheckStockResponse, error)
+	CheckStockBatch(context.Context, *CheckStockBatchRequest) (*CheckStockBatchResponse, error)
 	ReserveStock(context.Context, *ReserveStockRequest) (*ReserveStockResponse, error)
+	BatchReserveStock(context.Context, *BatchReserveStockRequest) (*BatchReserveStockResponse, error)
 	ReleaseStock(context.Context, *ReleaseStockRequest) (*ReleaseStockResponse, error)
+	ReleaseReservations(context.Context, *ReleaseReservationsRequest) (*ReleaseReservationsResponse, error)
+	ReleaseExpiredReservations(context.Context, *ReleaseExpiredReservationsRequest) (*ReleaseExpiredReservationsResponse, error)
+	CommitReservations(context.Context, *CommitReservationsRequest) (*CommitReservationsResponse, error)
 	UpdateStock(context.Context, *UpdateStockRequest) (*UpdateStockResponse, error)
+	AdjustStock(context.Context, *AdjustStockRequest) (*AdjustStockResponse, error)
+	GetStockAdjustmentReport(context.Context, *GetStockAdjustmentReportRequest) (*GetStockAdjustmentReportResponse, error)
 	GetStock(context.Context, *GetStockRequest) (*GetStockResponse, error)
 	ListLowStock(context.Context, *ListLowStockRequest) (*ListLowStockResponse, error)
+	ListExpiringStock(context.Context, *ListExpiringStockRequest) (*ListExpiringStockResponse, error)
+	ListStockSerials(context.Context, *ListStockSerialsRequest) (*ListStockSerialsResponse, error)
+	GetReorderSuggestions(context.Context, *GetReorderSuggestionsRequest) (*GetReorderSuggestionsResponse, error)
 	TransferStock(context.Context, *TransferStockRequest) (*TransferStockResponse, error)
+	// Purchase Order Operations
+	CreatePurchaseOrder(context.Context, *CreatePurchaseOrderRequest) (*CreatePurchaseOrderResponse, error)
+	ReceivePurchaseOrder(context.Context, *ReceivePurchaseOrderRequest) (*ReceivePurchaseOrderResponse, error)
+	GetPurchaseOrder(context.Context, *GetPurchaseOrderRequest) (*GetPurchaseOrderResponse, error)
+	CancelPurchaseOrder(context.Context, *CancelPurchaseOrderRequest) (*CancelPurchaseOrderResponse, error)
+	ListPurchaseOrders(context.Context, *ListPurchaseOrdersRequest) (*ListPurchaseOrdersResponse, error)
+	// Stock Count Operations
+	StartStockCount(context.Context, *StartStockCountRequest) (*StartStockCountResponse, error)
+	SubmitStockCountLine(context.Context, *SubmitStockCountLineRequest) (*SubmitStockCountLineResponse, error)
+	FinalizeStockCount(context.Context, *FinalizeStockCountRequest) (*FinalizeStockCountResponse, error)
+	// Valuation Operations
+	GetInventoryValuation(context.Context, *GetInventoryValuationRequest) (*GetInventoryValuationResponse, error)
+	GetStockValuationAsOf(context.Context, *GetStockValuationAsOfRequest) (*GetStockValuationAsOfResponse, error)
 	// Stock Movement Operations
 	ListStockMovements(context.Context, *ListStockMovementsRequest) (*ListStockMovementsResponse, error)
+	GetStockMovement(context.Context, *GetStockMovementRequest) (*GetStockMovementResponse, error)
+	StreamStockMovements(*StreamStockMovementsRequest, grpc.ServerStreamingServer[StockMovement]) error
+	StreamInventoryEvents(*StreamInventoryEventsRequest, grpc.ServerStreamingServer[InventoryEvent]) error
 	// Product Operations
 	CreateProduct(context.Context, *CreateProductRequest) (*CreateProductResponse, error)
 	UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error)
 	GetProduct(context.Context, *GetProductRequest) (*GetProductResponse, error)
 	GetProductByCode(context.Context, *GetProductByCodeRequest) (*GetProductByCodeResponse, error)
 	ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error)
+	ListUnitsOfMeasure(context.Context, *ListUnitsOfMeasureRequest) (*ListUnitsOfMeasureResponse, error)
 	// Warehouse Operations
 	CreateWarehouse(context.Context, *CreateWarehouseRequest) (*CreateWarehouseResponse, error)
+	UpdateWarehouse(context.Context, *UpdateWarehouseRequest) (*UpdateWarehouseResponse, error)
 	GetWarehouse(context.Context, *GetWarehouseRequest) (*GetWarehouseResponse, error)
 	ListWarehouses(context.Context, *ListWarehousesRequest) (*ListWarehousesResponse, error)
 	// Supplier Operations
 	CreateSupplier(context.Context, *CreateSupplierRequest) (*CreateSupplierResponse, error)
+	UpdateSupplier(context.Context, *UpdateSupplierRequest) (*UpdateSupplierResponse, error)
 	GetSupplier(context.Context, *GetSupplierRequest) (*GetSupplierResponse, error)
 	ListSuppliers(context.Context, *ListSuppliersRequest) (*ListSuppliersResponse, error)
 	// Product Type Operations
 	CreateProductType(context.Context, *CreateProductTypeRequest) (*CreateProductTypeResponse, error)
+	UpdateProductType(context.Context, *UpdateProductTypeRequest) (*UpdateProductTypeResponse, error)
 	ListProductTypes(context.Context, *ListProductTypesRequest) (*ListProductTypesResponse, error)
 	mustEmbedUnimplementedInventoryServiceServer()
 }
@@ -337,27 +712,96 @@ type UnimplementedInventoryServiceServer struct{}
 func (UnimplementedInventoryServiceServer) CheckStock(context.Context, *CheckStockRequest) (*CheckStockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckStock not implemented")
 }
+func (UnimplementedInventoryServiceServer) CheckStockBatch(context.Context, *CheckStockBatchRequest) (*CheckStockBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckStockBatch not implemented")
+}
 func (UnimplementedInventoryServiceServer) ReserveStock(context.Context, *ReserveStockRequest) (*ReserveStockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReserveStock not implemented")
 }
+func (UnimplementedInventoryServiceServer) BatchReserveStock(context.Context, *BatchReserveStockRequest) (*BatchReserveStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchReserveStock not implemented")
+}
 func (UnimplementedInventoryServiceServer) ReleaseStock(context.Context, *ReleaseStockRequest) (*ReleaseStockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReleaseStock not implemented")
 }
+func (UnimplementedInventoryServiceServer) ReleaseReservations(context.Context, *ReleaseReservationsRequest) (*ReleaseReservationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseReservations not implemented")
+}
+func (UnimplementedInventoryServiceServer) ReleaseExpiredReservations(context.Context, *ReleaseExpiredReservationsRequest) (*ReleaseExpiredReservationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseExpiredReservations not implemented")
+}
+func (UnimplementedInventoryServiceServer) CommitReservations(context.Context, *CommitReservationsRequest) (*CommitReservationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitReservations not implemented")
+}
 func (UnimplementedInventoryServiceServer) UpdateStock(context.Context, *UpdateStockRequest) (*UpdateStockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateStock not implemented")
 }
+func (UnimplementedInventoryServiceServer) AdjustStock(context.Context, *AdjustStockRequest) (*AdjustStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustStock not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetStockAdjustmentReport(context.Context, *GetStockAdjustmentReportRequest) (*GetStockAdjustmentReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStockAdjustmentReport not implemented")
+}
 func (UnimplementedInventoryServiceServer) GetStock(context.Context, *GetStockRequest) (*GetStockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStock not implemented")
 }
 func (UnimplementedInventoryServiceServer) ListLowStock(context.Context, *ListLowStockRequest) (*ListLowStockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListLowStock not implemented")
 }
+func (UnimplementedInventoryServiceServer) ListExpiringStock(context.Context, *ListExpiringStockRequest) (*ListExpiringStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExpiringStock not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListStockSerials(context.Context, *ListStockSerialsRequest) (*ListStockSerialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStockSerials not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetReorderSuggestions(context.Context, *GetReorderSuggestionsRequest) (*GetReorderSuggestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReorderSuggestions not implemented")
+}
 func (UnimplementedInventoryServiceServer) TransferStock(context.Context, *TransferStockRequest) (*TransferStockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TransferStock not implemented")
 }
+func (UnimplementedInventoryServiceServer) CreatePurchaseOrder(context.Context, *CreatePurchaseOrderRequest) (*CreatePurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePurchaseOrder not implemented")
+}
+func (UnimplementedInventoryServiceServer) ReceivePurchaseOrder(context.Context, *ReceivePurchaseOrderRequest) (*ReceivePurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceivePurchaseOrder not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetPurchaseOrder(context.Context, *GetPurchaseOrderRequest) (*GetPurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPurchaseOrder not implemented")
+}
+func (UnimplementedInventoryServiceServer) CancelPurchaseOrder(context.Context, *CancelPurchaseOrderRequest) (*CancelPurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPurchaseOrder not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListPurchaseOrders(context.Context, *ListPurchaseOrdersRequest) (*ListPurchaseOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPurchaseOrders not implemented")
+}
+func (UnimplementedInventoryServiceServer) StartStockCount(context.Context, *StartStockCountRequest) (*StartStockCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartStockCount not implemented")
+}
+func (UnimplementedInventoryServiceServer) SubmitStockCountLine(context.Context, *SubmitStockCountLineRequest) (*SubmitStockCountLineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitStockCountLine not implemented")
+}
+func (UnimplementedInventoryServiceServer) FinalizeStockCount(context.Context, *FinalizeStockCountRequest) (*FinalizeStockCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeStockCount not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetInventoryValuation(context.Context, *GetInventoryValuationRequest) (*GetInventoryValuationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInventoryValuation not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetStockValuationAsOf(context.Context, *GetStockValuationAsOfRequest) (*GetStockValuationAsOfResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStockValuationAsOf not implemented")
+}
 func (UnimplementedInventoryServiceServer) ListStockMovements(context.Context, *ListStockMovementsRequest) (*ListStockMovementsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListStockMovements not implemented")
 }
+func (UnimplementedInventoryServiceServer) GetStockMovement(context.Context, *GetStockMovementRequest) (*GetStockMovementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStockMovement not implemented")
+}
+func (UnimplementedInventoryServiceServer) StreamStockMovements(*StreamStockMovementsRequest, grpc.ServerStreamingServer[StockMovement]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamStockMovements not implemented")
+}
+func (UnimplementedInventoryServiceServer) StreamInventoryEvents(*StreamInventoryEventsRequest, grpc.ServerStreamingServer[InventoryEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamInventoryEvents not implemented")
+}
 func (UnimplementedInventoryServiceServer) CreateProduct(context.Context, *CreateProductRequest) (*CreateProductResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateProduct not implemented")
 }
@@ -373,9 +817,15 @@ func (UnimplementedInventoryServiceServer) GetProductByCode(context.Context, *Ge
 func (UnimplementedInventoryServiceServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProducts not implemented")
 }
+func (UnimplementedInventoryServiceServer) ListUnitsOfMeasure(context.Context, *ListUnitsOfMeasureRequest) (*ListUnitsOfMeasureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUnitsOfMeasure not implemented")
+}
 func (UnimplementedInventoryServiceServer) CreateWarehouse(context.Context, *CreateWarehouseRequest) (*CreateWarehouseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateWarehouse not implemented")
 }
+func (UnimplementedInventoryServiceServer) UpdateWarehouse(context.Context, *UpdateWarehouseRequest) (*UpdateWarehouseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWarehouse not implemented")
+}
 func (UnimplementedInventoryServiceServer) GetWarehouse(context.Context, *GetWarehouseRequest) (*GetWarehouseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWarehouse not implemented")
 }
@@ -385,161 +835,527 @@ func (UnimplementedInventoryServiceServer) ListWarehouses(context.Context, *List
 func (UnimplementedInventoryServiceServer) CreateSupplier(context.Context, *CreateSupplierRequest) (*CreateSupplierResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSupplier not implemented")
 }
+func (UnimplementedInventoryServiceServer) UpdateSupplier(context.Context, *UpdateSupplierRequest) (*UpdateSupplierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSupplier not implemented")
+}
 func (UnimplementedInventoryServiceServer) GetSupplier(context.Context, *GetSupplierRequest) (*GetSupplierResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSupplier not implemented")
 }
-func (UnimplementedInventoryServiceServer) ListSuppliers(context.Context, *ListSuppliersRequest) (*ListSuppliersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListSuppliers not implemented")
+func (UnimplementedInventoryServiceServer) ListSuppliers(context.Context, *ListSuppliersRequest) (*ListSuppliersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSuppliers not implemented")
+}
+func (UnimplementedInventoryServiceServer) CreateProductType(context.Context, *CreateProductTypeRequest) (*CreateProductTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProductType not implemented")
+}
+func (UnimplementedInventoryServiceServer) UpdateProductType(context.Context, *UpdateProductTypeRequest) (*UpdateProductTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProductType not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListProductTypes(context.Context, *ListProductTypesRequest) (*ListProductTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProductTypes not implemented")
+}
+func (UnimplementedInventoryServiceServer) mustEmbedUnimplementedInventoryServiceServer() {}
+func (UnimplementedInventoryServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeInventoryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InventoryServiceServer will
+// result in compilation errors.
+type UnsafeInventoryServiceServer interface {
+	mustEmbedUnimplementedInventoryServiceServer()
+}
+
+func RegisterInventoryServiceServer(s grpc.ServiceRegistrar, srv InventoryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedInventoryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&InventoryService_ServiceDesc, srv)
+}
+
+func _InventoryService_CheckStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CheckStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CheckStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CheckStock(ctx, req.(*CheckStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CheckStockBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckStockBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CheckStockBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CheckStockBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CheckStockBatch(ctx, req.(*CheckStockBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReserveStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReserveStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReserveStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReserveStock(ctx, req.(*ReserveStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_BatchReserveStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchReserveStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).BatchReserveStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_BatchReserveStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).BatchReserveStock(ctx, req.(*BatchReserveStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReleaseStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReleaseStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReleaseStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReleaseStock(ctx, req.(*ReleaseStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReleaseReservations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseReservationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReleaseReservations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReleaseReservations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReleaseReservations(ctx, req.(*ReleaseReservationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReleaseExpiredReservations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseExpiredReservationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReleaseExpiredReservations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReleaseExpiredReservations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReleaseExpiredReservations(ctx, req.(*ReleaseExpiredReservationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CommitReservations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitReservationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CommitReservations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CommitReservations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CommitReservations(ctx, req.(*CommitReservationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_UpdateStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).UpdateStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_UpdateStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).UpdateStock(ctx, req.(*UpdateStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_AdjustStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).AdjustStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_AdjustStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).AdjustStock(ctx, req.(*AdjustStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetStockAdjustmentReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStockAdjustmentReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetStockAdjustmentReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetStockAdjustmentReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetStockAdjustmentReport(ctx, req.(*GetStockAdjustmentReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetStock(ctx, req.(*GetStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListLowStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLowStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListLowStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListLowStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListLowStock(ctx, req.(*ListLowStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListExpiringStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExpiringStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListExpiringStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListExpiringStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListExpiringStock(ctx, req.(*ListExpiringStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListStockSerials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStockSerialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListStockSerials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListStockSerials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListStockSerials(ctx, req.(*ListStockSerialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetReorderSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReorderSuggestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetReorderSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetReorderSuggestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetReorderSuggestions(ctx, req.(*GetReorderSuggestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedInventoryServiceServer) CreateProductType(context.Context, *CreateProductTypeRequest) (*CreateProductTypeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateProductType not implemented")
+
+func _InventoryService_TransferStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).TransferStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_TransferStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).TransferStock(ctx, req.(*TransferStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedInventoryServiceServer) ListProductTypes(context.Context, *ListProductTypesRequest) (*ListProductTypesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListProductTypes not implemented")
+
+func _InventoryService_CreatePurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePurchaseOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CreatePurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CreatePurchaseOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CreatePurchaseOrder(ctx, req.(*CreatePurchaseOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedInventoryServiceServer) mustEmbedUnimplementedInventoryServiceServer() {}
-func (UnimplementedInventoryServiceServer) testEmbeddedByValue()                          {}
 
-// UnsafeInventoryServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to InventoryServiceServer will
-// result in compilation errors.
-type UnsafeInventoryServiceServer interface {
-	mustEmbedUnimplementedInventoryServiceServer()
+func _InventoryService_ReceivePurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceivePurchaseOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReceivePurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReceivePurchaseOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReceivePurchaseOrder(ctx, req.(*ReceivePurchaseOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterInventoryServiceServer(s grpc.ServiceRegistrar, srv InventoryServiceServer) {
-	// If the following call pancis, it indicates UnimplementedInventoryServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _InventoryService_GetPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPurchaseOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&InventoryService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetPurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetPurchaseOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetPurchaseOrder(ctx, req.(*GetPurchaseOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_CheckStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckStockRequest)
+func _InventoryService_CancelPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPurchaseOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).CheckStock(ctx, in)
+		return srv.(InventoryServiceServer).CancelPurchaseOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_CheckStock_FullMethodName,
+		FullMethod: InventoryService_CancelPurchaseOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).CheckStock(ctx, req.(*CheckStockRequest))
+		return srv.(InventoryServiceServer).CancelPurchaseOrder(ctx, req.(*CancelPurchaseOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_ReserveStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReserveStockRequest)
+func _InventoryService_ListPurchaseOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPurchaseOrdersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).ReserveStock(ctx, in)
+		return srv.(InventoryServiceServer).ListPurchaseOrders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_ReserveStock_FullMethodName,
+		FullMethod: InventoryService_ListPurchaseOrders_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).ReserveStock(ctx, req.(*ReserveStockRequest))
+		return srv.(InventoryServiceServer).ListPurchaseOrders(ctx, req.(*ListPurchaseOrdersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_ReleaseStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReleaseStockRequest)
+func _InventoryService_StartStockCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartStockCountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).ReleaseStock(ctx, in)
+		return srv.(InventoryServiceServer).StartStockCount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_ReleaseStock_FullMethodName,
+		FullMethod: InventoryService_StartStockCount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).ReleaseStock(ctx, req.(*ReleaseStockRequest))
+		return srv.(InventoryServiceServer).StartStockCount(ctx, req.(*StartStockCountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_UpdateStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateStockRequest)
+func _InventoryService_SubmitStockCountLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitStockCountLineRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).UpdateStock(ctx, in)
+		return srv.(InventoryServiceServer).SubmitStockCountLine(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_UpdateStock_FullMethodName,
+		FullMethod: InventoryService_SubmitStockCountLine_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).UpdateStock(ctx, req.(*UpdateStockRequest))
+		return srv.(InventoryServiceServer).SubmitStockCountLine(ctx, req.(*SubmitStockCountLineRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_GetStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStockRequest)
+func _InventoryService_FinalizeStockCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizeStockCountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).GetStock(ctx, in)
+		return srv.(InventoryServiceServer).FinalizeStockCount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_GetStock_FullMethodName,
+		FullMethod: InventoryService_FinalizeStockCount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).GetStock(ctx, req.(*GetStockRequest))
+		return srv.(InventoryServiceServer).FinalizeStockCount(ctx, req.(*FinalizeStockCountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_ListLowStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListLowStockRequest)
+func _InventoryService_GetInventoryValuation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInventoryValuationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).ListLowStock(ctx, in)
+		return srv.(InventoryServiceServer).GetInventoryValuation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_ListLowStock_FullMethodName,
+		FullMethod: InventoryService_GetInventoryValuation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).ListLowStock(ctx, req.(*ListLowStockRequest))
+		return srv.(InventoryServiceServer).GetInventoryValuation(ctx, req.(*GetInventoryValuationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_TransferStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TransferStockRequest)
+func _InventoryService_GetStockValuationAsOf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStockValuationAsOfRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).TransferStock(ctx, in)
+		return srv.(InventoryServiceServer).GetStockValuationAsOf(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_TransferStock_FullMethodName,
+		FullMethod: InventoryService_GetStockValuationAsOf_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).TransferStock(ctx, req.(*TransferStockRequest))
+		return srv.(InventoryServiceServer).GetStockValuationAsOf(ctx, req.(*GetStockValuationAsOfRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -562,6 +1378,46 @@ func _InventoryService_ListStockMovements_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_GetStockMovement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStockMovementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetStockMovement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetStockMovement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetStockMovement(ctx, req.(*GetStockMovementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_StreamStockMovements_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamStockMovementsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InventoryServiceServer).StreamStockMovements(m, &grpc.GenericServerStream[StreamStockMovementsRequest, StockMovement]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_StreamStockMovementsServer = grpc.ServerStreamingServer[StockMovement]
+
+func _InventoryService_StreamInventoryEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamInventoryEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InventoryServiceServer).StreamInventoryEvents(m, &grpc.GenericServerStream[StreamInventoryEventsRequest, InventoryEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_StreamInventoryEventsServer = grpc.ServerStreamingServer[InventoryEvent]
+
 func _InventoryService_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateProductRequest)
 	if err := dec(in); err != nil {
@@ -652,6 +1508,24 @@ func _InventoryService_ListProducts_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_ListUnitsOfMeasure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUnitsOfMeasureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListUnitsOfMeasure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListUnitsOfMeasure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListUnitsOfMeasure(ctx, req.(*ListUnitsOfMeasureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _InventoryService_CreateWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateWarehouseRequest)
 	if err := dec(in); err != nil {
@@ -670,6 +1544,24 @@ func _InventoryService_CreateWarehouse_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_UpdateWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWarehouseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).UpdateWarehouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_UpdateWarehouse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).UpdateWarehouse(ctx, req.(*UpdateWarehouseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _InventoryService_GetWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetWarehouseRequest)
 	if err := dec(in); err != nil {
@@ -724,6 +1616,24 @@ func _InventoryService_CreateSupplier_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_UpdateSupplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSupplierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).UpdateSupplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_UpdateSupplier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).UpdateSupplier(ctx, req.(*UpdateSupplierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _InventoryService_GetSupplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSupplierRequest)
 	if err := dec(in); err != nil {
@@ -778,6 +1688,24 @@ func _InventoryService_CreateProductType_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_UpdateProductType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).UpdateProductType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_UpdateProductType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).UpdateProductType(ctx, req.(*UpdateProductTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _InventoryService_ListProductTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListProductTypesRequest)
 	if err := dec(in); err != nil {
@@ -807,18 +1735,46 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckStock",
 			Handler:    _InventoryService_CheckStock_Handler,
 		},
+		{
+			MethodName: "CheckStockBatch",
+			Handler:    _InventoryService_CheckStockBatch_Handler,
+		},
 		{
 			MethodName: "ReserveStock",
 			Handler:    _InventoryService_ReserveStock_Handler,
 		},
+		{
+			MethodName: "BatchReserveStock",
+			Handler:    _InventoryService_BatchReserveStock_Handler,
+		},
 		{
 			MethodName: "ReleaseStock",
 			Handler:    _InventoryService_ReleaseStock_Handler,
 		},
+		{
+			MethodName: "ReleaseReservations",
+			Handler:    _InventoryService_ReleaseReservations_Handler,
+		},
+		{
+			MethodName: "ReleaseExpiredReservations",
+			Handler:    _InventoryService_ReleaseExpiredReservations_Handler,
+		},
+		{
+			MethodName: "CommitReservations",
+			Handler:    _InventoryService_CommitReservations_Handler,
+		},
 		{
 			MethodName: "UpdateStock",
 			Handler:    _InventoryService_UpdateStock_Handler,
 		},
+		{
+			MethodName: "AdjustStock",
+			Handler:    _InventoryService_AdjustStock_Handler,
+		},
+		{
+			MethodName: "GetStockAdjustmentReport",
+			Handler:    _InventoryService_GetStockAdjustmentReport_Handler,
+		},
 		{
 			MethodName: "GetStock",
 			Handler:    _InventoryService_GetStock_Handler,
@@ -827,14 +1783,70 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListLowStock",
 			Handler:    _InventoryService_ListLowStock_Handler,
 		},
+		{
+			MethodName: "ListExpiringStock",
+			Handler:    _InventoryService_ListExpiringStock_Handler,
+		},
+		{
+			MethodName: "ListStockSerials",
+			Handler:    _InventoryService_ListStockSerials_Handler,
+		},
+		{
+			MethodName: "GetReorderSuggestions",
+			Handler:    _InventoryService_GetReorderSuggestions_Handler,
+		},
 		{
 			MethodName: "TransferStock",
 			Handler:    _InventoryService_TransferStock_Handler,
 		},
+		{
+			MethodName: "CreatePurchaseOrder",
+			Handler:    _InventoryService_CreatePurchaseOrder_Handler,
+		},
+		{
+			MethodName: "ReceivePurchaseOrder",
+			Handler:    _InventoryService_ReceivePurchaseOrder_Handler,
+		},
+		{
+			MethodName: "GetPurchaseOrder",
+			Handler:    _InventoryService_GetPurchaseOrder_Handler,
+		},
+		{
+			MethodName: "CancelPurchaseOrder",
+			Handler:    _InventoryService_CancelPurchaseOrder_Handler,
+		},
+		{
+			MethodName: "ListPurchaseOrders",
+			Handler:    _InventoryService_ListPurchaseOrders_Handler,
+		},
+		{
+			MethodName: "StartStockCount",
+			Handler:    _InventoryService_StartStockCount_Handler,
+		},
+		{
+			MethodName: "SubmitStockCountLine",
+			Handler:    _InventoryService_SubmitStockCountLine_Handler,
+		},
+		{
+			MethodName: "FinalizeStockCount",
+			Handler:    _InventoryService_FinalizeStockCount_Handler,
+		},
+		{
+			MethodName: "GetInventoryValuation",
+			Handler:    _InventoryService_GetInventoryValuation_Handler,
+		},
+		{
+			MethodName: "GetStockValuationAsOf",
+			Handler:    _InventoryService_GetStockValuationAsOf_Handler,
+		},
 		{
 			MethodName: "ListStockMovements",
 			Handler:    _InventoryService_ListStockMovements_Handler,
 		},
+		{
+			MethodName: "GetStockMovement",
+			Handler:    _InventoryService_GetStockMovement_Handler,
+		},
 		{
 			MethodName: "CreateProduct",
 			Handler:    _InventoryService_CreateProduct_Handler,
@@ -855,10 +1867,18 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListProducts",
 			Handler:    _InventoryService_ListProducts_Handler,
 		},
+		{
+			MethodName: "ListUnitsOfMeasure",
+			Handler:    _InventoryService_ListUnitsOfMeasure_Handler,
+		},
 		{
 			MethodName: "CreateWarehouse",
 			Handler:    _InventoryService_CreateWarehouse_Handler,
 		},
+		{
+			MethodName: "UpdateWarehouse",
+			Handler:    _InventoryService_UpdateWarehouse_Handler,
+		},
 		{
 			MethodName: "GetWarehouse",
 			Handler:    _InventoryService_GetWarehouse_Handler,
@@ -871,6 +1891,10 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateSupplier",
 			Handler:    _InventoryService_CreateSupplier_Handler,
 		},
+		{
+			MethodName: "UpdateSupplier",
+			Handler:    _InventoryService_UpdateSupplier_Handler,
+		},
 		{
 			MethodName: "GetSupplier",
 			Handler:    _InventoryService_GetSupplier_Handler,
@@ -883,11 +1907,26 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateProductType",
 			Handler:    _InventoryService_CreateProductType_Handler,
 		},
+		{
+			MethodName: "UpdateProductType",
+			Handler:    _InventoryService_UpdateProductType_Handler,
+		},
 		{
 			MethodName: "ListProductTypes",
 			Handler:    _InventoryService_ListProductTypes_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamStockMovements",
+			Handler:       _InventoryService_StreamStockMovements_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamInventoryEvents",
+			Handler:       _InventoryService_StreamInventoryEvents_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "inventory/inventory_service.proto",
 }
@@ -181,6 +181,62 @@ func (DiscountType) EnumDescriptor() ([]byte, []int) {
 	return file_pos_pos_service_proto_rawDescGZIP(), []int{2}
 }
 
+type PriceOperation int32
+
+const (
+	PriceOperation_PRICE_OPERATION_UNSPECIFIED      PriceOperation = 0
+	PriceOperation_PRICE_OPERATION_SET              PriceOperation = 1
+	PriceOperation_PRICE_OPERATION_INCREASE_PERCENT PriceOperation = 2
+	PriceOperation_PRICE_OPERATION_DECREASE_PERCENT PriceOperation = 3
+	// Price = cost_price * (1 + value / 100).
+	PriceOperation_PRICE_OPERATION_SET_COST_MARGIN PriceOperation = 4
+)
+
+// Enum value maps for PriceOperation.
+var (
+	PriceOperation_name = map[int32]string{
+		0: "PRICE_OPERATION_UNSPECIFIED",
+		1: "PRICE_OPERATION_SET",
+		2: "PRICE_OPERATION_INCREASE_PERCENT",
+		3: "PRICE_OPERATION_DECREASE_PERCENT",
+		4: "PRICE_OPERATION_SET_COST_MARGIN",
+	}
+	PriceOperation_value = map[string]int32{
+		"PRICE_OPERATION_UNSPECIFIED":      0,
+		"PRICE_OPERATION_SET":              1,
+		"PRICE_OPERATION_INCREASE_PERCENT": 2,
+		"PRICE_OPERATION_DECREASE_PERCENT": 3,
+		"PRICE_OPERATION_SET_COST_MARGIN":  4,
+	}
+)
+
+func (x PriceOperation) Enum() *PriceOperation {
+	p := new(PriceOperation)
+	*p = x
+	return p
+}
+
+func (x PriceOperation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PriceOperation) Descriptor() protoreflect.EnumDescriptor {
+	return file_pos_pos_service_proto_enumTypes[3].Descriptor()
+}
+
+func (PriceOperation) Type() protoreflect.EnumType {
+	return &file_pos_pos_service_proto_enumTypes[3]
+}
+
+func (x PriceOperation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PriceOperation.Descriptor instead.
+func (PriceOperation) EnumDescriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{3}
+}
+
 type PaginationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
@@ -286,9 +342,11 @@ func (x *PaginationResponse) GetTotalCount() int32 {
 }
 
 type DateRange struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StartDate string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// IANA zone used for day boundaries; defaults to the store timezone.
+	Timezone      *string `protobuf:"bytes,3,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -337,6 +395,13 @@ func (x *DateRange) GetEndDate() string {
 	return ""
 }
 
+func (x *DateRange) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
 type OrderDocument struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -358,8 +423,18 @@ type OrderDocument struct {
 	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	OrderItems     []*OrderItem           `protobuf:"bytes,18,rep,name=order_items,json=orderItems,proto3" json:"order_items,omitempty"`
 	PaymentType    *PaymentType           `protobuf:"bytes,19,opt,name=payment_type,json=paymentType,proto3,oneof" json:"payment_type,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	TaxExempt      bool                   `protobuf:"varint,20,opt,name=tax_exempt,json=taxExempt,proto3" json:"tax_exempt,omitempty"`
+	// ISO 4217 code; defaults to the configured base currency.
+	Currency string `protobuf:"bytes,21,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Rate to the base currency captured when the order was created.
+	ExchangeRate       string  `protobuf:"bytes,22,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	TaxExemptionReason *string `protobuf:"bytes,23,opt,name=tax_exemption_reason,json=taxExemptionReason,proto3,oneof" json:"tax_exemption_reason,omitempty"`
+	// Tax per rate; tax_amount stays the aggregate of these lines.
+	TaxLines      []*OrderTaxLine `protobuf:"bytes,24,rep,name=tax_lines,json=taxLines,proto3" json:"tax_lines,omitempty"`
+	CreatedBy     *int64          `protobuf:"varint,25,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	UpdatedBy     *int64          `protobuf:"varint,26,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *OrderDocument) Reset() {
@@ -525,29 +600,159 @@ func (x *OrderDocument) GetPaymentType() *PaymentType {
 	return nil
 }
 
+func (x *OrderDocument) GetTaxExempt() bool {
+	if x != nil {
+		return x.TaxExempt
+	}
+	return false
+}
+
+func (x *OrderDocument) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *OrderDocument) GetExchangeRate() string {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return ""
+}
+
+func (x *OrderDocument) GetTaxExemptionReason() string {
+	if x != nil && x.TaxExemptionReason != nil {
+		return *x.TaxExemptionReason
+	}
+	return ""
+}
+
+func (x *OrderDocument) GetTaxLines() []*OrderTaxLine {
+	if x != nil {
+		return x.TaxLines
+	}
+	return nil
+}
+
+func (x *OrderDocument) GetCreatedBy() int64 {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return 0
+}
+
+func (x *OrderDocument) GetUpdatedBy() int64 {
+	if x != nil && x.UpdatedBy != nil {
+		return *x.UpdatedBy
+	}
+	return 0
+}
+
+type OrderTaxLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DocumentId    int64                  `protobuf:"varint,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	TaxRate       string                 `protobuf:"bytes,3,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
+	TaxableAmount string                 `protobuf:"bytes,4,opt,name=taxable_amount,json=taxableAmount,proto3" json:"taxable_amount,omitempty"`
+	TaxAmount     string                 `protobuf:"bytes,5,opt,name=tax_amount,json=taxAmount,proto3" json:"tax_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderTaxLine) Reset() {
+	*x = OrderTaxLine{}
+	mi := &file_pos_pos_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderTaxLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderTaxLine) ProtoMessage() {}
+
+func (x *OrderTaxLine) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderTaxLine.ProtoReflect.Descriptor instead.
+func (*OrderTaxLine) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OrderTaxLine) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OrderTaxLine) GetDocumentId() int64 {
+	if x != nil {
+		return x.DocumentId
+	}
+	return 0
+}
+
+func (x *OrderTaxLine) GetTaxRate() string {
+	if x != nil {
+		return x.TaxRate
+	}
+	return ""
+}
+
+func (x *OrderTaxLine) GetTaxableAmount() string {
+	if x != nil {
+		return x.TaxableAmount
+	}
+	return ""
+}
+
+func (x *OrderTaxLine) GetTaxAmount() string {
+	if x != nil {
+		return x.TaxAmount
+	}
+	return ""
+}
+
 type OrderItem struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Id                  int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	DocumentId          int64                  `protobuf:"varint,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
-	ProductId           int32                  `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	ServingEmployeeId   *int64                 `protobuf:"varint,4,opt,name=serving_employee_id,json=servingEmployeeId,proto3,oneof" json:"serving_employee_id,omitempty"`
-	Quantity            int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	UnitPrice           string                 `protobuf:"bytes,6,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
-	PriceBeforeDiscount string                 `protobuf:"bytes,7,opt,name=price_before_discount,json=priceBeforeDiscount,proto3" json:"price_before_discount,omitempty"`
-	DiscountId          *int32                 `protobuf:"varint,8,opt,name=discount_id,json=discountId,proto3,oneof" json:"discount_id,omitempty"`
-	DiscountAmount      string                 `protobuf:"bytes,9,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
-	LineTotal           string                 `protobuf:"bytes,10,opt,name=line_total,json=lineTotal,proto3" json:"line_total,omitempty"`
-	CommissionAmount    string                 `protobuf:"bytes,11,opt,name=commission_amount,json=commissionAmount,proto3" json:"commission_amount,omitempty"`
-	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Product             *Product               `protobuf:"bytes,13,opt,name=product,proto3,oneof" json:"product,omitempty"`
-	Discount            *Discount              `protobuf:"bytes,14,opt,name=discount,proto3,oneof" json:"discount,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	state                 protoimpl.MessageState  `protogen:"open.v1"`
+	Id                    int64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DocumentId            int64                   `protobuf:"varint,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	ProductId             int32                   `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ServingEmployeeId     *int64                  `protobuf:"varint,4,opt,name=serving_employee_id,json=servingEmployeeId,proto3,oneof" json:"serving_employee_id,omitempty"`
+	Quantity              int32                   `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice             string                  `protobuf:"bytes,6,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	PriceBeforeDiscount   string                  `protobuf:"bytes,7,opt,name=price_before_discount,json=priceBeforeDiscount,proto3" json:"price_before_discount,omitempty"`
+	DiscountId            *int32                  `protobuf:"varint,8,opt,name=discount_id,json=discountId,proto3,oneof" json:"discount_id,omitempty"`
+	DiscountAmount        string                  `protobuf:"bytes,9,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	LineTotal             string                  `protobuf:"bytes,10,opt,name=line_total,json=lineTotal,proto3" json:"line_total,omitempty"`
+	CommissionAmount      string                  `protobuf:"bytes,11,opt,name=commission_amount,json=commissionAmount,proto3" json:"commission_amount,omitempty"`
+	CreatedAt             *timestamppb.Timestamp  `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Product               *Product                `protobuf:"bytes,13,opt,name=product,proto3,oneof" json:"product,omitempty"`
+	Discount              *Discount               `protobuf:"bytes,14,opt,name=discount,proto3,oneof" json:"discount,omitempty"`
+	ServingEmployeeSplits []*ServingEmployeeSplit `protobuf:"bytes,15,rep,name=serving_employee_splits,json=servingEmployeeSplits,proto3" json:"serving_employee_splits,omitempty"`
+	// Product group commission rate and product cost captured at sale time.
+	CommissionRate string  `protobuf:"bytes,16,opt,name=commission_rate,json=commissionRate,proto3" json:"commission_rate,omitempty"`
+	CostPrice      string  `protobuf:"bytes,17,opt,name=cost_price,json=costPrice,proto3" json:"cost_price,omitempty"`
+	SerialNumber   *string `protobuf:"bytes,18,opt,name=serial_number,json=serialNumber,proto3,oneof" json:"serial_number,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *OrderItem) Reset() {
 	*x = OrderItem{}
-	mi := &file_pos_pos_service_proto_msgTypes[4]
+	mi := &file_pos_pos_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -559,7 +764,7 @@ func (x *OrderItem) String() string {
 func (*OrderItem) ProtoMessage() {}
 
 func (x *OrderItem) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[4]
+	mi := &file_pos_pos_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -572,7 +777,7 @@ func (x *OrderItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
 func (*OrderItem) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{4}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *OrderItem) GetId() int64 {
@@ -673,6 +878,88 @@ func (x *OrderItem) GetDiscount() *Discount {
 	return nil
 }
 
+func (x *OrderItem) GetServingEmployeeSplits() []*ServingEmployeeSplit {
+	if x != nil {
+		return x.ServingEmployeeSplits
+	}
+	return nil
+}
+
+func (x *OrderItem) GetCommissionRate() string {
+	if x != nil {
+		return x.CommissionRate
+	}
+	return ""
+}
+
+func (x *OrderItem) GetCostPrice() string {
+	if x != nil {
+		return x.CostPrice
+	}
+	return ""
+}
+
+func (x *OrderItem) GetSerialNumber() string {
+	if x != nil && x.SerialNumber != nil {
+		return *x.SerialNumber
+	}
+	return ""
+}
+
+// Share of an order item's commissionable sales credited to one serving
+// employee. Splits on an item must sum to 100.
+type ServingEmployeeSplit struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId      int64                  `protobuf:"varint,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	SplitPercentage string                 `protobuf:"bytes,2,opt,name=split_percentage,json=splitPercentage,proto3" json:"split_percentage,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ServingEmployeeSplit) Reset() {
+	*x = ServingEmployeeSplit{}
+	mi := &file_pos_pos_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServingEmployeeSplit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServingEmployeeSplit) ProtoMessage() {}
+
+func (x *ServingEmployeeSplit) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServingEmployeeSplit.ProtoReflect.Descriptor instead.
+func (*ServingEmployeeSplit) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ServingEmployeeSplit) GetEmployeeId() int64 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *ServingEmployeeSplit) GetSplitPercentage() string {
+	if x != nil {
+		return x.SplitPercentage
+	}
+	return ""
+}
+
 type PaymentType struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	Id                int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -687,7 +974,7 @@ type PaymentType struct {
 
 func (x *PaymentType) Reset() {
 	*x = PaymentType{}
-	mi := &file_pos_pos_service_proto_msgTypes[5]
+	mi := &file_pos_pos_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -699,7 +986,7 @@ func (x *PaymentType) String() string {
 func (*PaymentType) ProtoMessage() {}
 
 func (x *PaymentType) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[5]
+	mi := &file_pos_pos_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -712,7 +999,7 @@ func (x *PaymentType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentType.ProtoReflect.Descriptor instead.
 func (*PaymentType) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{5}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PaymentType) GetId() int32 {
@@ -774,13 +1061,16 @@ type Discount struct {
 	UpdatedAt              *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	Product                *Product               `protobuf:"bytes,14,opt,name=product,proto3,oneof" json:"product,omitempty"`
 	ProductGroup           *ProductGroup          `protobuf:"bytes,15,opt,name=product_group,json=productGroup,proto3,oneof" json:"product_group,omitempty"`
+	DeletedAt              *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
+	CreatedBy              *int64                 `protobuf:"varint,17,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	UpdatedBy              *int64                 `protobuf:"varint,18,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
 func (x *Discount) Reset() {
 	*x = Discount{}
-	mi := &file_pos_pos_service_proto_msgTypes[6]
+	mi := &file_pos_pos_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -792,7 +1082,7 @@ func (x *Discount) String() string {
 func (*Discount) ProtoMessage() {}
 
 func (x *Discount) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[6]
+	mi := &file_pos_pos_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -805,7 +1095,7 @@ func (x *Discount) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Discount.ProtoReflect.Descriptor instead.
 func (*Discount) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{6}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Discount) GetId() int32 {
@@ -913,6 +1203,27 @@ func (x *Discount) GetProductGroup() *ProductGroup {
 	return nil
 }
 
+func (x *Discount) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Discount) GetCreatedBy() int64 {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return 0
+}
+
+func (x *Discount) GetUpdatedBy() int64 {
+	if x != nil && x.UpdatedBy != nil {
+		return *x.UpdatedBy
+	}
+	return 0
+}
+
 type Product struct {
 	state                   protoimpl.MessageState `protogen:"open.v1"`
 	Id                      int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -927,13 +1238,19 @@ type Product struct {
 	CreatedAt               *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt               *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	ProductGroup            *ProductGroup          `protobuf:"bytes,12,opt,name=product_group,json=productGroup,proto3,oneof" json:"product_group,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+	TaxExempt               bool                   `protobuf:"varint,13,opt,name=tax_exempt,json=taxExempt,proto3" json:"tax_exempt,omitempty"`
+	// Overrides the configured default tax rate when set.
+	TaxRate       *string                `protobuf:"bytes,14,opt,name=tax_rate,json=taxRate,proto3,oneof" json:"tax_rate,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
+	CreatedBy     *int64                 `protobuf:"varint,16,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	UpdatedBy     *int64                 `protobuf:"varint,17,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Product) Reset() {
 	*x = Product{}
-	mi := &file_pos_pos_service_proto_msgTypes[7]
+	mi := &file_pos_pos_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -945,7 +1262,7 @@ func (x *Product) String() string {
 func (*Product) ProtoMessage() {}
 
 func (x *Product) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[7]
+	mi := &file_pos_pos_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -958,7 +1275,7 @@ func (x *Product) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Product.ProtoReflect.Descriptor instead.
 func (*Product) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{7}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Product) GetId() int32 {
@@ -1045,39 +1362,258 @@ func (x *Product) GetProductGroup() *ProductGroup {
 	return nil
 }
 
-type ProductGroup struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ProductGroupName string                 `protobuf:"bytes,2,opt,name=product_group_name,json=productGroupName,proto3" json:"product_group_name,omitempty"`
-	ParentGroupId    *int32                 `protobuf:"varint,3,opt,name=parent_group_id,json=parentGroupId,proto3,oneof" json:"parent_group_id,omitempty"`
-	Color            *string                `protobuf:"bytes,4,opt,name=color,proto3,oneof" json:"color,omitempty"`
-	ImageUrl         *string                `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
-	CommissionRate   string                 `protobuf:"bytes,6,opt,name=commission_rate,json=commissionRate,proto3" json:"commission_rate,omitempty"`
-	IsActive         bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	ParentGroup      *ProductGroup          `protobuf:"bytes,10,opt,name=parent_group,json=parentGroup,proto3,oneof" json:"parent_group,omitempty"`
-	ChildGroups      []*ProductGroup        `protobuf:"bytes,11,rep,name=child_groups,json=childGroups,proto3" json:"child_groups,omitempty"`
-	Products         []*Product             `protobuf:"bytes,12,rep,name=products,proto3" json:"products,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *Product) GetTaxExempt() bool {
+	if x != nil {
+		return x.TaxExempt
+	}
+	return false
 }
 
-func (x *ProductGroup) Reset() {
-	*x = ProductGroup{}
-	mi := &file_pos_pos_service_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Product) GetTaxRate() string {
+	if x != nil && x.TaxRate != nil {
+		return *x.TaxRate
+	}
+	return ""
 }
 
-func (x *ProductGroup) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+func (x *Product) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Product) GetCreatedBy() int64 {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return 0
+}
+
+func (x *Product) GetUpdatedBy() int64 {
+	if x != nil && x.UpdatedBy != nil {
+		return *x.UpdatedBy
+	}
+	return 0
+}
+
+type ProductPriceHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     int32                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	OldPrice      string                 `protobuf:"bytes,3,opt,name=old_price,json=oldPrice,proto3" json:"old_price,omitempty"`
+	NewPrice      string                 `protobuf:"bytes,4,opt,name=new_price,json=newPrice,proto3" json:"new_price,omitempty"`
+	ChangedBy     *int64                 `protobuf:"varint,5,opt,name=changed_by,json=changedBy,proto3,oneof" json:"changed_by,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductPriceHistory) Reset() {
+	*x = ProductPriceHistory{}
+	mi := &file_pos_pos_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductPriceHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductPriceHistory) ProtoMessage() {}
+
+func (x *ProductPriceHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductPriceHistory.ProtoReflect.Descriptor instead.
+func (*ProductPriceHistory) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ProductPriceHistory) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProductPriceHistory) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ProductPriceHistory) GetOldPrice() string {
+	if x != nil {
+		return x.OldPrice
+	}
+	return ""
+}
+
+func (x *ProductPriceHistory) GetNewPrice() string {
+	if x != nil {
+		return x.NewPrice
+	}
+	return ""
+}
+
+func (x *ProductPriceHistory) GetChangedBy() int64 {
+	if x != nil && x.ChangedBy != nil {
+		return *x.ChangedBy
+	}
+	return 0
+}
+
+func (x *ProductPriceHistory) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+type ProductScheduledPrice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     int32                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	NewPrice      string                 `protobuf:"bytes,3,opt,name=new_price,json=newPrice,proto3" json:"new_price,omitempty"`
+	EffectiveAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
+	IsApplied     bool                   `protobuf:"varint,5,opt,name=is_applied,json=isApplied,proto3" json:"is_applied,omitempty"`
+	AppliedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=applied_at,json=appliedAt,proto3,oneof" json:"applied_at,omitempty"`
+	CreatedBy     *int64                 `protobuf:"varint,7,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductScheduledPrice) Reset() {
+	*x = ProductScheduledPrice{}
+	mi := &file_pos_pos_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductScheduledPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductScheduledPrice) ProtoMessage() {}
+
+func (x *ProductScheduledPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductScheduledPrice.ProtoReflect.Descriptor instead.
+func (*ProductScheduledPrice) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ProductScheduledPrice) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProductScheduledPrice) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ProductScheduledPrice) GetNewPrice() string {
+	if x != nil {
+		return x.NewPrice
+	}
+	return ""
+}
+
+func (x *ProductScheduledPrice) GetEffectiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return nil
+}
+
+func (x *ProductScheduledPrice) GetIsApplied() bool {
+	if x != nil {
+		return x.IsApplied
+	}
+	return false
+}
+
+func (x *ProductScheduledPrice) GetAppliedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AppliedAt
+	}
+	return nil
+}
+
+func (x *ProductScheduledPrice) GetCreatedBy() int64 {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return 0
+}
+
+func (x *ProductScheduledPrice) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ProductGroup struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductGroupName string                 `protobuf:"bytes,2,opt,name=product_group_name,json=productGroupName,proto3" json:"product_group_name,omitempty"`
+	ParentGroupId    *int32                 `protobuf:"varint,3,opt,name=parent_group_id,json=parentGroupId,proto3,oneof" json:"parent_group_id,omitempty"`
+	Color            *string                `protobuf:"bytes,4,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	ImageUrl         *string                `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
+	CommissionRate   string                 `protobuf:"bytes,6,opt,name=commission_rate,json=commissionRate,proto3" json:"commission_rate,omitempty"`
+	IsActive         bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ParentGroup      *ProductGroup          `protobuf:"bytes,10,opt,name=parent_group,json=parentGroup,proto3,oneof" json:"parent_group,omitempty"`
+	ChildGroups      []*ProductGroup        `protobuf:"bytes,11,rep,name=child_groups,json=childGroups,proto3" json:"child_groups,omitempty"`
+	Products         []*Product             `protobuf:"bytes,12,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ProductGroup) Reset() {
+	*x = ProductGroup{}
+	mi := &file_pos_pos_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
 func (*ProductGroup) ProtoMessage() {}
 
 func (x *ProductGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[8]
+	mi := &file_pos_pos_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1090,7 +1626,7 @@ func (x *ProductGroup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductGroup.ProtoReflect.Descriptor instead.
 func (*ProductGroup) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{8}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ProductGroup) GetId() int32 {
@@ -1189,13 +1725,20 @@ type Cart struct {
 	TotalAmount    string                 `protobuf:"bytes,7,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Incremented on every mutation; see expected_version on cart requests.
+	Version            int64   `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
+	Currency           string  `protobuf:"bytes,11,opt,name=currency,proto3" json:"currency,omitempty"`
+	TaxExempt          bool    `protobuf:"varint,12,opt,name=tax_exempt,json=taxExempt,proto3" json:"tax_exempt,omitempty"`
+	TaxExemptionReason *string `protobuf:"bytes,13,opt,name=tax_exemption_reason,json=taxExemptionReason,proto3,oneof" json:"tax_exemption_reason,omitempty"`
+	CreatedBy          *int64  `protobuf:"varint,14,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	UpdatedBy          *int64  `protobuf:"varint,15,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *Cart) Reset() {
 	*x = Cart{}
-	mi := &file_pos_pos_service_proto_msgTypes[9]
+	mi := &file_pos_pos_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1207,7 +1750,7 @@ func (x *Cart) String() string {
 func (*Cart) ProtoMessage() {}
 
 func (x *Cart) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[9]
+	mi := &file_pos_pos_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1220,7 +1763,7 @@ func (x *Cart) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Cart.ProtoReflect.Descriptor instead.
 func (*Cart) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{9}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Cart) GetCartId() string {
@@ -1286,25 +1829,69 @@ func (x *Cart) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Cart) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Cart) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Cart) GetTaxExempt() bool {
+	if x != nil {
+		return x.TaxExempt
+	}
+	return false
+}
+
+func (x *Cart) GetTaxExemptionReason() string {
+	if x != nil && x.TaxExemptionReason != nil {
+		return *x.TaxExemptionReason
+	}
+	return ""
+}
+
+func (x *Cart) GetCreatedBy() int64 {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return 0
+}
+
+func (x *Cart) GetUpdatedBy() int64 {
+	if x != nil && x.UpdatedBy != nil {
+		return *x.UpdatedBy
+	}
+	return 0
+}
+
 type CartItem struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	ItemId            string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	ProductId         int32                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	ServingEmployeeId *int64                 `protobuf:"varint,3,opt,name=serving_employee_id,json=servingEmployeeId,proto3,oneof" json:"serving_employee_id,omitempty"`
-	Quantity          int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	UnitPrice         string                 `protobuf:"bytes,5,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
-	DiscountId        *int32                 `protobuf:"varint,6,opt,name=discount_id,json=discountId,proto3,oneof" json:"discount_id,omitempty"`
-	DiscountAmount    string                 `protobuf:"bytes,7,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
-	LineTotal         string                 `protobuf:"bytes,8,opt,name=line_total,json=lineTotal,proto3" json:"line_total,omitempty"`
-	Product           *Product               `protobuf:"bytes,9,opt,name=product,proto3,oneof" json:"product,omitempty"`
-	Discount          *Discount              `protobuf:"bytes,10,opt,name=discount,proto3,oneof" json:"discount,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	state                 protoimpl.MessageState  `protogen:"open.v1"`
+	ItemId                string                  `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ProductId             int32                   `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ServingEmployeeId     *int64                  `protobuf:"varint,3,opt,name=serving_employee_id,json=servingEmployeeId,proto3,oneof" json:"serving_employee_id,omitempty"`
+	Quantity              int32                   `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice             string                  `protobuf:"bytes,5,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	DiscountId            *int32                  `protobuf:"varint,6,opt,name=discount_id,json=discountId,proto3,oneof" json:"discount_id,omitempty"`
+	DiscountAmount        string                  `protobuf:"bytes,7,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	LineTotal             string                  `protobuf:"bytes,8,opt,name=line_total,json=lineTotal,proto3" json:"line_total,omitempty"`
+	Product               *Product                `protobuf:"bytes,9,opt,name=product,proto3,oneof" json:"product,omitempty"`
+	Discount              *Discount               `protobuf:"bytes,10,opt,name=discount,proto3,oneof" json:"discount,omitempty"`
+	SerialNumber          *string                 `protobuf:"bytes,11,opt,name=serial_number,json=serialNumber,proto3,oneof" json:"serial_number,omitempty"`
+	ServingEmployeeSplits []*ServingEmployeeSplit `protobuf:"bytes,12,rep,name=serving_employee_splits,json=servingEmployeeSplits,proto3" json:"serving_employee_splits,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *CartItem) Reset() {
 	*x = CartItem{}
-	mi := &file_pos_pos_service_proto_msgTypes[10]
+	mi := &file_pos_pos_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1316,7 +1903,7 @@ func (x *CartItem) String() string {
 func (*CartItem) ProtoMessage() {}
 
 func (x *CartItem) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[10]
+	mi := &file_pos_pos_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1329,7 +1916,7 @@ func (x *CartItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CartItem.ProtoReflect.Descriptor instead.
 func (*CartItem) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{10}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CartItem) GetItemId() string {
@@ -1402,17 +1989,34 @@ func (x *CartItem) GetDiscount() *Discount {
 	return nil
 }
 
+func (x *CartItem) GetSerialNumber() string {
+	if x != nil && x.SerialNumber != nil {
+		return *x.SerialNumber
+	}
+	return ""
+}
+
+func (x *CartItem) GetServingEmployeeSplits() []*ServingEmployeeSplit {
+	if x != nil {
+		return x.ServingEmployeeSplits
+	}
+	return nil
+}
+
 // Cart Operations
 type CreateCartRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CashierId     int64                  `protobuf:"varint,1,opt,name=cashier_id,json=cashierId,proto3" json:"cashier_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	CashierId          int64                  `protobuf:"varint,1,opt,name=cashier_id,json=cashierId,proto3" json:"cashier_id,omitempty"`
+	Currency           *string                `protobuf:"bytes,2,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	TaxExempt          *bool                  `protobuf:"varint,3,opt,name=tax_exempt,json=taxExempt,proto3,oneof" json:"tax_exempt,omitempty"`
+	TaxExemptionReason *string                `protobuf:"bytes,4,opt,name=tax_exemption_reason,json=taxExemptionReason,proto3,oneof" json:"tax_exemption_reason,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *CreateCartRequest) Reset() {
 	*x = CreateCartRequest{}
-	mi := &file_pos_pos_service_proto_msgTypes[11]
+	mi := &file_pos_pos_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1424,7 +2028,7 @@ func (x *CreateCartRequest) String() string {
 func (*CreateCartRequest) ProtoMessage() {}
 
 func (x *CreateCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[11]
+	mi := &file_pos_pos_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1437,7 +2041,7 @@ func (x *CreateCartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCartRequest.ProtoReflect.Descriptor instead.
 func (*CreateCartRequest) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{11}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *CreateCartRequest) GetCashierId() int64 {
@@ -1447,6 +2051,27 @@ func (x *CreateCartRequest) GetCashierId() int64 {
 	return 0
 }
 
+func (x *CreateCartRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *CreateCartRequest) GetTaxExempt() bool {
+	if x != nil && x.TaxExempt != nil {
+		return *x.TaxExempt
+	}
+	return false
+}
+
+func (x *CreateCartRequest) GetTaxExemptionReason() string {
+	if x != nil && x.TaxExemptionReason != nil {
+		return *x.TaxExemptionReason
+	}
+	return ""
+}
+
 type CreateCartResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
@@ -1456,7 +2081,7 @@ type CreateCartResponse struct {
 
 func (x *CreateCartResponse) Reset() {
 	*x = CreateCartResponse{}
-	mi := &file_pos_pos_service_proto_msgTypes[12]
+	mi := &file_pos_pos_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1468,7 +2093,7 @@ func (x *CreateCartResponse) String() string {
 func (*CreateCartResponse) ProtoMessage() {}
 
 func (x *CreateCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[12]
+	mi := &file_pos_pos_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1481,7 +2106,7 @@ func (x *CreateCartResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCartResponse.ProtoReflect.Descriptor instead.
 func (*CreateCartResponse) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{12}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CreateCartResponse) GetCart() *Cart {
@@ -1497,13 +2122,20 @@ type AddItemToCartRequest struct {
 	ProductId         int32                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	Quantity          int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
 	ServingEmployeeId *int64                 `protobuf:"varint,4,opt,name=serving_employee_id,json=servingEmployeeId,proto3,oneof" json:"serving_employee_id,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	ExpectedVersion   *int64                 `protobuf:"varint,5,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	// Required when the product is serialized in inventory; quantity must
+	// then be 1. Each serial is its own cart line.
+	SerialNumber *string `protobuf:"bytes,6,opt,name=serial_number,json=serialNumber,proto3,oneof" json:"serial_number,omitempty"`
+	// Overrides serving_employee_id when the sale is shared; split
+	// percentages must sum to 100.
+	ServingEmployeeSplits []*ServingEmployeeSplit `protobuf:"bytes,7,rep,name=serving_employee_splits,json=servingEmployeeSplits,proto3" json:"serving_employee_splits,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *AddItemToCartRequest) Reset() {
 	*x = AddItemToCartRequest{}
-	mi := &file_pos_pos_service_proto_msgTypes[13]
+	mi := &file_pos_pos_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1515,7 +2147,7 @@ func (x *AddItemToCartRequest) String() string {
 func (*AddItemToCartRequest) ProtoMessage() {}
 
 func (x *AddItemToCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[13]
+	mi := &file_pos_pos_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1528,7 +2160,7 @@ func (x *AddItemToCartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddItemToCartRequest.ProtoReflect.Descriptor instead.
 func (*AddItemToCartRequest) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{13}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *AddItemToCartRequest) GetCartId() string {
@@ -1559,6 +2191,27 @@ func (x *AddItemToCartRequest) GetServingEmployeeId() int64 {
 	return 0
 }
 
+func (x *AddItemToCartRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *AddItemToCartRequest) GetSerialNumber() string {
+	if x != nil && x.SerialNumber != nil {
+		return *x.SerialNumber
+	}
+	return ""
+}
+
+func (x *AddItemToCartRequest) GetServingEmployeeSplits() []*ServingEmployeeSplit {
+	if x != nil {
+		return x.ServingEmployeeSplits
+	}
+	return nil
+}
+
 type AddItemToCartResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
@@ -1568,7 +2221,7 @@ type AddItemToCartResponse struct {
 
 func (x *AddItemToCartResponse) Reset() {
 	*x = AddItemToCartResponse{}
-	mi := &file_pos_pos_service_proto_msgTypes[14]
+	mi := &file_pos_pos_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1580,7 +2233,7 @@ func (x *AddItemToCartResponse) String() string {
 func (*AddItemToCartResponse) ProtoMessage() {}
 
 func (x *AddItemToCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[14]
+	mi := &file_pos_pos_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1593,7 +2246,7 @@ func (x *AddItemToCartResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddItemToCartResponse.ProtoReflect.Descriptor instead.
 func (*AddItemToCartResponse) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{14}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *AddItemToCartResponse) GetCart() *Cart {
@@ -1604,16 +2257,17 @@ func (x *AddItemToCartResponse) GetCart() *Cart {
 }
 
 type RemoveItemFromCartRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CartId        string                 `protobuf:"bytes,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
-	ItemId        string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CartId          string                 `protobuf:"bytes,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
+	ItemId          string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ExpectedVersion *int64                 `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *RemoveItemFromCartRequest) Reset() {
 	*x = RemoveItemFromCartRequest{}
-	mi := &file_pos_pos_service_proto_msgTypes[15]
+	mi := &file_pos_pos_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1625,7 +2279,7 @@ func (x *RemoveItemFromCartRequest) String() string {
 func (*RemoveItemFromCartRequest) ProtoMessage() {}
 
 func (x *RemoveItemFromCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[15]
+	mi := &file_pos_pos_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1638,7 +2292,7 @@ func (x *RemoveItemFromCartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveItemFromCartRequest.ProtoReflect.Descriptor instead.
 func (*RemoveItemFromCartRequest) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{15}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *RemoveItemFromCartRequest) GetCartId() string {
@@ -1655,6 +2309,13 @@ func (x *RemoveItemFromCartRequest) GetItemId() string {
 	return ""
 }
 
+func (x *RemoveItemFromCartRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
 type RemoveItemFromCartResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
@@ -1664,7 +2325,7 @@ type RemoveItemFromCartResponse struct {
 
 func (x *RemoveItemFromCartResponse) Reset() {
 	*x = RemoveItemFromCartResponse{}
-	mi := &file_pos_pos_service_proto_msgTypes[16]
+	mi := &file_pos_pos_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1676,7 +2337,7 @@ func (x *RemoveItemFromCartResponse) String() string {
 func (*RemoveItemFromCartResponse) ProtoMessage() {}
 
 func (x *RemoveItemFromCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[16]
+	mi := &file_pos_pos_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1689,7 +2350,7 @@ func (x *RemoveItemFromCartResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveItemFromCartResponse.ProtoReflect.Descriptor instead.
 func (*RemoveItemFromCartResponse) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{16}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *RemoveItemFromCartResponse) GetCart() *Cart {
@@ -1700,17 +2361,18 @@ func (x *RemoveItemFromCartResponse) GetCart() *Cart {
 }
 
 type ApplyDiscountRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CartId        string                 `protobuf:"bytes,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
-	DiscountId    int32                  `protobuf:"varint,2,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
-	ItemIds       []string               `protobuf:"bytes,3,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CartId          string                 `protobuf:"bytes,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
+	DiscountId      int32                  `protobuf:"varint,2,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
+	ItemIds         []string               `protobuf:"bytes,3,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+	ExpectedVersion *int64                 `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ApplyDiscountRequest) Reset() {
 	*x = ApplyDiscountRequest{}
-	mi := &file_pos_pos_service_proto_msgTypes[17]
+	mi := &file_pos_pos_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1722,7 +2384,7 @@ func (x *ApplyDiscountRequest) String() string {
 func (*ApplyDiscountRequest) ProtoMessage() {}
 
 func (x *ApplyDiscountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[17]
+	mi := &file_pos_pos_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1735,7 +2397,7 @@ func (x *ApplyDiscountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyDiscountRequest.ProtoReflect.Descriptor instead.
 func (*ApplyDiscountRequest) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{17}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ApplyDiscountRequest) GetCartId() string {
@@ -1759,6 +2421,13 @@ func (x *ApplyDiscountRequest) GetItemIds() []string {
 	return nil
 }
 
+func (x *ApplyDiscountRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
 type ApplyDiscountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
@@ -1768,7 +2437,7 @@ type ApplyDiscountResponse struct {
 
 func (x *ApplyDiscountResponse) Reset() {
 	*x = ApplyDiscountResponse{}
-	mi := &file_pos_pos_service_proto_msgTypes[18]
+	mi := &file_pos_pos_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1780,7 +2449,7 @@ func (x *ApplyDiscountResponse) String() string {
 func (*ApplyDiscountResponse) ProtoMessage() {}
 
 func (x *ApplyDiscountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[18]
+	mi := &file_pos_pos_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1793,7 +2462,7 @@ func (x *ApplyDiscountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyDiscountResponse.ProtoReflect.Descriptor instead.
 func (*ApplyDiscountResponse) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{18}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ApplyDiscountResponse) GetCart() *Cart {
@@ -1812,7 +2481,7 @@ type GetCartRequest struct {
 
 func (x *GetCartRequest) Reset() {
 	*x = GetCartRequest{}
-	mi := &file_pos_pos_service_proto_msgTypes[19]
+	mi := &file_pos_pos_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1824,7 +2493,7 @@ func (x *GetCartRequest) String() string {
 func (*GetCartRequest) ProtoMessage() {}
 
 func (x *GetCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[19]
+	mi := &file_pos_pos_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1837,7 +2506,7 @@ func (x *GetCartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCartRequest.ProtoReflect.Descriptor instead.
 func (*GetCartRequest) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{19}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetCartRequest) GetCartId() string {
@@ -1856,7 +2525,7 @@ type GetCartResponse struct {
 
 func (x *GetCartResponse) Reset() {
 	*x = GetCartResponse{}
-	mi := &file_pos_pos_service_proto_msgTypes[20]
+	mi := &file_pos_pos_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1868,7 +2537,7 @@ func (x *GetCartResponse) String() string {
 func (*GetCartResponse) ProtoMessage() {}
 
 func (x *GetCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[20]
+	mi := &file_pos_pos_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1881,7 +2550,7 @@ func (x *GetCartResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCartResponse.ProtoReflect.Descriptor instead.
 func (*GetCartResponse) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{20}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetCartResponse) GetCart() *Cart {
@@ -1904,7 +2573,7 @@ type CreateOrderFromCartRequest struct {
 
 func (x *CreateOrderFromCartRequest) Reset() {
 	*x = CreateOrderFromCartRequest{}
-	mi := &file_pos_pos_service_proto_msgTypes[21]
+	mi := &file_pos_pos_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1916,7 +2585,7 @@ func (x *CreateOrderFromCartRequest) String() string {
 func (*CreateOrderFromCartRequest) ProtoMessage() {}
 
 func (x *CreateOrderFromCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[21]
+	mi := &file_pos_pos_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1929,7 +2598,7 @@ func (x *CreateOrderFromCartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOrderFromCartRequest.ProtoReflect.Descriptor instead.
 func (*CreateOrderFromCartRequest) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{21}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *CreateOrderFromCartRequest) GetCartId() string {
@@ -1969,7 +2638,7 @@ type CreateOrderFromCartResponse struct {
 
 func (x *CreateOrderFromCartResponse) Reset() {
 	*x = CreateOrderFromCartResponse{}
-	mi := &file_pos_pos_service_proto_msgTypes[22]
+	mi := &file_pos_pos_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1981,7 +2650,7 @@ func (x *CreateOrderFromCartResponse) String() string {
 func (*CreateOrderFromCartResponse) ProtoMessage() {}
 
 func (x *CreateOrderFromCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[22]
+	mi := &file_pos_pos_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1994,7 +2663,7 @@ func (x *CreateOrderFromCartResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOrderFromCartResponse.ProtoReflect.Descriptor instead.
 func (*CreateOrderFromCartResponse) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{22}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *CreateOrderFromCartResponse) GetOrderDocument() *OrderDocument {
@@ -2012,13 +2681,17 @@ type CreateOrderRequest struct {
 	OrderItems     []*CreateOrderItemRequest `protobuf:"bytes,4,rep,name=order_items,json=orderItems,proto3" json:"order_items,omitempty"`
 	AdditionalInfo *string                   `protobuf:"bytes,5,opt,name=additional_info,json=additionalInfo,proto3,oneof" json:"additional_info,omitempty"`
 	Notes          *string                   `protobuf:"bytes,6,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Customer-level exemption; no tax is charged on any line.
+	TaxExempt          *bool   `protobuf:"varint,7,opt,name=tax_exempt,json=taxExempt,proto3,oneof" json:"tax_exempt,omitempty"`
+	Currency           *string `protobuf:"bytes,8,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	TaxExemptionReason *string `protobuf:"bytes,9,opt,name=tax_exemption_reason,json=taxExemptionReason,proto3,oneof" json:"tax_exemption_reason,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *CreateOrderRequest) Reset() {
 	*x = CreateOrderRequest{}
-	mi := &file_pos_pos_service_proto_msgTypes[23]
+	mi := &file_pos_pos_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2030,7 +2703,7 @@ func (x *CreateOrderRequest) String() string {
 func (*CreateOrderRequest) ProtoMessage() {}
 
 func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[23]
+	mi := &file_pos_pos_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2043,7 +2716,7 @@ func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
 func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{23}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *CreateOrderRequest) GetDocumentNumber() string {
@@ -2088,19 +2761,46 @@ func (x *CreateOrderRequest) GetNotes() string {
 	return ""
 }
 
+func (x *CreateOrderRequest) GetTaxExempt() bool {
+	if x != nil && x.TaxExempt != nil {
+		return *x.TaxExempt
+	}
+	return false
+}
+
+func (x *CreateOrderRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetTaxExemptionReason() string {
+	if x != nil && x.TaxExemptionReason != nil {
+		return *x.TaxExemptionReason
+	}
+	return ""
+}
+
 type CreateOrderItemRequest struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	ProductId         int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	ServingEmployeeId *int64                 `protobuf:"varint,2,opt,name=serving_employee_id,json=servingEmployeeId,proto3,oneof" json:"serving_employee_id,omitempty"`
 	Quantity          int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
 	DiscountId        *int32                 `protobuf:"varint,4,opt,name=discount_id,json=discountId,proto3,oneof" json:"discount_id,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Overrides serving_employee_id when the sale is shared; split
+	// percentages must sum to 100.
+	ServingEmployeeSplits []*ServingEmployeeSplit `protobuf:"bytes,5,rep,name=serving_employee_splits,json=servingEmployeeSplits,proto3" json:"serving_employee_splits,omitempty"`
+	// Required when the product is serialized in inventory; quantity must
+	// then be 1.
+	SerialNumber  *string `protobuf:"bytes,6,opt,name=serial_number,json=serialNumber,proto3,oneof" json:"serial_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateOrderItemRequest) Reset() {
 	*x = CreateOrderItemRequest{}
-	mi := &file_pos_pos_service_proto_msgTypes[24]
+	mi := &file_pos_pos_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2112,7 +2812,7 @@ func (x *CreateOrderItemRequest) String() string {
 func (*CreateOrderItemRequest) ProtoMessage() {}
 
 func (x *CreateOrderItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[24]
+	mi := &file_pos_pos_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2125,7 +2825,7 @@ func (x *CreateOrderItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOrderItemRequest.ProtoReflect.Descriptor instead.
 func (*CreateOrderItemRequest) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{24}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *CreateOrderItemRequest) GetProductId() int32 {
@@ -2156,6 +2856,20 @@ func (x *CreateOrderItemRequest) GetDiscountId() int32 {
 	return 0
 }
 
+func (x *CreateOrderItemRequest) GetServingEmployeeSplits() []*ServingEmployeeSplit {
+	if x != nil {
+		return x.ServingEmployeeSplits
+	}
+	return nil
+}
+
+func (x *CreateOrderItemRequest) GetSerialNumber() string {
+	if x != nil && x.SerialNumber != nil {
+		return *x.SerialNumber
+	}
+	return ""
+}
+
 type CreateOrderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderDocument *OrderDocument         `protobuf:"bytes,1,opt,name=order_document,json=orderDocument,proto3" json:"order_document,omitempty"`
@@ -2165,7 +2879,7 @@ type CreateOrderResponse struct {
 
 func (x *CreateOrderResponse) Reset() {
 	*x = CreateOrderResponse{}
-	mi := &file_pos_pos_service_proto_msgTypes[25]
+	mi := &file_pos_pos_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2177,7 +2891,7 @@ func (x *CreateOrderResponse) String() string {
 func (*CreateOrderResponse) ProtoMessage() {}
 
 func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[25]
+	mi := &file_pos_pos_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2190,7 +2904,7 @@ func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOrderResponse.ProtoReflect.Descriptor instead.
 func (*CreateOrderResponse) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{25}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *CreateOrderResponse) GetOrderDocument() *OrderDocument {
@@ -2209,7 +2923,7 @@ type GetOrderRequest struct {
 
 func (x *GetOrderRequest) Reset() {
 	*x = GetOrderRequest{}
-	mi := &file_pos_pos_service_proto_msgTypes[26]
+	mi := &file_pos_pos_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2221,7 +2935,7 @@ func (x *GetOrderRequest) String() string {
 func (*GetOrderRequest) ProtoMessage() {}
 
 func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[26]
+	mi := &file_pos_pos_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2234,7 +2948,7 @@ func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
 func (*GetOrderRequest) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{26}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GetOrderRequest) GetId() int64 {
@@ -2253,7 +2967,7 @@ type GetOrderResponse struct {
 
 func (x *GetOrderResponse) Reset() {
 	*x = GetOrderResponse{}
-	mi := &file_pos_pos_service_proto_msgTypes[27]
+	mi := &file_pos_pos_service_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2265,7 +2979,7 @@ func (x *GetOrderResponse) String() string {
 func (*GetOrderResponse) ProtoMessage() {}
 
 func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[27]
+	mi := &file_pos_pos_service_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2278,7 +2992,7 @@ func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderResponse.ProtoReflect.Descriptor instead.
 func (*GetOrderResponse) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{27}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetOrderResponse) GetOrderDocument() *OrderDocument {
@@ -2288,32 +3002,29 @@ func (x *GetOrderResponse) GetOrderDocument() *OrderDocument {
 	return nil
 }
 
-type ListOrdersRequest struct {
+// Lightweight status for polling; no preloads.
+type GetOrderStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pagination    *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	CashierId     *int64                 `protobuf:"varint,2,opt,name=cashier_id,json=cashierId,proto3,oneof" json:"cashier_id,omitempty"`
-	DocumentType  *DocumentType          `protobuf:"varint,3,opt,name=document_type,json=documentType,proto3,enum=pos.DocumentType,oneof" json:"document_type,omitempty"`
-	PaidStatus    *PaidStatus            `protobuf:"varint,4,opt,name=paid_status,json=paidStatus,proto3,enum=pos.PaidStatus,oneof" json:"paid_status,omitempty"`
-	DateRange     *DateRange             `protobuf:"bytes,5,opt,name=date_range,json=dateRange,proto3,oneof" json:"date_range,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOrdersRequest) Reset() {
-	*x = ListOrdersRequest{}
-	mi := &file_pos_pos_service_proto_msgTypes[28]
+func (x *GetOrderStatusRequest) Reset() {
+	*x = GetOrderStatusRequest{}
+	mi := &file_pos_pos_service_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrdersRequest) String() string {
+func (x *GetOrderStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrdersRequest) ProtoMessage() {}
+func (*GetOrderStatusRequest) ProtoMessage() {}
 
-func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[28]
+func (x *GetOrderStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2324,69 +3035,116 @@ func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
-func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use GetOrderStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderStatusRequest) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ListOrdersRequest) GetPagination() *PaginationRequest {
+func (x *GetOrderStatusRequest) GetId() int64 {
 	if x != nil {
-		return x.Pagination
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *ListOrdersRequest) GetCashierId() int64 {
-	if x != nil && x.CashierId != nil {
-		return *x.CashierId
+type GetOrderStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	PaidStatus    PaidStatus             `protobuf:"varint,2,opt,name=paid_status,json=paidStatus,proto3,enum=pos.PaidStatus" json:"paid_status,omitempty"`
+	DocumentType  DocumentType           `protobuf:"varint,3,opt,name=document_type,json=documentType,proto3,enum=pos.DocumentType" json:"document_type,omitempty"`
+	TotalAmount   string                 `protobuf:"bytes,4,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderStatusResponse) Reset() {
+	*x = GetOrderStatusResponse{}
+	mi := &file_pos_pos_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderStatusResponse) ProtoMessage() {}
+
+func (x *GetOrderStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderStatusResponse) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetOrderStatusResponse) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
 	}
 	return 0
 }
 
-func (x *ListOrdersRequest) GetDocumentType() DocumentType {
-	if x != nil && x.DocumentType != nil {
-		return *x.DocumentType
+func (x *GetOrderStatusResponse) GetPaidStatus() PaidStatus {
+	if x != nil {
+		return x.PaidStatus
+	}
+	return PaidStatus_PAID_STATUS_UNSPECIFIED
+}
+
+func (x *GetOrderStatusResponse) GetDocumentType() DocumentType {
+	if x != nil {
+		return x.DocumentType
 	}
 	return DocumentType_DOCUMENT_TYPE_UNSPECIFIED
 }
 
-func (x *ListOrdersRequest) GetPaidStatus() PaidStatus {
-	if x != nil && x.PaidStatus != nil {
-		return *x.PaidStatus
+func (x *GetOrderStatusResponse) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
 	}
-	return PaidStatus_PAID_STATUS_UNSPECIFIED
+	return ""
 }
 
-func (x *ListOrdersRequest) GetDateRange() *DateRange {
+func (x *GetOrderStatusResponse) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.DateRange
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-type ListOrdersResponse struct {
+type GetOrderByDocumentNumberRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrderDocuments []*OrderDocument       `protobuf:"bytes,1,rep,name=order_documents,json=orderDocuments,proto3" json:"order_documents,omitempty"`
-	Pagination     *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	DocumentNumber string                 `protobuf:"bytes,1,opt,name=document_number,json=documentNumber,proto3" json:"document_number,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListOrdersResponse) Reset() {
-	*x = ListOrdersResponse{}
-	mi := &file_pos_pos_service_proto_msgTypes[29]
+func (x *GetOrderByDocumentNumberRequest) Reset() {
+	*x = GetOrderByDocumentNumberRequest{}
+	mi := &file_pos_pos_service_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrdersResponse) String() string {
+func (x *GetOrderByDocumentNumberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrdersResponse) ProtoMessage() {}
+func (*GetOrderByDocumentNumberRequest) ProtoMessage() {}
 
-func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[29]
+func (x *GetOrderByDocumentNumberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2397,51 +3155,91 @@ func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
-func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use GetOrderByDocumentNumberRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderByDocumentNumberRequest) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ListOrdersResponse) GetOrderDocuments() []*OrderDocument {
+func (x *GetOrderByDocumentNumberRequest) GetDocumentNumber() string {
 	if x != nil {
-		return x.OrderDocuments
+		return x.DocumentNumber
 	}
-	return nil
+	return ""
 }
 
-func (x *ListOrdersResponse) GetPagination() *PaginationResponse {
-	if x != nil {
-		return x.Pagination
-	}
-	return nil
+type GetOrderByDocumentNumberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderDocument *OrderDocument         `protobuf:"bytes,1,opt,name=order_document,json=orderDocument,proto3" json:"order_document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-// Payment Operations
-type ProcessPaymentRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	OrderId         int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	PaidAmount      string                 `protobuf:"bytes,2,opt,name=paid_amount,json=paidAmount,proto3" json:"paid_amount,omitempty"`
-	PaymentTypeId   int32                  `protobuf:"varint,3,opt,name=payment_type_id,json=paymentTypeId,proto3" json:"payment_type_id,omitempty"`
-	ReferenceNumber *string                `protobuf:"bytes,4,opt,name=reference_number,json=referenceNumber,proto3,oneof" json:"reference_number,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *GetOrderByDocumentNumberResponse) Reset() {
+	*x = GetOrderByDocumentNumberResponse{}
+	mi := &file_pos_pos_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessPaymentRequest) Reset() {
-	*x = ProcessPaymentRequest{}
-	mi := &file_pos_pos_service_proto_msgTypes[30]
+func (x *GetOrderByDocumentNumberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderByDocumentNumberResponse) ProtoMessage() {}
+
+func (x *GetOrderByDocumentNumberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderByDocumentNumberResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderByDocumentNumberResponse) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetOrderByDocumentNumberResponse) GetOrderDocument() *OrderDocument {
+	if x != nil {
+		return x.OrderDocument
+	}
+	return nil
+}
+
+// page_token is an opaque cursor over (created_at, id); total_count is
+// only filled when include_total_count is set.
+type ListOrdersRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Pagination        *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	CashierId         *int64                 `protobuf:"varint,2,opt,name=cashier_id,json=cashierId,proto3,oneof" json:"cashier_id,omitempty"`
+	DocumentType      *DocumentType          `protobuf:"varint,3,opt,name=document_type,json=documentType,proto3,enum=pos.DocumentType,oneof" json:"document_type,omitempty"`
+	PaidStatus        *PaidStatus            `protobuf:"varint,4,opt,name=paid_status,json=paidStatus,proto3,enum=pos.PaidStatus,oneof" json:"paid_status,omitempty"`
+	DateRange         *DateRange             `protobuf:"bytes,5,opt,name=date_range,json=dateRange,proto3,oneof" json:"date_range,omitempty"`
+	IncludeTotalCount *bool                  `protobuf:"varint,6,opt,name=include_total_count,json=includeTotalCount,proto3,oneof" json:"include_total_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
+	mi := &file_pos_pos_service_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessPaymentRequest) String() string {
+func (x *ListOrdersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessPaymentRequest) ProtoMessage() {}
+func (*ListOrdersRequest) ProtoMessage() {}
 
-func (x *ProcessPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[30]
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2452,62 +3250,2249 @@ func (x *ProcessPaymentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessPaymentRequest.ProtoReflect.Descriptor instead.
-func (*ProcessPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *ProcessPaymentRequest) GetOrderId() int64 {
+func (x *ListOrdersRequest) GetPagination() *PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListOrdersRequest) GetCashierId() int64 {
+	if x != nil && x.CashierId != nil {
+		return *x.CashierId
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetDocumentType() DocumentType {
+	if x != nil && x.DocumentType != nil {
+		return *x.DocumentType
+	}
+	return DocumentType_DOCUMENT_TYPE_UNSPECIFIED
+}
+
+func (x *ListOrdersRequest) GetPaidStatus() PaidStatus {
+	if x != nil && x.PaidStatus != nil {
+		return *x.PaidStatus
+	}
+	return PaidStatus_PAID_STATUS_UNSPECIFIED
+}
+
+func (x *ListOrdersRequest) GetDateRange() *DateRange {
+	if x != nil {
+		return x.DateRange
+	}
+	return nil
+}
+
+func (x *ListOrdersRequest) GetIncludeTotalCount() bool {
+	if x != nil && x.IncludeTotalCount != nil {
+		return *x.IncludeTotalCount
+	}
+	return false
+}
+
+type ListOrdersResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderDocuments []*OrderDocument       `protobuf:"bytes,1,rep,name=order_documents,json=orderDocuments,proto3" json:"order_documents,omitempty"`
+	Pagination     *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListOrdersResponse) Reset() {
+	*x = ListOrdersResponse{}
+	mi := &file_pos_pos_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersResponse) ProtoMessage() {}
+
+func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListOrdersResponse) GetOrderDocuments() []*OrderDocument {
+	if x != nil {
+		return x.OrderDocuments
+	}
+	return nil
+}
+
+func (x *ListOrdersResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// Mirrors the JSON published on the pos:events Redis channel.
+type OrderEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// order.created, order.updated or order.paid
+	EventType string `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// Bumped on incompatible payload changes; consumers skip events whose
+	// version they do not know.
+	SchemaVersion  int32                  `protobuf:"varint,2,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	OrderId        int64                  `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	DocumentNumber string                 `protobuf:"bytes,4,opt,name=document_number,json=documentNumber,proto3" json:"document_number,omitempty"`
+	CashierId      int64                  `protobuf:"varint,5,opt,name=cashier_id,json=cashierId,proto3" json:"cashier_id,omitempty"`
+	PaidStatus     PaidStatus             `protobuf:"varint,6,opt,name=paid_status,json=paidStatus,proto3,enum=pos.PaidStatus" json:"paid_status,omitempty"`
+	TotalAmount    string                 `protobuf:"bytes,7,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	OccurredAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OrderEvent) Reset() {
+	*x = OrderEvent{}
+	mi := &file_pos_pos_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderEvent) ProtoMessage() {}
+
+func (x *OrderEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderEvent.ProtoReflect.Descriptor instead.
+func (*OrderEvent) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *OrderEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetSchemaVersion() int32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *OrderEvent) GetOrderId() int64 {
 	if x != nil {
 		return x.OrderId
 	}
 	return 0
 }
 
-func (x *ProcessPaymentRequest) GetPaidAmount() string {
+func (x *OrderEvent) GetDocumentNumber() string {
+	if x != nil {
+		return x.DocumentNumber
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetCashierId() int64 {
+	if x != nil {
+		return x.CashierId
+	}
+	return 0
+}
+
+func (x *OrderEvent) GetPaidStatus() PaidStatus {
+	if x != nil {
+		return x.PaidStatus
+	}
+	return PaidStatus_PAID_STATUS_UNSPECIFIED
+}
+
+func (x *OrderEvent) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type StreamOrderEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CashierId     *int64                 `protobuf:"varint,1,opt,name=cashier_id,json=cashierId,proto3,oneof" json:"cashier_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamOrderEventsRequest) Reset() {
+	*x = StreamOrderEventsRequest{}
+	mi := &file_pos_pos_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamOrderEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamOrderEventsRequest) ProtoMessage() {}
+
+func (x *StreamOrderEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamOrderEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamOrderEventsRequest) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *StreamOrderEventsRequest) GetCashierId() int64 {
+	if x != nil && x.CashierId != nil {
+		return *x.CashierId
+	}
+	return 0
+}
+
+// Payment Operations
+type StoreCredit struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CustomerId *int64                 `protobuf:"varint,2,opt,name=customer_id,json=customerId,proto3,oneof" json:"customer_id,omitempty"`
+	IssuedBy   int64                  `protobuf:"varint,3,opt,name=issued_by,json=issuedBy,proto3" json:"issued_by,omitempty"`
+	OrderId    int64                  `protobuf:"varint,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Amount     string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Balance    string                 `protobuf:"bytes,6,opt,name=balance,proto3" json:"balance,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Currency of the issuing order; redeemable only against orders in it.
+	Currency      string `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoreCredit) Reset() {
+	*x = StoreCredit{}
+	mi := &file_pos_pos_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreCredit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreCredit) ProtoMessage() {}
+
+func (x *StoreCredit) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreCredit.ProtoReflect.Descriptor instead.
+func (*StoreCredit) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *StoreCredit) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StoreCredit) GetCustomerId() int64 {
+	if x != nil && x.CustomerId != nil {
+		return *x.CustomerId
+	}
+	return 0
+}
+
+func (x *StoreCredit) GetIssuedBy() int64 {
+	if x != nil {
+		return x.IssuedBy
+	}
+	return 0
+}
+
+func (x *StoreCredit) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *StoreCredit) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *StoreCredit) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *StoreCredit) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *StoreCredit) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ProcessPaymentRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// In the order's currency.
+	PaidAmount      string  `protobuf:"bytes,2,opt,name=paid_amount,json=paidAmount,proto3" json:"paid_amount,omitempty"`
+	PaymentTypeId   int32   `protobuf:"varint,3,opt,name=payment_type_id,json=paymentTypeId,proto3" json:"payment_type_id,omitempty"`
+	ReferenceNumber *string `protobuf:"bytes,4,opt,name=reference_number,json=referenceNumber,proto3,oneof" json:"reference_number,omitempty"`
+	// Issue the overpayment as store credit instead of cash change.
+	ChangeAsStoreCredit *bool  `protobuf:"varint,5,opt,name=change_as_store_credit,json=changeAsStoreCredit,proto3,oneof" json:"change_as_store_credit,omitempty"`
+	CustomerId          *int64 `protobuf:"varint,6,opt,name=customer_id,json=customerId,proto3,oneof" json:"customer_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ProcessPaymentRequest) Reset() {
+	*x = ProcessPaymentRequest{}
+	mi := &file_pos_pos_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessPaymentRequest) ProtoMessage() {}
+
+func (x *ProcessPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessPaymentRequest.ProtoReflect.Descriptor instead.
+func (*ProcessPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ProcessPaymentRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *ProcessPaymentRequest) GetPaidAmount() string {
+	if x != nil {
+		return x.PaidAmount
+	}
+	return ""
+}
+
+func (x *ProcessPaymentRequest) GetPaymentTypeId() int32 {
+	if x != nil {
+		return x.PaymentTypeId
+	}
+	return 0
+}
+
+func (x *ProcessPaymentRequest) GetReferenceNumber() string {
+	if x != nil && x.ReferenceNumber != nil {
+		return *x.ReferenceNumber
+	}
+	return ""
+}
+
+func (x *ProcessPaymentRequest) GetChangeAsStoreCredit() bool {
+	if x != nil && x.ChangeAsStoreCredit != nil {
+		return *x.ChangeAsStoreCredit
+	}
+	return false
+}
+
+func (x *ProcessPaymentRequest) GetCustomerId() int64 {
+	if x != nil && x.CustomerId != nil {
+		return *x.CustomerId
+	}
+	return 0
+}
+
+type ProcessPaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderDocument *OrderDocument         `protobuf:"bytes,1,opt,name=order_document,json=orderDocument,proto3" json:"order_document,omitempty"`
+	ChangeAmount  string                 `protobuf:"bytes,2,opt,name=change_amount,json=changeAmount,proto3" json:"change_amount,omitempty"`
+	StoreCredit   *StoreCredit           `protobuf:"bytes,3,opt,name=store_credit,json=storeCredit,proto3,oneof" json:"store_credit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessPaymentResponse) Reset() {
+	*x = ProcessPaymentResponse{}
+	mi := &file_pos_pos_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessPaymentResponse) ProtoMessage() {}
+
+func (x *ProcessPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessPaymentResponse.ProtoReflect.Descriptor instead.
+func (*ProcessPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ProcessPaymentResponse) GetOrderDocument() *OrderDocument {
+	if x != nil {
+		return x.OrderDocument
+	}
+	return nil
+}
+
+func (x *ProcessPaymentResponse) GetChangeAmount() string {
+	if x != nil {
+		return x.ChangeAmount
+	}
+	return ""
+}
+
+func (x *ProcessPaymentResponse) GetStoreCredit() *StoreCredit {
+	if x != nil {
+		return x.StoreCredit
+	}
+	return nil
+}
+
+// Order Modifications
+type VoidOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	VoidedBy      int64                  `protobuf:"varint,2,opt,name=voided_by,json=voidedBy,proto3" json:"voided_by,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoidOrderRequest) Reset() {
+	*x = VoidOrderRequest{}
+	mi := &file_pos_pos_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidOrderRequest) ProtoMessage() {}
+
+func (x *VoidOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidOrderRequest.ProtoReflect.Descriptor instead.
+func (*VoidOrderRequest) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *VoidOrderRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VoidOrderRequest) GetVoidedBy() int64 {
+	if x != nil {
+		return x.VoidedBy
+	}
+	return 0
+}
+
+func (x *VoidOrderRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type VoidOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderDocument *OrderDocument         `protobuf:"bytes,1,opt,name=order_document,json=orderDocument,proto3" json:"order_document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoidOrderResponse) Reset() {
+	*x = VoidOrderResponse{}
+	mi := &file_pos_pos_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidOrderResponse) ProtoMessage() {}
+
+func (x *VoidOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidOrderResponse.ProtoReflect.Descriptor instead.
+func (*VoidOrderResponse) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *VoidOrderResponse) GetOrderDocument() *OrderDocument {
+	if x != nil {
+		return x.OrderDocument
+	}
+	return nil
+}
+
+type ReturnOrderRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OriginalOrderId int64                  `protobuf:"varint,1,opt,name=original_order_id,json=originalOrderId,proto3" json:"original_order_id,omitempty"`
+	ItemIds         []int64                `protobuf:"varint,2,rep,packed,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+	ProcessedBy     int64                  `protobuf:"varint,3,opt,name=processed_by,json=processedBy,proto3" json:"processed_by,omitempty"`
+	Reason          *string                `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	// Manager authorizing a return outside the configured return window.
+	OverrideAuthorizedBy *int64 `protobuf:"varint,5,opt,name=override_authorized_by,json=overrideAuthorizedBy,proto3,oneof" json:"override_authorized_by,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ReturnOrderRequest) Reset() {
+	*x = ReturnOrderRequest{}
+	mi := &file_pos_pos_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnOrderRequest) ProtoMessage() {}
+
+func (x *ReturnOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnOrderRequest.ProtoReflect.Descriptor instead.
+func (*ReturnOrderRequest) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ReturnOrderRequest) GetOriginalOrderId() int64 {
+	if x != nil {
+		return x.OriginalOrderId
+	}
+	return 0
+}
+
+func (x *ReturnOrderRequest) GetItemIds() []int64 {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
+}
+
+func (x *ReturnOrderRequest) GetProcessedBy() int64 {
+	if x != nil {
+		return x.ProcessedBy
+	}
+	return 0
+}
+
+func (x *ReturnOrderRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *ReturnOrderRequest) GetOverrideAuthorizedBy() int64 {
+	if x != nil && x.OverrideAuthorizedBy != nil {
+		return *x.OverrideAuthorizedBy
+	}
+	return 0
+}
+
+type ReturnOrderResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ReturnDocument *OrderDocument         `protobuf:"bytes,1,opt,name=return_document,json=returnDocument,proto3" json:"return_document,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReturnOrderResponse) Reset() {
+	*x = ReturnOrderResponse{}
+	mi := &file_pos_pos_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnOrderResponse) ProtoMessage() {}
+
+func (x *ReturnOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnOrderResponse.ProtoReflect.Descriptor instead.
+func (*ReturnOrderResponse) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ReturnOrderResponse) GetReturnDocument() *OrderDocument {
+	if x != nil {
+		return x.ReturnDocument
+	}
+	return nil
+}
+
+// Rows are sorted by net sales, highest first. Voided documents are
+// excluded; adjust_for_returns nets out RETURN documents.
+type GetSalesByProductRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DateRange        *DateRange             `protobuf:"bytes,1,opt,name=date_range,json=dateRange,proto3" json:"date_range,omitempty"`
+	Pagination       *PaginationRequest     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	AdjustForReturns *bool                  `protobuf:"varint,3,opt,name=adjust_for_returns,json=adjustForReturns,proto3,oneof" json:"adjust_for_returns,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetSalesByProductRequest) Reset() {
+	*x = GetSalesByProductRequest{}
+	mi := &file_pos_pos_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSalesByProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSalesByProductRequest) ProtoMessage() {}
+
+func (x *GetSalesByProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSalesByProductRequest.ProtoReflect.Descriptor instead.
+func (*GetSalesByProductRequest) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetSalesByProductRequest) GetDateRange() *DateRange {
+	if x != nil {
+		return x.DateRange
+	}
+	return nil
+}
+
+func (x *GetSalesByProductRequest) GetPagination() *PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetSalesByProductRequest) GetAdjustForReturns() bool {
+	if x != nil && x.AdjustForReturns != nil {
+		return *x.AdjustForReturns
+	}
+	return false
+}
+
+type ProductSales struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProductId      int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductCode    string                 `protobuf:"bytes,2,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`
+	ProductName    string                 `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	QuantitySold   int32                  `protobuf:"varint,4,opt,name=quantity_sold,json=quantitySold,proto3" json:"quantity_sold,omitempty"`
+	GrossSales     string                 `protobuf:"bytes,5,opt,name=gross_sales,json=grossSales,proto3" json:"gross_sales,omitempty"`
+	DiscountAmount string                 `protobuf:"bytes,6,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	NetSales       string                 `protobuf:"bytes,7,opt,name=net_sales,json=netSales,proto3" json:"net_sales,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ProductSales) Reset() {
+	*x = ProductSales{}
+	mi := &file_pos_pos_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductSales) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductSales) ProtoMessage() {}
+
+func (x *ProductSales) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductSales.ProtoReflect.Descriptor instead.
+func (*ProductSales) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ProductSales) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ProductSales) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *ProductSales) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *ProductSales) GetQuantitySold() int32 {
+	if x != nil {
+		return x.QuantitySold
+	}
+	return 0
+}
+
+func (x *ProductSales) GetGrossSales() string {
+	if x != nil {
+		return x.GrossSales
+	}
+	return ""
+}
+
+func (x *ProductSales) GetDiscountAmount() string {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return ""
+}
+
+func (x *ProductSales) GetNetSales() string {
+	if x != nil {
+		return x.NetSales
+	}
+	return ""
+}
+
+type GetSalesByProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductSales  []*ProductSales        `protobuf:"bytes,1,rep,name=product_sales,json=productSales,proto3" json:"product_sales,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSalesByProductResponse) Reset() {
+	*x = GetSalesByProductResponse{}
+	mi := &file_pos_pos_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSalesByProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSalesByProductResponse) ProtoMessage() {}
+
+func (x *GetSalesByProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSalesByProductResponse.ProtoReflect.Descriptor instead.
+func (*GetSalesByProductResponse) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetSalesByProductResponse) GetProductSales() []*ProductSales {
+	if x != nil {
+		return x.ProductSales
+	}
+	return nil
+}
+
+func (x *GetSalesByProductResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetSalesByCashierRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DateRange        *DateRange             `protobuf:"bytes,1,opt,name=date_range,json=dateRange,proto3" json:"date_range,omitempty"`
+	Pagination       *PaginationRequest     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	AdjustForReturns *bool                  `protobuf:"varint,3,opt,name=adjust_for_returns,json=adjustForReturns,proto3,oneof" json:"adjust_for_returns,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetSalesByCashierRequest) Reset() {
+	*x = GetSalesByCashierRequest{}
+	mi := &file_pos_pos_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSalesByCashierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSalesByCashierRequest) ProtoMessage() {}
+
+func (x *GetSalesByCashierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSalesByCashierRequest.ProtoReflect.Descriptor instead.
+func (*GetSalesByCashierRequest) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetSalesByCashierRequest) GetDateRange() *DateRange {
+	if x != nil {
+		return x.DateRange
+	}
+	return nil
+}
+
+func (x *GetSalesByCashierRequest) GetPagination() *PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetSalesByCashierRequest) GetAdjustForReturns() bool {
+	if x != nil && x.AdjustForReturns != nil {
+		return *x.AdjustForReturns
+	}
+	return false
+}
+
+type CashierSales struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CashierId        int64                  `protobuf:"varint,1,opt,name=cashier_id,json=cashierId,proto3" json:"cashier_id,omitempty"`
+	TransactionCount int32                  `protobuf:"varint,2,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	QuantitySold     int32                  `protobuf:"varint,3,opt,name=quantity_sold,json=quantitySold,proto3" json:"quantity_sold,omitempty"`
+	GrossSales       string                 `protobuf:"bytes,4,opt,name=gross_sales,json=grossSales,proto3" json:"gross_sales,omitempty"`
+	DiscountAmount   string                 `protobuf:"bytes,5,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	NetSales         string                 `protobuf:"bytes,6,opt,name=net_sales,json=netSales,proto3" json:"net_sales,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CashierSales) Reset() {
+	*x = CashierSales{}
+	mi := &file_pos_pos_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CashierSales) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CashierSales) ProtoMessage() {}
+
+func (x *CashierSales) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CashierSales.ProtoReflect.Descriptor instead.
+func (*CashierSales) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CashierSales) GetCashierId() int64 {
+	if x != nil {
+		return x.CashierId
+	}
+	return 0
+}
+
+func (x *CashierSales) GetTransactionCount() int32 {
+	if x != nil {
+		return x.TransactionCount
+	}
+	return 0
+}
+
+func (x *CashierSales) GetQuantitySold() int32 {
+	if x != nil {
+		return x.QuantitySold
+	}
+	return 0
+}
+
+func (x *CashierSales) GetGrossSales() string {
+	if x != nil {
+		return x.GrossSales
+	}
+	return ""
+}
+
+func (x *CashierSales) GetDiscountAmount() string {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return ""
+}
+
+func (x *CashierSales) GetNetSales() string {
+	if x != nil {
+		return x.NetSales
+	}
+	return ""
+}
+
+type GetSalesByCashierResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CashierSales  []*CashierSales        `protobuf:"bytes,1,rep,name=cashier_sales,json=cashierSales,proto3" json:"cashier_sales,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSalesByCashierResponse) Reset() {
+	*x = GetSalesByCashierResponse{}
+	mi := &file_pos_pos_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSalesByCashierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSalesByCashierResponse) ProtoMessage() {}
+
+func (x *GetSalesByCashierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSalesByCashierResponse.ProtoReflect.Descriptor instead.
+func (*GetSalesByCashierResponse) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetSalesByCashierResponse) GetCashierSales() []*CashierSales {
+	if x != nil {
+		return x.CashierSales
+	}
+	return nil
+}
+
+func (x *GetSalesByCashierResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// Presentation-agnostic receipt built from the persisted order and its
+// payments; header fields are placeholders filled from store settings.
+type Receipt struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderId        int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	DocumentNumber string                 `protobuf:"bytes,2,opt,name=document_number,json=documentNumber,proto3" json:"document_number,omitempty"`
+	OrdersDate     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=orders_date,json=ordersDate,proto3" json:"orders_date,omitempty"`
+	CashierId      int64                  `protobuf:"varint,4,opt,name=cashier_id,json=cashierId,proto3" json:"cashier_id,omitempty"`
+	StoreName      *string                `protobuf:"bytes,5,opt,name=store_name,json=storeName,proto3,oneof" json:"store_name,omitempty"`
+	StoreAddress   *string                `protobuf:"bytes,6,opt,name=store_address,json=storeAddress,proto3,oneof" json:"store_address,omitempty"`
+	StorePhone     *string                `protobuf:"bytes,7,opt,name=store_phone,json=storePhone,proto3,oneof" json:"store_phone,omitempty"`
+	Lines          []*ReceiptLine         `protobuf:"bytes,8,rep,name=lines,proto3" json:"lines,omitempty"`
+	Subtotal       string                 `protobuf:"bytes,9,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	DiscountLines  []*ReceiptDiscountLine `protobuf:"bytes,10,rep,name=discount_lines,json=discountLines,proto3" json:"discount_lines,omitempty"`
+	TaxAmount      string                 `protobuf:"bytes,11,opt,name=tax_amount,json=taxAmount,proto3" json:"tax_amount,omitempty"`
+	TotalAmount    string                 `protobuf:"bytes,12,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	Tenders        []*ReceiptTender       `protobuf:"bytes,13,rep,name=tenders,proto3" json:"tenders,omitempty"`
+	ChangeAmount   string                 `protobuf:"bytes,14,opt,name=change_amount,json=changeAmount,proto3" json:"change_amount,omitempty"`
+	Notes          *string                `protobuf:"bytes,15,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	TaxLines       []*OrderTaxLine        `protobuf:"bytes,16,rep,name=tax_lines,json=taxLines,proto3" json:"tax_lines,omitempty"`
+	// ISO 4217 code of the order; all amounts above are in it.
+	Currency      string `protobuf:"bytes,17,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Receipt) Reset() {
+	*x = Receipt{}
+	mi := &file_pos_pos_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Receipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Receipt) ProtoMessage() {}
+
+func (x *Receipt) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Receipt.ProtoReflect.Descriptor instead.
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Receipt) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *Receipt) GetDocumentNumber() string {
+	if x != nil {
+		return x.DocumentNumber
+	}
+	return ""
+}
+
+func (x *Receipt) GetOrdersDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OrdersDate
+	}
+	return nil
+}
+
+func (x *Receipt) GetCashierId() int64 {
+	if x != nil {
+		return x.CashierId
+	}
+	return 0
+}
+
+func (x *Receipt) GetStoreName() string {
+	if x != nil && x.StoreName != nil {
+		return *x.StoreName
+	}
+	return ""
+}
+
+func (x *Receipt) GetStoreAddress() string {
+	if x != nil && x.StoreAddress != nil {
+		return *x.StoreAddress
+	}
+	return ""
+}
+
+func (x *Receipt) GetStorePhone() string {
+	if x != nil && x.StorePhone != nil {
+		return *x.StorePhone
+	}
+	return ""
+}
+
+func (x *Receipt) GetLines() []*ReceiptLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *Receipt) GetSubtotal() string {
+	if x != nil {
+		return x.Subtotal
+	}
+	return ""
+}
+
+func (x *Receipt) GetDiscountLines() []*ReceiptDiscountLine {
+	if x != nil {
+		return x.DiscountLines
+	}
+	return nil
+}
+
+func (x *Receipt) GetTaxAmount() string {
+	if x != nil {
+		return x.TaxAmount
+	}
+	return ""
+}
+
+func (x *Receipt) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
+func (x *Receipt) GetTenders() []*ReceiptTender {
+	if x != nil {
+		return x.Tenders
+	}
+	return nil
+}
+
+func (x *Receipt) GetChangeAmount() string {
+	if x != nil {
+		return x.ChangeAmount
+	}
+	return ""
+}
+
+func (x *Receipt) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+func (x *Receipt) GetTaxLines() []*OrderTaxLine {
+	if x != nil {
+		return x.TaxLines
+	}
+	return nil
+}
+
+func (x *Receipt) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ReceiptLine struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProductId      int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName    string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Quantity       int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice      string                 `protobuf:"bytes,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	DiscountAmount string                 `protobuf:"bytes,5,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	LineTotal      string                 `protobuf:"bytes,6,opt,name=line_total,json=lineTotal,proto3" json:"line_total,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReceiptLine) Reset() {
+	*x = ReceiptLine{}
+	mi := &file_pos_pos_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiptLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptLine) ProtoMessage() {}
+
+func (x *ReceiptLine) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptLine.ProtoReflect.Descriptor instead.
+func (*ReceiptLine) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ReceiptLine) GetProductId() int32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ReceiptLine) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *ReceiptLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReceiptLine) GetUnitPrice() string {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return ""
+}
+
+func (x *ReceiptLine) GetDiscountAmount() string {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return ""
+}
+
+func (x *ReceiptLine) GetLineTotal() string {
+	if x != nil {
+		return x.LineTotal
+	}
+	return ""
+}
+
+type ReceiptDiscountLine struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DiscountId     int32                  `protobuf:"varint,1,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
+	DiscountName   string                 `protobuf:"bytes,2,opt,name=discount_name,json=discountName,proto3" json:"discount_name,omitempty"`
+	DiscountAmount string                 `protobuf:"bytes,3,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReceiptDiscountLine) Reset() {
+	*x = ReceiptDiscountLine{}
+	mi := &file_pos_pos_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiptDiscountLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptDiscountLine) ProtoMessage() {}
+
+func (x *ReceiptDiscountLine) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptDiscountLine.ProtoReflect.Descriptor instead.
+func (*ReceiptDiscountLine) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ReceiptDiscountLine) GetDiscountId() int32 {
+	if x != nil {
+		return x.DiscountId
+	}
+	return 0
+}
+
+func (x *ReceiptDiscountLine) GetDiscountName() string {
+	if x != nil {
+		return x.DiscountName
+	}
+	return ""
+}
+
+func (x *ReceiptDiscountLine) GetDiscountAmount() string {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return ""
+}
+
+type ReceiptTender struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PaymentTypeId   int32                  `protobuf:"varint,1,opt,name=payment_type_id,json=paymentTypeId,proto3" json:"payment_type_id,omitempty"`
+	PaymentName     string                 `protobuf:"bytes,2,opt,name=payment_name,json=paymentName,proto3" json:"payment_name,omitempty"`
+	Amount          string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	ReferenceNumber *string                `protobuf:"bytes,4,opt,name=reference_number,json=referenceNumber,proto3,oneof" json:"reference_number,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReceiptTender) Reset() {
+	*x = ReceiptTender{}
+	mi := &file_pos_pos_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiptTender) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptTender) ProtoMessage() {}
+
+func (x *ReceiptTender) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptTender.ProtoReflect.Descriptor instead.
+func (*ReceiptTender) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ReceiptTender) GetPaymentTypeId() int32 {
+	if x != nil {
+		return x.PaymentTypeId
+	}
+	return 0
+}
+
+func (x *ReceiptTender) GetPaymentName() string {
+	if x != nil {
+		return x.PaymentName
+	}
+	return ""
+}
+
+func (x *ReceiptTender) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ReceiptTender) GetReferenceNumber() string {
+	if x != nil && x.ReferenceNumber != nil {
+		return *x.ReferenceNumber
+	}
+	return ""
+}
+
+type GetReceiptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceiptRequest) Reset() {
+	*x = GetReceiptRequest{}
+	mi := &file_pos_pos_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceiptRequest) ProtoMessage() {}
+
+func (x *GetReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceiptRequest.ProtoReflect.Descriptor instead.
+func (*GetReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetReceiptRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type GetReceiptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Receipt       *Receipt               `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceiptResponse) Reset() {
+	*x = GetReceiptResponse{}
+	mi := &file_pos_pos_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceiptResponse) ProtoMessage() {}
+
+func (x *GetReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceiptResponse.ProtoReflect.Descriptor instead.
+func (*GetReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetReceiptResponse) GetReceipt() *Receipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+// Product Operations
+type GetProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductRequest) Reset() {
+	*x = GetProductRequest{}
+	mi := &file_pos_pos_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRequest) ProtoMessage() {}
+
+func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRequest) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetProductRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductResponse) Reset() {
+	*x = GetProductResponse{}
+	mi := &file_pos_pos_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductResponse) ProtoMessage() {}
+
+func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductResponse.ProtoReflect.Descriptor instead.
+func (*GetProductResponse) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// Inventory product and opening stock to create alongside a POS product.
+type InventorySeed struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductTypeId   int32                  `protobuf:"varint,1,opt,name=product_type_id,json=productTypeId,proto3" json:"product_type_id,omitempty"`
+	SupplierId      int32                  `protobuf:"varint,2,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
+	UnitOfMeasure   *string                `protobuf:"bytes,3,opt,name=unit_of_measure,json=unitOfMeasure,proto3,oneof" json:"unit_of_measure,omitempty"`
+	WarehouseId     int32                  `protobuf:"varint,4,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	InitialQuantity int32                  `protobuf:"varint,5,opt,name=initial_quantity,json=initialQuantity,proto3" json:"initial_quantity,omitempty"`
+	CreatedBy       int64                  `protobuf:"varint,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *InventorySeed) Reset() {
+	*x = InventorySeed{}
+	mi := &file_pos_pos_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventorySeed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventorySeed) ProtoMessage() {}
+
+func (x *InventorySeed) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventorySeed.ProtoReflect.Descriptor instead.
+func (*InventorySeed) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *InventorySeed) GetProductTypeId() int32 {
+	if x != nil {
+		return x.ProductTypeId
+	}
+	return 0
+}
+
+func (x *InventorySeed) GetSupplierId() int32 {
+	if x != nil {
+		return x.SupplierId
+	}
+	return 0
+}
+
+func (x *InventorySeed) GetUnitOfMeasure() string {
+	if x != nil && x.UnitOfMeasure != nil {
+		return *x.UnitOfMeasure
+	}
+	return ""
+}
+
+func (x *InventorySeed) GetWarehouseId() int32 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *InventorySeed) GetInitialQuantity() int32 {
+	if x != nil {
+		return x.InitialQuantity
+	}
+	return 0
+}
+
+func (x *InventorySeed) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+type CreateProductRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	ProductCode             string                 `protobuf:"bytes,1,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`
+	ProductName             string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	ProductPrice            string                 `protobuf:"bytes,3,opt,name=product_price,json=productPrice,proto3" json:"product_price,omitempty"`
+	CostPrice               string                 `protobuf:"bytes,4,opt,name=cost_price,json=costPrice,proto3" json:"cost_price,omitempty"`
+	ProductGroupId          *int32                 `protobuf:"varint,5,opt,name=product_group_id,json=productGroupId,proto3,oneof" json:"product_group_id,omitempty"`
+	CommissionEligible      bool                   `protobuf:"varint,6,opt,name=commission_eligible,json=commissionEligible,proto3" json:"commission_eligible,omitempty"`
+	RequiresServiceEmployee bool                   `protobuf:"varint,7,opt,name=requires_service_employee,json=requiresServiceEmployee,proto3" json:"requires_service_employee,omitempty"`
+	TaxExempt               bool                   `protobuf:"varint,8,opt,name=tax_exempt,json=taxExempt,proto3" json:"tax_exempt,omitempty"`
+	InventorySeed           *InventorySeed         `protobuf:"bytes,9,opt,name=inventory_seed,json=inventorySeed,proto3,oneof" json:"inventory_seed,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *CreateProductRequest) Reset() {
+	*x = CreateProductRequest{}
+	mi := &file_pos_pos_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductRequest) ProtoMessage() {}
+
+func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductRequest) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CreateProductRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetProductPrice() string {
+	if x != nil {
+		return x.ProductPrice
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetCostPrice() string {
+	if x != nil {
+		return x.CostPrice
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetProductGroupId() int32 {
+	if x != nil && x.ProductGroupId != nil {
+		return *x.ProductGroupId
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetCommissionEligible() bool {
+	if x != nil {
+		return x.CommissionEligible
+	}
+	return false
+}
+
+func (x *CreateProductRequest) GetRequiresServiceEmployee() bool {
+	if x != nil {
+		return x.RequiresServiceEmployee
+	}
+	return false
+}
+
+func (x *CreateProductRequest) GetTaxExempt() bool {
+	if x != nil {
+		return x.TaxExempt
+	}
+	return false
+}
+
+func (x *CreateProductRequest) GetInventorySeed() *InventorySeed {
+	if x != nil {
+		return x.InventorySeed
+	}
+	return nil
+}
+
+// The POS product is kept even if seeding inventory fails; the failure
+// is reported in inventory_error.
+type CreateProductResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Product            *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	InventorySeeded    bool                   `protobuf:"varint,2,opt,name=inventory_seeded,json=inventorySeeded,proto3" json:"inventory_seeded,omitempty"`
+	InventoryProductId *int32                 `protobuf:"varint,3,opt,name=inventory_product_id,json=inventoryProductId,proto3,oneof" json:"inventory_product_id,omitempty"`
+	InventoryError     *string                `protobuf:"bytes,4,opt,name=inventory_error,json=inventoryError,proto3,oneof" json:"inventory_error,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateProductResponse) Reset() {
+	*x = CreateProductResponse{}
+	mi := &file_pos_pos_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductResponse) ProtoMessage() {}
+
+func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductResponse) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CreateProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *CreateProductResponse) GetInventorySeeded() bool {
+	if x != nil {
+		return x.InventorySeeded
+	}
+	return false
+}
+
+func (x *CreateProductResponse) GetInventoryProductId() int32 {
+	if x != nil && x.InventoryProductId != nil {
+		return *x.InventoryProductId
+	}
+	return 0
+}
+
+func (x *CreateProductResponse) GetInventoryError() string {
+	if x != nil && x.InventoryError != nil {
+		return *x.InventoryError
+	}
+	return ""
+}
+
+type GetProductByCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductCode   string                 `protobuf:"bytes,1,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductByCodeRequest) Reset() {
+	*x = GetProductByCodeRequest{}
+	mi := &file_pos_pos_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductByCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductByCodeRequest) ProtoMessage() {}
+
+func (x *GetProductByCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductByCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetProductByCodeRequest) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetProductByCodeRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+type GetProductByCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductByCodeResponse) Reset() {
+	*x = GetProductByCodeResponse{}
+	mi := &file_pos_pos_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductByCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductByCodeResponse) ProtoMessage() {}
+
+func (x *GetProductByCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductByCodeResponse.ProtoReflect.Descriptor instead.
+func (*GetProductByCodeResponse) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetProductByCodeResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type ListProductsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Pagination     *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	IsActive       *bool                  `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	ProductGroupId *int32                 `protobuf:"varint,3,opt,name=product_group_id,json=productGroupId,proto3,oneof" json:"product_group_id,omitempty"`
+	SearchTerm     *string                `protobuf:"bytes,4,opt,name=search_term,json=searchTerm,proto3,oneof" json:"search_term,omitempty"`
+	// Admin only; soft-deleted rows are excluded by default.
+	IncludeDeleted *bool `protobuf:"varint,5,opt,name=include_deleted,json=includeDeleted,proto3,oneof" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_pos_pos_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsRequest) ProtoMessage() {}
+
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListProductsRequest) GetPagination() *PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListProductsRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetProductGroupId() int32 {
+	if x != nil && x.ProductGroupId != nil {
+		return *x.ProductGroupId
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetSearchTerm() string {
+	if x != nil && x.SearchTerm != nil {
+		return *x.SearchTerm
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetIncludeDeleted() bool {
+	if x != nil && x.IncludeDeleted != nil {
+		return *x.IncludeDeleted
+	}
+	return false
+}
+
+type ListProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsResponse) Reset() {
+	*x = ListProductsResponse{}
+	mi := &file_pos_pos_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsResponse) ProtoMessage() {}
+
+func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsResponse) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// Newest change first.
+type GetProductPriceHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Pagination    *PaginationRequest     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductPriceHistoryRequest) Reset() {
+	*x = GetProductPriceHistoryRequest{}
+	mi := &file_pos_pos_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductPriceHistoryRequest) ProtoMessage() {}
+
+func (x *GetProductPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[68]
 	if x != nil {
-		return x.PaidAmount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ProcessPaymentRequest) GetPaymentTypeId() int32 {
+// Deprecated: Use GetProductPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetProductPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetProductPriceHistoryRequest) GetProductId() int32 {
 	if x != nil {
-		return x.PaymentTypeId
+		return x.ProductId
 	}
 	return 0
 }
 
-func (x *ProcessPaymentRequest) GetReferenceNumber() string {
-	if x != nil && x.ReferenceNumber != nil {
-		return *x.ReferenceNumber
+func (x *GetProductPriceHistoryRequest) GetPagination() *PaginationRequest {
+	if x != nil {
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-type ProcessPaymentResponse struct {
+type GetProductPriceHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderDocument *OrderDocument         `protobuf:"bytes,1,opt,name=order_document,json=orderDocument,proto3" json:"order_document,omitempty"`
-	ChangeAmount  string                 `protobuf:"bytes,2,opt,name=change_amount,json=changeAmount,proto3" json:"change_amount,omitempty"`
+	PriceHistory  []*ProductPriceHistory `protobuf:"bytes,1,rep,name=price_history,json=priceHistory,proto3" json:"price_history,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProcessPaymentResponse) Reset() {
-	*x = ProcessPaymentResponse{}
-	mi := &file_pos_pos_service_proto_msgTypes[31]
+func (x *GetProductPriceHistoryResponse) Reset() {
+	*x = GetProductPriceHistoryResponse{}
+	mi := &file_pos_pos_service_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessPaymentResponse) String() string {
+func (x *GetProductPriceHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessPaymentResponse) ProtoMessage() {}
+func (*GetProductPriceHistoryResponse) ProtoMessage() {}
 
-func (x *ProcessPaymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[31]
+func (x *GetProductPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2518,50 +5503,52 @@ func (x *ProcessPaymentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessPaymentResponse.ProtoReflect.Descriptor instead.
-func (*ProcessPaymentResponse) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use GetProductPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetProductPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *ProcessPaymentResponse) GetOrderDocument() *OrderDocument {
+func (x *GetProductPriceHistoryResponse) GetPriceHistory() []*ProductPriceHistory {
 	if x != nil {
-		return x.OrderDocument
+		return x.PriceHistory
 	}
 	return nil
 }
 
-func (x *ProcessPaymentResponse) GetChangeAmount() string {
+func (x *GetProductPriceHistoryResponse) GetPagination() *PaginationResponse {
 	if x != nil {
-		return x.ChangeAmount
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-// Order Modifications
-type VoidOrderRequest struct {
+// Applied once effective_at passes, writing a price history row; until
+// then GetProduct keeps returning the current price.
+type SchedulePriceChangeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	VoidedBy      int64                  `protobuf:"varint,2,opt,name=voided_by,json=voidedBy,proto3" json:"voided_by,omitempty"`
-	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ProductId     int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	NewPrice      string                 `protobuf:"bytes,2,opt,name=new_price,json=newPrice,proto3" json:"new_price,omitempty"`
+	EffectiveAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
+	CreatedBy     int64                  `protobuf:"varint,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VoidOrderRequest) Reset() {
-	*x = VoidOrderRequest{}
-	mi := &file_pos_pos_service_proto_msgTypes[32]
+func (x *SchedulePriceChangeRequest) Reset() {
+	*x = SchedulePriceChangeRequest{}
+	mi := &file_pos_pos_service_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VoidOrderRequest) String() string {
+func (x *SchedulePriceChangeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VoidOrderRequest) ProtoMessage() {}
+func (*SchedulePriceChangeRequest) ProtoMessage() {}
 
-func (x *VoidOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[32]
+func (x *SchedulePriceChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2572,54 +5559,61 @@ func (x *VoidOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VoidOrderRequest.ProtoReflect.Descriptor instead.
-func (*VoidOrderRequest) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use SchedulePriceChangeRequest.ProtoReflect.Descriptor instead.
+func (*SchedulePriceChangeRequest) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *VoidOrderRequest) GetId() int64 {
+func (x *SchedulePriceChangeRequest) GetProductId() int32 {
 	if x != nil {
-		return x.Id
+		return x.ProductId
 	}
 	return 0
 }
 
-func (x *VoidOrderRequest) GetVoidedBy() int64 {
+func (x *SchedulePriceChangeRequest) GetNewPrice() string {
 	if x != nil {
-		return x.VoidedBy
+		return x.NewPrice
 	}
-	return 0
+	return ""
 }
 
-func (x *VoidOrderRequest) GetReason() string {
+func (x *SchedulePriceChangeRequest) GetEffectiveAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Reason
+		return x.EffectiveAt
 	}
-	return ""
+	return nil
 }
 
-type VoidOrderResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderDocument *OrderDocument         `protobuf:"bytes,1,opt,name=order_document,json=orderDocument,proto3" json:"order_document,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SchedulePriceChangeRequest) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
 }
 
-func (x *VoidOrderResponse) Reset() {
-	*x = VoidOrderResponse{}
-	mi := &file_pos_pos_service_proto_msgTypes[33]
+type SchedulePriceChangeResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledPrice *ProductScheduledPrice `protobuf:"bytes,1,opt,name=scheduled_price,json=scheduledPrice,proto3" json:"scheduled_price,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SchedulePriceChangeResponse) Reset() {
+	*x = SchedulePriceChangeResponse{}
+	mi := &file_pos_pos_service_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VoidOrderResponse) String() string {
+func (x *SchedulePriceChangeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VoidOrderResponse) ProtoMessage() {}
+func (*SchedulePriceChangeResponse) ProtoMessage() {}
 
-func (x *VoidOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[33]
+func (x *SchedulePriceChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2630,43 +5624,42 @@ func (x *VoidOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VoidOrderResponse.ProtoReflect.Descriptor instead.
-func (*VoidOrderResponse) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use SchedulePriceChangeResponse.ProtoReflect.Descriptor instead.
+func (*SchedulePriceChangeResponse) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *VoidOrderResponse) GetOrderDocument() *OrderDocument {
+func (x *SchedulePriceChangeResponse) GetScheduledPrice() *ProductScheduledPrice {
 	if x != nil {
-		return x.OrderDocument
+		return x.ScheduledPrice
 	}
 	return nil
 }
 
-type ReturnOrderRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	OriginalOrderId int64                  `protobuf:"varint,1,opt,name=original_order_id,json=originalOrderId,proto3" json:"original_order_id,omitempty"`
-	ItemIds         []int64                `protobuf:"varint,2,rep,packed,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
-	ProcessedBy     int64                  `protobuf:"varint,3,opt,name=processed_by,json=processedBy,proto3" json:"processed_by,omitempty"`
-	Reason          *string                `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type ListScheduledPricesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pagination    *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ProductId     *int32                 `protobuf:"varint,2,opt,name=product_id,json=productId,proto3,oneof" json:"product_id,omitempty"`
+	IsApplied     *bool                  `protobuf:"varint,3,opt,name=is_applied,json=isApplied,proto3,oneof" json:"is_applied,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReturnOrderRequest) Reset() {
-	*x = ReturnOrderRequest{}
-	mi := &file_pos_pos_service_proto_msgTypes[34]
+func (x *ListScheduledPricesRequest) Reset() {
+	*x = ListScheduledPricesRequest{}
+	mi := &file_pos_pos_service_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReturnOrderRequest) String() string {
+func (x *ListScheduledPricesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReturnOrderRequest) ProtoMessage() {}
+func (*ListScheduledPricesRequest) ProtoMessage() {}
 
-func (x *ReturnOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[34]
+func (x *ListScheduledPricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2677,61 +5670,112 @@ func (x *ReturnOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReturnOrderRequest.ProtoReflect.Descriptor instead.
-func (*ReturnOrderRequest) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use ListScheduledPricesRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledPricesRequest) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *ReturnOrderRequest) GetOriginalOrderId() int64 {
+func (x *ListScheduledPricesRequest) GetPagination() *PaginationRequest {
 	if x != nil {
-		return x.OriginalOrderId
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListScheduledPricesRequest) GetProductId() int32 {
+	if x != nil && x.ProductId != nil {
+		return *x.ProductId
 	}
 	return 0
 }
 
-func (x *ReturnOrderRequest) GetItemIds() []int64 {
+func (x *ListScheduledPricesRequest) GetIsApplied() bool {
+	if x != nil && x.IsApplied != nil {
+		return *x.IsApplied
+	}
+	return false
+}
+
+type ListScheduledPricesResponse struct {
+	state           protoimpl.MessageState   `protogen:"open.v1"`
+	ScheduledPrices []*ProductScheduledPrice `protobuf:"bytes,1,rep,name=scheduled_prices,json=scheduledPrices,proto3" json:"scheduled_prices,omitempty"`
+	Pagination      *PaginationResponse      `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListScheduledPricesResponse) Reset() {
+	*x = ListScheduledPricesResponse{}
+	mi := &file_pos_pos_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledPricesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledPricesResponse) ProtoMessage() {}
+
+func (x *ListScheduledPricesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[73]
 	if x != nil {
-		return x.ItemIds
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ReturnOrderRequest) GetProcessedBy() int64 {
+// Deprecated: Use ListScheduledPricesResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledPricesResponse) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListScheduledPricesResponse) GetScheduledPrices() []*ProductScheduledPrice {
 	if x != nil {
-		return x.ProcessedBy
+		return x.ScheduledPrices
 	}
-	return 0
+	return nil
 }
 
-func (x *ReturnOrderRequest) GetReason() string {
-	if x != nil && x.Reason != nil {
-		return *x.Reason
+func (x *ListScheduledPricesResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-type ReturnOrderResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ReturnDocument *OrderDocument         `protobuf:"bytes,1,opt,name=return_document,json=returnDocument,proto3" json:"return_document,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// CSV columns: code,name,price,cost,group,commission_eligible.
+// group is a product group name, matched case-insensitively; an unknown
+// name fails the row, and an empty one leaves the product ungrouped.
+// A code that already exists updates that product (upsert). Codes must
+// be unique within the file; repeats of an earlier row fail.
+type ImportProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CsvData       []byte                 `protobuf:"bytes,1,opt,name=csv_data,json=csvData,proto3" json:"csv_data,omitempty"`
+	ImportedBy    int64                  `protobuf:"varint,2,opt,name=imported_by,json=importedBy,proto3" json:"imported_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReturnOrderResponse) Reset() {
-	*x = ReturnOrderResponse{}
-	mi := &file_pos_pos_service_proto_msgTypes[35]
+func (x *ImportProductsRequest) Reset() {
+	*x = ImportProductsRequest{}
+	mi := &file_pos_pos_service_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReturnOrderResponse) String() string {
+func (x *ImportProductsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReturnOrderResponse) ProtoMessage() {}
+func (*ImportProductsRequest) ProtoMessage() {}
 
-func (x *ReturnOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[35]
+func (x *ImportProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2742,41 +5786,51 @@ func (x *ReturnOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReturnOrderResponse.ProtoReflect.Descriptor instead.
-func (*ReturnOrderResponse) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use ImportProductsRequest.ProtoReflect.Descriptor instead.
+func (*ImportProductsRequest) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *ReturnOrderResponse) GetReturnDocument() *OrderDocument {
+func (x *ImportProductsRequest) GetCsvData() []byte {
 	if x != nil {
-		return x.ReturnDocument
+		return x.CsvData
 	}
 	return nil
 }
 
-// Product Operations
-type GetProductRequest struct {
+func (x *ImportProductsRequest) GetImportedBy() int64 {
+	if x != nil {
+		return x.ImportedBy
+	}
+	return 0
+}
+
+type ProductImportRowResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RowNumber     int32                  `protobuf:"varint,1,opt,name=row_number,json=rowNumber,proto3" json:"row_number,omitempty"`
+	ProductCode   string                 `protobuf:"bytes,2,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Error         *string                `protobuf:"bytes,4,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	Product       *Product               `protobuf:"bytes,5,opt,name=product,proto3,oneof" json:"product,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductRequest) Reset() {
-	*x = GetProductRequest{}
-	mi := &file_pos_pos_service_proto_msgTypes[36]
+func (x *ProductImportRowResult) Reset() {
+	*x = ProductImportRowResult{}
+	mi := &file_pos_pos_service_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductRequest) String() string {
+func (x *ProductImportRowResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductRequest) ProtoMessage() {}
+func (*ProductImportRowResult) ProtoMessage() {}
 
-func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[36]
+func (x *ProductImportRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2787,40 +5841,70 @@ func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
-func (*GetProductRequest) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use ProductImportRowResult.ProtoReflect.Descriptor instead.
+func (*ProductImportRowResult) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *GetProductRequest) GetId() int32 {
+func (x *ProductImportRowResult) GetRowNumber() int32 {
 	if x != nil {
-		return x.Id
+		return x.RowNumber
 	}
 	return 0
 }
 
-type GetProductResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+func (x *ProductImportRowResult) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *ProductImportRowResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ProductImportRowResult) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *ProductImportRowResult) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type ImportProductsResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Results       []*ProductImportRowResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	SuccessCount  int32                     `protobuf:"varint,2,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	ErrorCount    int32                     `protobuf:"varint,3,opt,name=error_count,json=errorCount,proto3" json:"error_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductResponse) Reset() {
-	*x = GetProductResponse{}
-	mi := &file_pos_pos_service_proto_msgTypes[37]
+func (x *ImportProductsResponse) Reset() {
+	*x = ImportProductsResponse{}
+	mi := &file_pos_pos_service_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductResponse) String() string {
+func (x *ImportProductsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductResponse) ProtoMessage() {}
+func (*ImportProductsResponse) ProtoMessage() {}
 
-func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[37]
+func (x *ImportProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2831,40 +5915,54 @@ func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductResponse.ProtoReflect.Descriptor instead.
-func (*GetProductResponse) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use ImportProductsResponse.ProtoReflect.Descriptor instead.
+func (*ImportProductsResponse) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *GetProductResponse) GetProduct() *Product {
+func (x *ImportProductsResponse) GetResults() []*ProductImportRowResult {
 	if x != nil {
-		return x.Product
+		return x.Results
 	}
 	return nil
 }
 
-type GetProductByCodeRequest struct {
+func (x *ImportProductsResponse) GetSuccessCount() int32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *ImportProductsResponse) GetErrorCount() int32 {
+	if x != nil {
+		return x.ErrorCount
+	}
+	return 0
+}
+
+type ProductIdList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductCode   string                 `protobuf:"bytes,1,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`
+	ProductIds    []int32                `protobuf:"varint,1,rep,packed,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductByCodeRequest) Reset() {
-	*x = GetProductByCodeRequest{}
-	mi := &file_pos_pos_service_proto_msgTypes[38]
+func (x *ProductIdList) Reset() {
+	*x = ProductIdList{}
+	mi := &file_pos_pos_service_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductByCodeRequest) String() string {
+func (x *ProductIdList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductByCodeRequest) ProtoMessage() {}
+func (*ProductIdList) ProtoMessage() {}
 
-func (x *GetProductByCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[38]
+func (x *ProductIdList) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2875,40 +5973,49 @@ func (x *GetProductByCodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductByCodeRequest.ProtoReflect.Descriptor instead.
-func (*GetProductByCodeRequest) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use ProductIdList.ProtoReflect.Descriptor instead.
+func (*ProductIdList) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *GetProductByCodeRequest) GetProductCode() string {
+func (x *ProductIdList) GetProductIds() []int32 {
 	if x != nil {
-		return x.ProductCode
+		return x.ProductIds
 	}
-	return ""
+	return nil
 }
 
-type GetProductByCodeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+type BulkUpdatePricesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Selector:
+	//
+	//	*BulkUpdatePricesRequest_ProductIds
+	//	*BulkUpdatePricesRequest_ProductGroupId
+	//	*BulkUpdatePricesRequest_AllProducts
+	Selector      isBulkUpdatePricesRequest_Selector `protobuf_oneof:"selector"`
+	Operation     PriceOperation                     `protobuf:"varint,4,opt,name=operation,proto3,enum=pos.PriceOperation" json:"operation,omitempty"`
+	Value         string                             `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	DryRun        bool                               `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	ChangedBy     int64                              `protobuf:"varint,7,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductByCodeResponse) Reset() {
-	*x = GetProductByCodeResponse{}
-	mi := &file_pos_pos_service_proto_msgTypes[39]
+func (x *BulkUpdatePricesRequest) Reset() {
+	*x = BulkUpdatePricesRequest{}
+	mi := &file_pos_pos_service_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductByCodeResponse) String() string {
+func (x *BulkUpdatePricesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductByCodeResponse) ProtoMessage() {}
+func (*BulkUpdatePricesRequest) ProtoMessage() {}
 
-func (x *GetProductByCodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[39]
+func (x *BulkUpdatePricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2919,43 +6026,120 @@ func (x *GetProductByCodeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductByCodeResponse.ProtoReflect.Descriptor instead.
-func (*GetProductByCodeResponse) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use BulkUpdatePricesRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpdatePricesRequest) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *GetProductByCodeResponse) GetProduct() *Product {
+func (x *BulkUpdatePricesRequest) GetSelector() isBulkUpdatePricesRequest_Selector {
 	if x != nil {
-		return x.Product
+		return x.Selector
 	}
 	return nil
 }
 
-type ListProductsRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Pagination     *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	IsActive       *bool                  `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
-	ProductGroupId *int32                 `protobuf:"varint,3,opt,name=product_group_id,json=productGroupId,proto3,oneof" json:"product_group_id,omitempty"`
-	SearchTerm     *string                `protobuf:"bytes,4,opt,name=search_term,json=searchTerm,proto3,oneof" json:"search_term,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *BulkUpdatePricesRequest) GetProductIds() *ProductIdList {
+	if x != nil {
+		if x, ok := x.Selector.(*BulkUpdatePricesRequest_ProductIds); ok {
+			return x.ProductIds
+		}
+	}
+	return nil
 }
 
-func (x *ListProductsRequest) Reset() {
-	*x = ListProductsRequest{}
-	mi := &file_pos_pos_service_proto_msgTypes[40]
+func (x *BulkUpdatePricesRequest) GetProductGroupId() int32 {
+	if x != nil {
+		if x, ok := x.Selector.(*BulkUpdatePricesRequest_ProductGroupId); ok {
+			return x.ProductGroupId
+		}
+	}
+	return 0
+}
+
+func (x *BulkUpdatePricesRequest) GetAllProducts() bool {
+	if x != nil {
+		if x, ok := x.Selector.(*BulkUpdatePricesRequest_AllProducts); ok {
+			return x.AllProducts
+		}
+	}
+	return false
+}
+
+func (x *BulkUpdatePricesRequest) GetOperation() PriceOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return PriceOperation_PRICE_OPERATION_UNSPECIFIED
+}
+
+func (x *BulkUpdatePricesRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *BulkUpdatePricesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *BulkUpdatePricesRequest) GetChangedBy() int64 {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return 0
+}
+
+type isBulkUpdatePricesRequest_Selector interface {
+	isBulkUpdatePricesRequest_Selector()
+}
+
+type BulkUpdatePricesRequest_ProductIds struct {
+	ProductIds *ProductIdList `protobuf:"bytes,1,opt,name=product_ids,json=productIds,proto3,oneof"`
+}
+
+type BulkUpdatePricesRequest_ProductGroupId struct {
+	ProductGroupId int32 `protobuf:"varint,2,opt,name=product_group_id,json=productGroupId,proto3,oneof"`
+}
+
+type BulkUpdatePricesRequest_AllProducts struct {
+	AllProducts bool `protobuf:"varint,3,opt,name=all_products,json=allProducts,proto3,oneof"`
+}
+
+func (*BulkUpdatePricesRequest_ProductIds) isBulkUpdatePricesRequest_Selector() {}
+
+func (*BulkUpdatePricesRequest_ProductGroupId) isBulkUpdatePricesRequest_Selector() {}
+
+func (*BulkUpdatePricesRequest_AllProducts) isBulkUpdatePricesRequest_Selector() {}
+
+type ProductPriceChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int32                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductCode   string                 `protobuf:"bytes,2,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`
+	OldPrice      string                 `protobuf:"bytes,3,opt,name=old_price,json=oldPrice,proto3" json:"old_price,omitempty"`
+	NewPrice      string                 `protobuf:"bytes,4,opt,name=new_price,json=newPrice,proto3" json:"new_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductPriceChange) Reset() {
+	*x = ProductPriceChange{}
+	mi := &file_pos_pos_service_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsRequest) String() string {
+func (x *ProductPriceChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsRequest) ProtoMessage() {}
+func (*ProductPriceChange) ProtoMessage() {}
 
-func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[40]
+func (x *ProductPriceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2966,62 +6150,63 @@ func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
-func (*ListProductsRequest) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use ProductPriceChange.ProtoReflect.Descriptor instead.
+func (*ProductPriceChange) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *ListProductsRequest) GetPagination() *PaginationRequest {
+func (x *ProductPriceChange) GetProductId() int32 {
 	if x != nil {
-		return x.Pagination
+		return x.ProductId
 	}
-	return nil
+	return 0
 }
 
-func (x *ListProductsRequest) GetIsActive() bool {
-	if x != nil && x.IsActive != nil {
-		return *x.IsActive
+func (x *ProductPriceChange) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
 	}
-	return false
+	return ""
 }
 
-func (x *ListProductsRequest) GetProductGroupId() int32 {
-	if x != nil && x.ProductGroupId != nil {
-		return *x.ProductGroupId
+func (x *ProductPriceChange) GetOldPrice() string {
+	if x != nil {
+		return x.OldPrice
 	}
-	return 0
+	return ""
 }
 
-func (x *ListProductsRequest) GetSearchTerm() string {
-	if x != nil && x.SearchTerm != nil {
-		return *x.SearchTerm
+func (x *ProductPriceChange) GetNewPrice() string {
+	if x != nil {
+		return x.NewPrice
 	}
 	return ""
 }
 
-type ListProductsResponse struct {
+type BulkUpdatePricesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
-	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	PriceChanges  []*ProductPriceChange  `protobuf:"bytes,1,rep,name=price_changes,json=priceChanges,proto3" json:"price_changes,omitempty"`
+	AffectedCount int32                  `protobuf:"varint,2,opt,name=affected_count,json=affectedCount,proto3" json:"affected_count,omitempty"`
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductsResponse) Reset() {
-	*x = ListProductsResponse{}
-	mi := &file_pos_pos_service_proto_msgTypes[41]
+func (x *BulkUpdatePricesResponse) Reset() {
+	*x = BulkUpdatePricesResponse{}
+	mi := &file_pos_pos_service_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsResponse) String() string {
+func (x *BulkUpdatePricesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsResponse) ProtoMessage() {}
+func (*BulkUpdatePricesResponse) ProtoMessage() {}
 
-func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[41]
+func (x *BulkUpdatePricesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pos_pos_service_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3032,23 +6217,30 @@ func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
-func (*ListProductsResponse) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use BulkUpdatePricesResponse.ProtoReflect.Descriptor instead.
+func (*BulkUpdatePricesResponse) Descriptor() ([]byte, []int) {
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *ListProductsResponse) GetProducts() []*Product {
+func (x *BulkUpdatePricesResponse) GetPriceChanges() []*ProductPriceChange {
 	if x != nil {
-		return x.Products
+		return x.PriceChanges
 	}
 	return nil
 }
 
-func (x *ListProductsResponse) GetPagination() *PaginationResponse {
+func (x *BulkUpdatePricesResponse) GetAffectedCount() int32 {
 	if x != nil {
-		return x.Pagination
+		return x.AffectedCount
 	}
-	return nil
+	return 0
+}
+
+func (x *BulkUpdatePricesResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
 }
 
 // Product Group Operations
@@ -3063,7 +6255,7 @@ type ListProductGroupsRequest struct {
 
 func (x *ListProductGroupsRequest) Reset() {
 	*x = ListProductGroupsRequest{}
-	mi := &file_pos_pos_service_proto_msgTypes[42]
+	mi := &file_pos_pos_service_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3075,7 +6267,7 @@ func (x *ListProductGroupsRequest) String() string {
 func (*ListProductGroupsRequest) ProtoMessage() {}
 
 func (x *ListProductGroupsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[42]
+	mi := &file_pos_pos_service_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3088,7 +6280,7 @@ func (x *ListProductGroupsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProductGroupsRequest.ProtoReflect.Descriptor instead.
 func (*ListProductGroupsRequest) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{42}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *ListProductGroupsRequest) GetPagination() *PaginationRequest {
@@ -3122,7 +6314,7 @@ type ListProductGroupsResponse struct {
 
 func (x *ListProductGroupsResponse) Reset() {
 	*x = ListProductGroupsResponse{}
-	mi := &file_pos_pos_service_proto_msgTypes[43]
+	mi := &file_pos_pos_service_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3134,7 +6326,7 @@ func (x *ListProductGroupsResponse) String() string {
 func (*ListProductGroupsResponse) ProtoMessage() {}
 
 func (x *ListProductGroupsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[43]
+	mi := &file_pos_pos_service_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3147,7 +6339,7 @@ func (x *ListProductGroupsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProductGroupsResponse.ProtoReflect.Descriptor instead.
 func (*ListProductGroupsResponse) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{43}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *ListProductGroupsResponse) GetProductGroups() []*ProductGroup {
@@ -3166,17 +6358,18 @@ func (x *ListProductGroupsResponse) GetPagination() *PaginationResponse {
 
 // Discount Operations
 type ListDiscountsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pagination    *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	IsActive      *bool                  `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
-	ProductId     *int32                 `protobuf:"varint,3,opt,name=product_id,json=productId,proto3,oneof" json:"product_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Pagination     *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	IsActive       *bool                  `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	ProductId      *int32                 `protobuf:"varint,3,opt,name=product_id,json=productId,proto3,oneof" json:"product_id,omitempty"`
+	IncludeDeleted *bool                  `protobuf:"varint,4,opt,name=include_deleted,json=includeDeleted,proto3,oneof" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ListDiscountsRequest) Reset() {
 	*x = ListDiscountsRequest{}
-	mi := &file_pos_pos_service_proto_msgTypes[44]
+	mi := &file_pos_pos_service_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3188,7 +6381,7 @@ func (x *ListDiscountsRequest) String() string {
 func (*ListDiscountsRequest) ProtoMessage() {}
 
 func (x *ListDiscountsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[44]
+	mi := &file_pos_pos_service_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3201,7 +6394,7 @@ func (x *ListDiscountsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDiscountsRequest.ProtoReflect.Descriptor instead.
 func (*ListDiscountsRequest) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{44}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *ListDiscountsRequest) GetPagination() *PaginationRequest {
@@ -3225,6 +6418,13 @@ func (x *ListDiscountsRequest) GetProductId() int32 {
 	return 0
 }
 
+func (x *ListDiscountsRequest) GetIncludeDeleted() bool {
+	if x != nil && x.IncludeDeleted != nil {
+		return *x.IncludeDeleted
+	}
+	return false
+}
+
 type ListDiscountsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Discounts     []*Discount            `protobuf:"bytes,1,rep,name=discounts,proto3" json:"discounts,omitempty"`
@@ -3235,7 +6435,7 @@ type ListDiscountsResponse struct {
 
 func (x *ListDiscountsResponse) Reset() {
 	*x = ListDiscountsResponse{}
-	mi := &file_pos_pos_service_proto_msgTypes[45]
+	mi := &file_pos_pos_service_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3247,7 +6447,7 @@ func (x *ListDiscountsResponse) String() string {
 func (*ListDiscountsResponse) ProtoMessage() {}
 
 func (x *ListDiscountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[45]
+	mi := &file_pos_pos_service_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3260,7 +6460,7 @@ func (x *ListDiscountsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDiscountsResponse.ProtoReflect.Descriptor instead.
 func (*ListDiscountsResponse) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{45}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *ListDiscountsResponse) GetDiscounts() []*Discount {
@@ -3288,7 +6488,7 @@ type ValidateDiscountRequest struct {
 
 func (x *ValidateDiscountRequest) Reset() {
 	*x = ValidateDiscountRequest{}
-	mi := &file_pos_pos_service_proto_msgTypes[46]
+	mi := &file_pos_pos_service_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3300,7 +6500,7 @@ func (x *ValidateDiscountRequest) String() string {
 func (*ValidateDiscountRequest) ProtoMessage() {}
 
 func (x *ValidateDiscountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[46]
+	mi := &file_pos_pos_service_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3313,7 +6513,7 @@ func (x *ValidateDiscountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateDiscountRequest.ProtoReflect.Descriptor instead.
 func (*ValidateDiscountRequest) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{46}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *ValidateDiscountRequest) GetDiscountId() int32 {
@@ -3348,7 +6548,7 @@ type ValidateDiscountResponse struct {
 
 func (x *ValidateDiscountResponse) Reset() {
 	*x = ValidateDiscountResponse{}
-	mi := &file_pos_pos_service_proto_msgTypes[47]
+	mi := &file_pos_pos_service_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3360,7 +6560,7 @@ func (x *ValidateDiscountResponse) String() string {
 func (*ValidateDiscountResponse) ProtoMessage() {}
 
 func (x *ValidateDiscountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[47]
+	mi := &file_pos_pos_service_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3373,7 +6573,7 @@ func (x *ValidateDiscountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateDiscountResponse.ProtoReflect.Descriptor instead.
 func (*ValidateDiscountResponse) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{47}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *ValidateDiscountResponse) GetIsValid() bool {
@@ -3407,7 +6607,7 @@ type ListPaymentTypesRequest struct {
 
 func (x *ListPaymentTypesRequest) Reset() {
 	*x = ListPaymentTypesRequest{}
-	mi := &file_pos_pos_service_proto_msgTypes[48]
+	mi := &file_pos_pos_service_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3419,7 +6619,7 @@ func (x *ListPaymentTypesRequest) String() string {
 func (*ListPaymentTypesRequest) ProtoMessage() {}
 
 func (x *ListPaymentTypesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[48]
+	mi := &file_pos_pos_service_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3432,7 +6632,7 @@ func (x *ListPaymentTypesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPaymentTypesRequest.ProtoReflect.Descriptor instead.
 func (*ListPaymentTypesRequest) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{48}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *ListPaymentTypesRequest) GetIsActive() bool {
@@ -3451,7 +6651,7 @@ type ListPaymentTypesResponse struct {
 
 func (x *ListPaymentTypesResponse) Reset() {
 	*x = ListPaymentTypesResponse{}
-	mi := &file_pos_pos_service_proto_msgTypes[49]
+	mi := &file_pos_pos_service_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3463,7 +6663,7 @@ func (x *ListPaymentTypesResponse) String() string {
 func (*ListPaymentTypesResponse) ProtoMessage() {}
 
 func (x *ListPaymentTypesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pos_pos_service_proto_msgTypes[49]
+	mi := &file_pos_pos_service_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3476,7 +6676,7 @@ func (x *ListPaymentTypesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPaymentTypesResponse.ProtoReflect.Descriptor instead.
 func (*ListPaymentTypesResponse) Descriptor() ([]byte, []int) {
-	return file_pos_pos_service_proto_rawDescGZIP(), []int{49}
+	return file_pos_pos_service_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *ListPaymentTypesResponse) GetPaymentTypes() []*PaymentType {
@@ -3498,11 +6698,13 @@ const file_pos_pos_service_proto_rawDesc = "" +
 	"\x12PaginationResponse\x12&\n" +
 	"\x0fnext_page_token\x18\x01 \x01(\tR\rnextPageToken\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"E\n" +
+	"totalCount\"s\n" +
 	"\tDateRange\x12\x1d\n" +
 	"\n" +
 	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
-	"\bend_date\x18\x02 \x01(\tR\aendDate\"\xf5\x06\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12\x1f\n" +
+	"\btimezone\x18\x03 \x01(\tH\x00R\btimezone\x88\x01\x01B\v\n" +
+	"\t_timezone\"\xbb\t\n" +
 	"\rOrderDocument\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12'\n" +
 	"\x0fdocument_number\x18\x02 \x01(\tR\x0edocumentNumber\x12\x1d\n" +
@@ -3531,11 +6733,32 @@ const file_pos_pos_service_proto_rawDesc = "" +
 	"updated_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12/\n" +
 	"\vorder_items\x18\x12 \x03(\v2\x0e.pos.OrderItemR\n" +
 	"orderItems\x128\n" +
-	"\fpayment_type\x18\x13 \x01(\v2\x10.pos.PaymentTypeH\x03R\vpaymentType\x88\x01\x01B\x12\n" +
+	"\fpayment_type\x18\x13 \x01(\v2\x10.pos.PaymentTypeH\x03R\vpaymentType\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"tax_exempt\x18\x14 \x01(\bR\ttaxExempt\x12\x1a\n" +
+	"\bcurrency\x18\x15 \x01(\tR\bcurrency\x12#\n" +
+	"\rexchange_rate\x18\x16 \x01(\tR\fexchangeRate\x125\n" +
+	"\x14tax_exemption_reason\x18\x17 \x01(\tH\x04R\x12taxExemptionReason\x88\x01\x01\x12.\n" +
+	"\ttax_lines\x18\x18 \x03(\v2\x11.pos.OrderTaxLineR\btaxLines\x12\"\n" +
+	"\n" +
+	"created_by\x18\x19 \x01(\x03H\x05R\tcreatedBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"updated_by\x18\x1a \x01(\x03H\x06R\tupdatedBy\x88\x01\x01B\x12\n" +
 	"\x10_payment_type_idB\x12\n" +
 	"\x10_additional_infoB\b\n" +
 	"\x06_notesB\x0f\n" +
-	"\r_payment_type\"\xf3\x04\n" +
+	"\r_payment_typeB\x17\n" +
+	"\x15_tax_exemption_reasonB\r\n" +
+	"\v_created_byB\r\n" +
+	"\v_updated_by\"\xa0\x01\n" +
+	"\fOrderTaxLine\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vdocument_id\x18\x02 \x01(\x03R\n" +
+	"documentId\x12\x19\n" +
+	"\btax_rate\x18\x03 \x01(\tR\ataxRate\x12%\n" +
+	"\x0etaxable_amount\x18\x04 \x01(\tR\rtaxableAmount\x12\x1d\n" +
+	"\n" +
+	"tax_amount\x18\x05 \x01(\tR\ttaxAmount\"\xca\x06\n" +
 	"\tOrderItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
 	"\vdocument_id\x18\x02 \x01(\x03R\n" +
@@ -3557,12 +6780,22 @@ const file_pos_pos_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12+\n" +
 	"\aproduct\x18\r \x01(\v2\f.pos.ProductH\x02R\aproduct\x88\x01\x01\x12.\n" +
-	"\bdiscount\x18\x0e \x01(\v2\r.pos.DiscountH\x03R\bdiscount\x88\x01\x01B\x16\n" +
+	"\bdiscount\x18\x0e \x01(\v2\r.pos.DiscountH\x03R\bdiscount\x88\x01\x01\x12Q\n" +
+	"\x17serving_employee_splits\x18\x0f \x03(\v2\x19.pos.ServingEmployeeSplitR\x15servingEmployeeSplits\x12'\n" +
+	"\x0fcommission_rate\x18\x10 \x01(\tR\x0ecommissionRate\x12\x1d\n" +
+	"\n" +
+	"cost_price\x18\x11 \x01(\tR\tcostPrice\x12(\n" +
+	"\rserial_number\x18\x12 \x01(\tH\x04R\fserialNumber\x88\x01\x01B\x16\n" +
 	"\x14_serving_employee_idB\x0e\n" +
 	"\f_discount_idB\n" +
 	"\n" +
 	"\b_productB\v\n" +
-	"\t_discount\"\x83\x02\n" +
+	"\t_discountB\x10\n" +
+	"\x0e_serial_number\"b\n" +
+	"\x14ServingEmployeeSplit\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\x03R\n" +
+	"employeeId\x12)\n" +
+	"\x10split_percentage\x18\x02 \x01(\tR\x0fsplitPercentage\"\x83\x02\n" +
 	"\vPaymentType\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12!\n" +
 	"\fpayment_name\x18\x02 \x01(\tR\vpaymentName\x12\x1b\n" +
@@ -3571,7 +6804,7 @@ const file_pos_pos_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd2\x06\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x87\b\n" +
 	"\bDiscount\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12#\n" +
 	"\rdiscount_name\x18\x02 \x01(\tR\fdiscountName\x126\n" +
@@ -3593,7 +6826,13 @@ const file_pos_pos_service_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12+\n" +
 	"\aproduct\x18\x0e \x01(\v2\f.pos.ProductH\x05R\aproduct\x88\x01\x01\x12;\n" +
-	"\rproduct_group\x18\x0f \x01(\v2\x11.pos.ProductGroupH\x06R\fproductGroup\x88\x01\x01B\r\n" +
+	"\rproduct_group\x18\x0f \x01(\v2\x11.pos.ProductGroupH\x06R\fproductGroup\x88\x01\x01\x12>\n" +
+	"\n" +
+	"deleted_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampH\aR\tdeletedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_by\x18\x11 \x01(\x03H\bR\tcreatedBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"updated_by\x18\x12 \x01(\x03H\tR\tupdatedBy\x88\x01\x01B\r\n" +
 	"\v_product_idB\x13\n" +
 	"\x11_product_group_idB\x1c\n" +
 	"\x1a_max_usage_per_transactionB\r\n" +
@@ -3601,7 +6840,10 @@ const file_pos_pos_service_proto_rawDesc = "" +
 	"\f_valid_untilB\n" +
 	"\n" +
 	"\b_productB\x10\n" +
-	"\x0e_product_group\"\xb6\x04\n" +
+	"\x0e_product_groupB\r\n" +
+	"\v_deleted_atB\r\n" +
+	"\v_created_byB\r\n" +
+	"\v_updated_by\"\xb7\x06\n" +
 	"\aProduct\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12!\n" +
 	"\fproduct_code\x18\x02 \x01(\tR\vproductCode\x12!\n" +
@@ -3618,9 +6860,49 @@ const file_pos_pos_service_proto_rawDesc = "" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12;\n" +
-	"\rproduct_group\x18\f \x01(\v2\x11.pos.ProductGroupH\x01R\fproductGroup\x88\x01\x01B\x13\n" +
+	"\rproduct_group\x18\f \x01(\v2\x11.pos.ProductGroupH\x01R\fproductGroup\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"tax_exempt\x18\r \x01(\bR\ttaxExempt\x12\x1e\n" +
+	"\btax_rate\x18\x0e \x01(\tH\x02R\ataxRate\x88\x01\x01\x12>\n" +
+	"\n" +
+	"deleted_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampH\x03R\tdeletedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_by\x18\x10 \x01(\x03H\x04R\tcreatedBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"updated_by\x18\x11 \x01(\x03H\x05R\tupdatedBy\x88\x01\x01B\x13\n" +
 	"\x11_product_group_idB\x10\n" +
-	"\x0e_product_group\"\xca\x04\n" +
+	"\x0e_product_groupB\v\n" +
+	"\t_tax_rateB\r\n" +
+	"\v_deleted_atB\r\n" +
+	"\v_created_byB\r\n" +
+	"\v_updated_by\"\xec\x01\n" +
+	"\x13ProductPriceHistory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x05R\tproductId\x12\x1b\n" +
+	"\told_price\x18\x03 \x01(\tR\boldPrice\x12\x1b\n" +
+	"\tnew_price\x18\x04 \x01(\tR\bnewPrice\x12\"\n" +
+	"\n" +
+	"changed_by\x18\x05 \x01(\x03H\x00R\tchangedBy\x88\x01\x01\x129\n" +
+	"\n" +
+	"changed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAtB\r\n" +
+	"\v_changed_by\"\xfe\x02\n" +
+	"\x15ProductScheduledPrice\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x05R\tproductId\x12\x1b\n" +
+	"\tnew_price\x18\x03 \x01(\tR\bnewPrice\x12=\n" +
+	"\feffective_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveAt\x12\x1d\n" +
+	"\n" +
+	"is_applied\x18\x05 \x01(\bR\tisApplied\x12>\n" +
+	"\n" +
+	"applied_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tappliedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_by\x18\a \x01(\x03H\x01R\tcreatedBy\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\r\n" +
+	"\v_applied_atB\r\n" +
+	"\v_created_by\"\xca\x04\n" +
 	"\fProductGroup\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12,\n" +
 	"\x12product_group_name\x18\x02 \x01(\tR\x10productGroupName\x12+\n" +
@@ -3641,7 +6923,7 @@ const file_pos_pos_service_proto_rawDesc = "" +
 	"\x06_colorB\f\n" +
 	"\n" +
 	"_image_urlB\x0f\n" +
-	"\r_parent_group\"\xe0\x02\n" +
+	"\r_parent_group\"\xeb\x04\n" +
 	"\x04Cart\x12\x17\n" +
 	"\acart_id\x18\x01 \x01(\tR\x06cartId\x12\x1d\n" +
 	"\n" +
@@ -3655,7 +6937,20 @@ const file_pos_pos_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xbe\x03\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\n" +
+	" \x01(\x03R\aversion\x12\x1a\n" +
+	"\bcurrency\x18\v \x01(\tR\bcurrency\x12\x1d\n" +
+	"\n" +
+	"tax_exempt\x18\f \x01(\bR\ttaxExempt\x125\n" +
+	"\x14tax_exemption_reason\x18\r \x01(\tH\x00R\x12taxExemptionReason\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_by\x18\x0e \x01(\x03H\x01R\tcreatedBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"updated_by\x18\x0f \x01(\x03H\x02R\tupdatedBy\x88\x01\x01B\x17\n" +
+	"\x15_tax_exemption_reasonB\r\n" +
+	"\v_created_byB\r\n" +
+	"\v_updated_by\"\xcd\x04\n" +
 	"\bCartItem\x12\x17\n" +
 	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x1d\n" +
 	"\n" +
@@ -3671,36 +6966,55 @@ const file_pos_pos_service_proto_rawDesc = "" +
 	"line_total\x18\b \x01(\tR\tlineTotal\x12+\n" +
 	"\aproduct\x18\t \x01(\v2\f.pos.ProductH\x02R\aproduct\x88\x01\x01\x12.\n" +
 	"\bdiscount\x18\n" +
-	" \x01(\v2\r.pos.DiscountH\x03R\bdiscount\x88\x01\x01B\x16\n" +
+	" \x01(\v2\r.pos.DiscountH\x03R\bdiscount\x88\x01\x01\x12(\n" +
+	"\rserial_number\x18\v \x01(\tH\x04R\fserialNumber\x88\x01\x01\x12Q\n" +
+	"\x17serving_employee_splits\x18\f \x03(\v2\x19.pos.ServingEmployeeSplitR\x15servingEmployeeSplitsB\x16\n" +
 	"\x14_serving_employee_idB\x0e\n" +
 	"\f_discount_idB\n" +
 	"\n" +
 	"\b_productB\v\n" +
-	"\t_discount\"2\n" +
+	"\t_discountB\x10\n" +
+	"\x0e_serial_number\"\xe3\x01\n" +
 	"\x11CreateCartRequest\x12\x1d\n" +
 	"\n" +
-	"cashier_id\x18\x01 \x01(\x03R\tcashierId\"3\n" +
+	"cashier_id\x18\x01 \x01(\x03R\tcashierId\x12\x1f\n" +
+	"\bcurrency\x18\x02 \x01(\tH\x00R\bcurrency\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"tax_exempt\x18\x03 \x01(\bH\x01R\ttaxExempt\x88\x01\x01\x125\n" +
+	"\x14tax_exemption_reason\x18\x04 \x01(\tH\x02R\x12taxExemptionReason\x88\x01\x01B\v\n" +
+	"\t_currencyB\r\n" +
+	"\v_tax_exemptB\x17\n" +
+	"\x15_tax_exemption_reason\"3\n" +
 	"\x12CreateCartResponse\x12\x1d\n" +
-	"\x04cart\x18\x01 \x01(\v2\t.pos.CartR\x04cart\"\xb7\x01\n" +
+	"\x04cart\x18\x01 \x01(\v2\t.pos.CartR\x04cart\"\x8b\x03\n" +
 	"\x14AddItemToCartRequest\x12\x17\n" +
 	"\acart_id\x18\x01 \x01(\tR\x06cartId\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x02 \x01(\x05R\tproductId\x12\x1a\n" +
 	"\bquantity\x18\x03 \x01(\x05R\bquantity\x123\n" +
-	"\x13serving_employee_id\x18\x04 \x01(\x03H\x00R\x11servingEmployeeId\x88\x01\x01B\x16\n" +
-	"\x14_serving_employee_id\"6\n" +
+	"\x13serving_employee_id\x18\x04 \x01(\x03H\x00R\x11servingEmployeeId\x88\x01\x01\x12.\n" +
+	"\x10expected_version\x18\x05 \x01(\x03H\x01R\x0fexpectedVersion\x88\x01\x01\x12(\n" +
+	"\rserial_number\x18\x06 \x01(\tH\x02R\fserialNumber\x88\x01\x01\x12Q\n" +
+	"\x17serving_employee_splits\x18\a \x03(\v2\x19.pos.ServingEmployeeSplitR\x15servingEmployeeSplitsB\x16\n" +
+	"\x14_serving_employee_idB\x13\n" +
+	"\x11_expected_versionB\x10\n" +
+	"\x0e_serial_number\"6\n" +
 	"\x15AddItemToCartResponse\x12\x1d\n" +
-	"\x04cart\x18\x01 \x01(\v2\t.pos.CartR\x04cart\"M\n" +
+	"\x04cart\x18\x01 \x01(\v2\t.pos.CartR\x04cart\"\x92\x01\n" +
 	"\x19RemoveItemFromCartRequest\x12\x17\n" +
 	"\acart_id\x18\x01 \x01(\tR\x06cartId\x12\x17\n" +
-	"\aitem_id\x18\x02 \x01(\tR\x06itemId\";\n" +
+	"\aitem_id\x18\x02 \x01(\tR\x06itemId\x12.\n" +
+	"\x10expected_version\x18\x03 \x01(\x03H\x00R\x0fexpectedVersion\x88\x01\x01B\x13\n" +
+	"\x11_expected_version\";\n" +
 	"\x1aRemoveItemFromCartResponse\x12\x1d\n" +
-	"\x04cart\x18\x01 \x01(\v2\t.pos.CartR\x04cart\"k\n" +
+	"\x04cart\x18\x01 \x01(\v2\t.pos.CartR\x04cart\"\xb0\x01\n" +
 	"\x14ApplyDiscountRequest\x12\x17\n" +
 	"\acart_id\x18\x01 \x01(\tR\x06cartId\x12\x1f\n" +
 	"\vdiscount_id\x18\x02 \x01(\x05R\n" +
 	"discountId\x12\x19\n" +
-	"\bitem_ids\x18\x03 \x03(\tR\aitemIds\"6\n" +
+	"\bitem_ids\x18\x03 \x03(\tR\aitemIds\x12.\n" +
+	"\x10expected_version\x18\x04 \x01(\x03H\x00R\x0fexpectedVersion\x88\x01\x01B\x13\n" +
+	"\x11_expected_version\"6\n" +
 	"\x15ApplyDiscountResponse\x12\x1d\n" +
 	"\x04cart\x18\x01 \x01(\v2\t.pos.CartR\x04cart\")\n" +
 	"\x0eGetCartRequest\x12\x17\n" +
@@ -3715,7 +7029,7 @@ const file_pos_pos_service_proto_rawDesc = "" +
 	"\x10_additional_infoB\b\n" +
 	"\x06_notes\"X\n" +
 	"\x1bCreateOrderFromCartResponse\x129\n" +
-	"\x0eorder_document\x18\x01 \x01(\v2\x12.pos.OrderDocumentR\rorderDocument\"\xb9\x02\n" +
+	"\x0eorder_document\x18\x01 \x01(\v2\x12.pos.OrderDocumentR\rorderDocument\"\xea\x03\n" +
 	"\x12CreateOrderRequest\x12'\n" +
 	"\x0fdocument_number\x18\x01 \x01(\tR\x0edocumentNumber\x12\x1d\n" +
 	"\n" +
@@ -3724,24 +7038,48 @@ const file_pos_pos_service_proto_rawDesc = "" +
 	"\vorder_items\x18\x04 \x03(\v2\x1b.pos.CreateOrderItemRequestR\n" +
 	"orderItems\x12,\n" +
 	"\x0fadditional_info\x18\x05 \x01(\tH\x00R\x0eadditionalInfo\x88\x01\x01\x12\x19\n" +
-	"\x05notes\x18\x06 \x01(\tH\x01R\x05notes\x88\x01\x01B\x12\n" +
+	"\x05notes\x18\x06 \x01(\tH\x01R\x05notes\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"tax_exempt\x18\a \x01(\bH\x02R\ttaxExempt\x88\x01\x01\x12\x1f\n" +
+	"\bcurrency\x18\b \x01(\tH\x03R\bcurrency\x88\x01\x01\x125\n" +
+	"\x14tax_exemption_reason\x18\t \x01(\tH\x04R\x12taxExemptionReason\x88\x01\x01B\x12\n" +
 	"\x10_additional_infoB\b\n" +
-	"\x06_notes\"\xd6\x01\n" +
+	"\x06_notesB\r\n" +
+	"\v_tax_exemptB\v\n" +
+	"\t_currencyB\x17\n" +
+	"\x15_tax_exemption_reason\"\xe5\x02\n" +
 	"\x16CreateOrderItemRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x05R\tproductId\x123\n" +
 	"\x13serving_employee_id\x18\x02 \x01(\x03H\x00R\x11servingEmployeeId\x88\x01\x01\x12\x1a\n" +
 	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12$\n" +
 	"\vdiscount_id\x18\x04 \x01(\x05H\x01R\n" +
-	"discountId\x88\x01\x01B\x16\n" +
+	"discountId\x88\x01\x01\x12Q\n" +
+	"\x17serving_employee_splits\x18\x05 \x03(\v2\x19.pos.ServingEmployeeSplitR\x15servingEmployeeSplits\x12(\n" +
+	"\rserial_number\x18\x06 \x01(\tH\x02R\fserialNumber\x88\x01\x01B\x16\n" +
 	"\x14_serving_employee_idB\x0e\n" +
-	"\f_discount_id\"P\n" +
+	"\f_discount_idB\x10\n" +
+	"\x0e_serial_number\"P\n" +
 	"\x13CreateOrderResponse\x129\n" +
 	"\x0eorder_document\x18\x01 \x01(\v2\x12.pos.OrderDocumentR\rorderDocument\"!\n" +
 	"\x0fGetOrderRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"M\n" +
 	"\x10GetOrderResponse\x129\n" +
-	"\x0eorder_document\x18\x01 \x01(\v2\x12.pos.OrderDocumentR\rorderDocument\"\xd7\x02\n" +
+	"\x0eorder_document\x18\x01 \x01(\v2\x12.pos.OrderDocumentR\rorderDocument\"'\n" +
+	"\x15GetOrderStatusRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xfb\x01\n" +
+	"\x16GetOrderStatusResponse\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x120\n" +
+	"\vpaid_status\x18\x02 \x01(\x0e2\x0f.pos.PaidStatusR\n" +
+	"paidStatus\x126\n" +
+	"\rdocument_type\x18\x03 \x01(\x0e2\x11.pos.DocumentTypeR\fdocumentType\x12!\n" +
+	"\ftotal_amount\x18\x04 \x01(\tR\vtotalAmount\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"J\n" +
+	"\x1fGetOrderByDocumentNumberRequest\x12'\n" +
+	"\x0fdocument_number\x18\x01 \x01(\tR\x0edocumentNumber\"]\n" +
+	" GetOrderByDocumentNumberResponse\x129\n" +
+	"\x0eorder_document\x18\x01 \x01(\v2\x12.pos.OrderDocumentR\rorderDocument\"\xa4\x03\n" +
 	"\x11ListOrdersRequest\x126\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x16.pos.PaginationRequestR\n" +
@@ -3752,48 +7090,218 @@ const file_pos_pos_service_proto_rawDesc = "" +
 	"\vpaid_status\x18\x04 \x01(\x0e2\x0f.pos.PaidStatusH\x02R\n" +
 	"paidStatus\x88\x01\x01\x122\n" +
 	"\n" +
-	"date_range\x18\x05 \x01(\v2\x0e.pos.DateRangeH\x03R\tdateRange\x88\x01\x01B\r\n" +
+	"date_range\x18\x05 \x01(\v2\x0e.pos.DateRangeH\x03R\tdateRange\x88\x01\x01\x123\n" +
+	"\x13include_total_count\x18\x06 \x01(\bH\x04R\x11includeTotalCount\x88\x01\x01B\r\n" +
 	"\v_cashier_idB\x10\n" +
 	"\x0e_document_typeB\x0e\n" +
 	"\f_paid_statusB\r\n" +
-	"\v_date_range\"\x8a\x01\n" +
+	"\v_date_rangeB\x16\n" +
+	"\x14_include_total_count\"\x8a\x01\n" +
 	"\x12ListOrdersResponse\x12;\n" +
 	"\x0forder_documents\x18\x01 \x03(\v2\x12.pos.OrderDocumentR\x0eorderDocuments\x127\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x17.pos.PaginationResponseR\n" +
-	"pagination\"\xc0\x01\n" +
+	"pagination\"\xc7\x02\n" +
+	"\n" +
+	"OrderEvent\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x01 \x01(\tR\teventType\x12%\n" +
+	"\x0eschema_version\x18\x02 \x01(\x05R\rschemaVersion\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\x03R\aorderId\x12'\n" +
+	"\x0fdocument_number\x18\x04 \x01(\tR\x0edocumentNumber\x12\x1d\n" +
+	"\n" +
+	"cashier_id\x18\x05 \x01(\x03R\tcashierId\x120\n" +
+	"\vpaid_status\x18\x06 \x01(\x0e2\x0f.pos.PaidStatusR\n" +
+	"paidStatus\x12!\n" +
+	"\ftotal_amount\x18\a \x01(\tR\vtotalAmount\x12;\n" +
+	"\voccurred_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"M\n" +
+	"\x18StreamOrderEventsRequest\x12\"\n" +
+	"\n" +
+	"cashier_id\x18\x01 \x01(\x03H\x00R\tcashierId\x88\x01\x01B\r\n" +
+	"\v_cashier_id\"\x94\x02\n" +
+	"\vStoreCredit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12$\n" +
+	"\vcustomer_id\x18\x02 \x01(\x03H\x00R\n" +
+	"customerId\x88\x01\x01\x12\x1b\n" +
+	"\tissued_by\x18\x03 \x01(\x03R\bissuedBy\x12\x19\n" +
+	"\border_id\x18\x04 \x01(\x03R\aorderId\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\tR\x06amount\x12\x18\n" +
+	"\abalance\x18\x06 \x01(\tR\abalance\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrencyB\x0e\n" +
+	"\f_customer_id\"\xcb\x02\n" +
 	"\x15ProcessPaymentRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x1f\n" +
 	"\vpaid_amount\x18\x02 \x01(\tR\n" +
 	"paidAmount\x12&\n" +
 	"\x0fpayment_type_id\x18\x03 \x01(\x05R\rpaymentTypeId\x12.\n" +
-	"\x10reference_number\x18\x04 \x01(\tH\x00R\x0freferenceNumber\x88\x01\x01B\x13\n" +
-	"\x11_reference_number\"x\n" +
+	"\x10reference_number\x18\x04 \x01(\tH\x00R\x0freferenceNumber\x88\x01\x01\x128\n" +
+	"\x16change_as_store_credit\x18\x05 \x01(\bH\x01R\x13changeAsStoreCredit\x88\x01\x01\x12$\n" +
+	"\vcustomer_id\x18\x06 \x01(\x03H\x02R\n" +
+	"customerId\x88\x01\x01B\x13\n" +
+	"\x11_reference_numberB\x19\n" +
+	"\x17_change_as_store_creditB\x0e\n" +
+	"\f_customer_id\"\xc3\x01\n" +
 	"\x16ProcessPaymentResponse\x129\n" +
 	"\x0eorder_document\x18\x01 \x01(\v2\x12.pos.OrderDocumentR\rorderDocument\x12#\n" +
-	"\rchange_amount\x18\x02 \x01(\tR\fchangeAmount\"W\n" +
+	"\rchange_amount\x18\x02 \x01(\tR\fchangeAmount\x128\n" +
+	"\fstore_credit\x18\x03 \x01(\v2\x10.pos.StoreCreditH\x00R\vstoreCredit\x88\x01\x01B\x0f\n" +
+	"\r_store_credit\"W\n" +
 	"\x10VoidOrderRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
 	"\tvoided_by\x18\x02 \x01(\x03R\bvoidedBy\x12\x16\n" +
 	"\x06reason\x18\x03 \x01(\tR\x06reason\"N\n" +
 	"\x11VoidOrderResponse\x129\n" +
-	"\x0eorder_document\x18\x01 \x01(\v2\x12.pos.OrderDocumentR\rorderDocument\"\xa6\x01\n" +
+	"\x0eorder_document\x18\x01 \x01(\v2\x12.pos.OrderDocumentR\rorderDocument\"\xfc\x01\n" +
 	"\x12ReturnOrderRequest\x12*\n" +
 	"\x11original_order_id\x18\x01 \x01(\x03R\x0foriginalOrderId\x12\x19\n" +
 	"\bitem_ids\x18\x02 \x03(\x03R\aitemIds\x12!\n" +
 	"\fprocessed_by\x18\x03 \x01(\x03R\vprocessedBy\x12\x1b\n" +
-	"\x06reason\x18\x04 \x01(\tH\x00R\x06reason\x88\x01\x01B\t\n" +
-	"\a_reason\"R\n" +
+	"\x06reason\x18\x04 \x01(\tH\x00R\x06reason\x88\x01\x01\x129\n" +
+	"\x16override_authorized_by\x18\x05 \x01(\x03H\x01R\x14overrideAuthorizedBy\x88\x01\x01B\t\n" +
+	"\a_reasonB\x19\n" +
+	"\x17_override_authorized_by\"R\n" +
 	"\x13ReturnOrderResponse\x12;\n" +
-	"\x0freturn_document\x18\x01 \x01(\v2\x12.pos.OrderDocumentR\x0ereturnDocument\"#\n" +
+	"\x0freturn_document\x18\x01 \x01(\v2\x12.pos.OrderDocumentR\x0ereturnDocument\"\xcb\x01\n" +
+	"\x18GetSalesByProductRequest\x12-\n" +
+	"\n" +
+	"date_range\x18\x01 \x01(\v2\x0e.pos.DateRangeR\tdateRange\x126\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x16.pos.PaginationRequestR\n" +
+	"pagination\x121\n" +
+	"\x12adjust_for_returns\x18\x03 \x01(\bH\x00R\x10adjustForReturns\x88\x01\x01B\x15\n" +
+	"\x13_adjust_for_returns\"\xff\x01\n" +
+	"\fProductSales\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x05R\tproductId\x12!\n" +
+	"\fproduct_code\x18\x02 \x01(\tR\vproductCode\x12!\n" +
+	"\fproduct_name\x18\x03 \x01(\tR\vproductName\x12#\n" +
+	"\rquantity_sold\x18\x04 \x01(\x05R\fquantitySold\x12\x1f\n" +
+	"\vgross_sales\x18\x05 \x01(\tR\n" +
+	"grossSales\x12'\n" +
+	"\x0fdiscount_amount\x18\x06 \x01(\tR\x0ediscountAmount\x12\x1b\n" +
+	"\tnet_sales\x18\a \x01(\tR\bnetSales\"\x8c\x01\n" +
+	"\x19GetSalesByProductResponse\x126\n" +
+	"\rproduct_sales\x18\x01 \x03(\v2\x11.pos.ProductSalesR\fproductSales\x127\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x17.pos.PaginationResponseR\n" +
+	"pagination\"\xcb\x01\n" +
+	"\x18GetSalesByCashierRequest\x12-\n" +
+	"\n" +
+	"date_range\x18\x01 \x01(\v2\x0e.pos.DateRangeR\tdateRange\x126\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x16.pos.PaginationRequestR\n" +
+	"pagination\x121\n" +
+	"\x12adjust_for_returns\x18\x03 \x01(\bH\x00R\x10adjustForReturns\x88\x01\x01B\x15\n" +
+	"\x13_adjust_for_returns\"\xe6\x01\n" +
+	"\fCashierSales\x12\x1d\n" +
+	"\n" +
+	"cashier_id\x18\x01 \x01(\x03R\tcashierId\x12+\n" +
+	"\x11transaction_count\x18\x02 \x01(\x05R\x10transactionCount\x12#\n" +
+	"\rquantity_sold\x18\x03 \x01(\x05R\fquantitySold\x12\x1f\n" +
+	"\vgross_sales\x18\x04 \x01(\tR\n" +
+	"grossSales\x12'\n" +
+	"\x0fdiscount_amount\x18\x05 \x01(\tR\x0ediscountAmount\x12\x1b\n" +
+	"\tnet_sales\x18\x06 \x01(\tR\bnetSales\"\x8c\x01\n" +
+	"\x19GetSalesByCashierResponse\x126\n" +
+	"\rcashier_sales\x18\x01 \x03(\v2\x11.pos.CashierSalesR\fcashierSales\x127\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x17.pos.PaginationResponseR\n" +
+	"pagination\"\xd9\x05\n" +
+	"\aReceipt\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12'\n" +
+	"\x0fdocument_number\x18\x02 \x01(\tR\x0edocumentNumber\x12;\n" +
+	"\vorders_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"ordersDate\x12\x1d\n" +
+	"\n" +
+	"cashier_id\x18\x04 \x01(\x03R\tcashierId\x12\"\n" +
+	"\n" +
+	"store_name\x18\x05 \x01(\tH\x00R\tstoreName\x88\x01\x01\x12(\n" +
+	"\rstore_address\x18\x06 \x01(\tH\x01R\fstoreAddress\x88\x01\x01\x12$\n" +
+	"\vstore_phone\x18\a \x01(\tH\x02R\n" +
+	"storePhone\x88\x01\x01\x12&\n" +
+	"\x05lines\x18\b \x03(\v2\x10.pos.ReceiptLineR\x05lines\x12\x1a\n" +
+	"\bsubtotal\x18\t \x01(\tR\bsubtotal\x12?\n" +
+	"\x0ediscount_lines\x18\n" +
+	" \x03(\v2\x18.pos.ReceiptDiscountLineR\rdiscountLines\x12\x1d\n" +
+	"\n" +
+	"tax_amount\x18\v \x01(\tR\ttaxAmount\x12!\n" +
+	"\ftotal_amount\x18\f \x01(\tR\vtotalAmount\x12,\n" +
+	"\atenders\x18\r \x03(\v2\x12.pos.ReceiptTenderR\atenders\x12#\n" +
+	"\rchange_amount\x18\x0e \x01(\tR\fchangeAmount\x12\x19\n" +
+	"\x05notes\x18\x0f \x01(\tH\x03R\x05notes\x88\x01\x01\x12.\n" +
+	"\ttax_lines\x18\x10 \x03(\v2\x11.pos.OrderTaxLineR\btaxLines\x12\x1a\n" +
+	"\bcurrency\x18\x11 \x01(\tR\bcurrencyB\r\n" +
+	"\v_store_nameB\x10\n" +
+	"\x0e_store_addressB\x0e\n" +
+	"\f_store_phoneB\b\n" +
+	"\x06_notes\"\xd2\x01\n" +
+	"\vReceiptLine\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x05R\tproductId\x12!\n" +
+	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x04 \x01(\tR\tunitPrice\x12'\n" +
+	"\x0fdiscount_amount\x18\x05 \x01(\tR\x0ediscountAmount\x12\x1d\n" +
+	"\n" +
+	"line_total\x18\x06 \x01(\tR\tlineTotal\"\x84\x01\n" +
+	"\x13ReceiptDiscountLine\x12\x1f\n" +
+	"\vdiscount_id\x18\x01 \x01(\x05R\n" +
+	"discountId\x12#\n" +
+	"\rdiscount_name\x18\x02 \x01(\tR\fdiscountName\x12'\n" +
+	"\x0fdiscount_amount\x18\x03 \x01(\tR\x0ediscountAmount\"\xb7\x01\n" +
+	"\rReceiptTender\x12&\n" +
+	"\x0fpayment_type_id\x18\x01 \x01(\x05R\rpaymentTypeId\x12!\n" +
+	"\fpayment_name\x18\x02 \x01(\tR\vpaymentName\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12.\n" +
+	"\x10reference_number\x18\x04 \x01(\tH\x00R\x0freferenceNumber\x88\x01\x01B\x13\n" +
+	"\x11_reference_number\".\n" +
+	"\x11GetReceiptRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\"<\n" +
+	"\x12GetReceiptResponse\x12&\n" +
+	"\areceipt\x18\x01 \x01(\v2\f.pos.ReceiptR\areceipt\"#\n" +
 	"\x11GetProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"<\n" +
 	"\x12GetProductResponse\x12&\n" +
-	"\aproduct\x18\x01 \x01(\v2\f.pos.ProductR\aproduct\"<\n" +
+	"\aproduct\x18\x01 \x01(\v2\f.pos.ProductR\aproduct\"\x86\x02\n" +
+	"\rInventorySeed\x12&\n" +
+	"\x0fproduct_type_id\x18\x01 \x01(\x05R\rproductTypeId\x12\x1f\n" +
+	"\vsupplier_id\x18\x02 \x01(\x05R\n" +
+	"supplierId\x12+\n" +
+	"\x0funit_of_measure\x18\x03 \x01(\tH\x00R\runitOfMeasure\x88\x01\x01\x12!\n" +
+	"\fwarehouse_id\x18\x04 \x01(\x05R\vwarehouseId\x12)\n" +
+	"\x10initial_quantity\x18\x05 \x01(\x05R\x0finitialQuantity\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\x03R\tcreatedByB\x12\n" +
+	"\x10_unit_of_measure\"\xc3\x03\n" +
+	"\x14CreateProductRequest\x12!\n" +
+	"\fproduct_code\x18\x01 \x01(\tR\vproductCode\x12!\n" +
+	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12#\n" +
+	"\rproduct_price\x18\x03 \x01(\tR\fproductPrice\x12\x1d\n" +
+	"\n" +
+	"cost_price\x18\x04 \x01(\tR\tcostPrice\x12-\n" +
+	"\x10product_group_id\x18\x05 \x01(\x05H\x00R\x0eproductGroupId\x88\x01\x01\x12/\n" +
+	"\x13commission_eligible\x18\x06 \x01(\bR\x12commissionEligible\x12:\n" +
+	"\x19requires_service_employee\x18\a \x01(\bR\x17requiresServiceEmployee\x12\x1d\n" +
+	"\n" +
+	"tax_exempt\x18\b \x01(\bR\ttaxExempt\x12>\n" +
+	"\x0einventory_seed\x18\t \x01(\v2\x12.pos.InventorySeedH\x01R\rinventorySeed\x88\x01\x01B\x13\n" +
+	"\x11_product_group_idB\x11\n" +
+	"\x0f_inventory_seed\"\xfc\x01\n" +
+	"\x15CreateProductResponse\x12&\n" +
+	"\aproduct\x18\x01 \x01(\v2\f.pos.ProductR\aproduct\x12)\n" +
+	"\x10inventory_seeded\x18\x02 \x01(\bR\x0finventorySeeded\x125\n" +
+	"\x14inventory_product_id\x18\x03 \x01(\x05H\x00R\x12inventoryProductId\x88\x01\x01\x12,\n" +
+	"\x0finventory_error\x18\x04 \x01(\tH\x01R\x0einventoryError\x88\x01\x01B\x17\n" +
+	"\x15_inventory_product_idB\x12\n" +
+	"\x10_inventory_error\"<\n" +
 	"\x17GetProductByCodeRequest\x12!\n" +
 	"\fproduct_code\x18\x01 \x01(\tR\vproductCode\"B\n" +
 	"\x18GetProductByCodeResponse\x12&\n" +
-	"\aproduct\x18\x01 \x01(\v2\f.pos.ProductR\aproduct\"\xf7\x01\n" +
+	"\aproduct\x18\x01 \x01(\v2\f.pos.ProductR\aproduct\"\xb9\x02\n" +
 	"\x13ListProductsRequest\x126\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x16.pos.PaginationRequestR\n" +
@@ -3801,16 +7309,97 @@ const file_pos_pos_service_proto_rawDesc = "" +
 	"\tis_active\x18\x02 \x01(\bH\x00R\bisActive\x88\x01\x01\x12-\n" +
 	"\x10product_group_id\x18\x03 \x01(\x05H\x01R\x0eproductGroupId\x88\x01\x01\x12$\n" +
 	"\vsearch_term\x18\x04 \x01(\tH\x02R\n" +
-	"searchTerm\x88\x01\x01B\f\n" +
+	"searchTerm\x88\x01\x01\x12,\n" +
+	"\x0finclude_deleted\x18\x05 \x01(\bH\x03R\x0eincludeDeleted\x88\x01\x01B\f\n" +
 	"\n" +
 	"_is_activeB\x13\n" +
 	"\x11_product_group_idB\x0e\n" +
-	"\f_search_term\"y\n" +
+	"\f_search_termB\x12\n" +
+	"\x10_include_deleted\"y\n" +
 	"\x14ListProductsResponse\x12(\n" +
 	"\bproducts\x18\x01 \x03(\v2\f.pos.ProductR\bproducts\x127\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x17.pos.PaginationResponseR\n" +
-	"pagination\"\xc3\x01\n" +
+	"pagination\"v\n" +
+	"\x1dGetProductPriceHistoryRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x05R\tproductId\x126\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x16.pos.PaginationRequestR\n" +
+	"pagination\"\x98\x01\n" +
+	"\x1eGetProductPriceHistoryResponse\x12=\n" +
+	"\rprice_history\x18\x01 \x03(\v2\x18.pos.ProductPriceHistoryR\fpriceHistory\x127\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x17.pos.PaginationResponseR\n" +
+	"pagination\"\xb6\x01\n" +
+	"\x1aSchedulePriceChangeRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x05R\tproductId\x12\x1b\n" +
+	"\tnew_price\x18\x02 \x01(\tR\bnewPrice\x12=\n" +
+	"\feffective_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x04 \x01(\x03R\tcreatedBy\"b\n" +
+	"\x1bSchedulePriceChangeResponse\x12C\n" +
+	"\x0fscheduled_price\x18\x01 \x01(\v2\x1a.pos.ProductScheduledPriceR\x0escheduledPrice\"\xba\x01\n" +
+	"\x1aListScheduledPricesRequest\x126\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x16.pos.PaginationRequestR\n" +
+	"pagination\x12\"\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x05H\x00R\tproductId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"is_applied\x18\x03 \x01(\bH\x01R\tisApplied\x88\x01\x01B\r\n" +
+	"\v_product_idB\r\n" +
+	"\v_is_applied\"\x9d\x01\n" +
+	"\x1bListScheduledPricesResponse\x12E\n" +
+	"\x10scheduled_prices\x18\x01 \x03(\v2\x1a.pos.ProductScheduledPriceR\x0fscheduledPrices\x127\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x17.pos.PaginationResponseR\n" +
+	"pagination\"S\n" +
+	"\x15ImportProductsRequest\x12\x19\n" +
+	"\bcsv_data\x18\x01 \x01(\fR\acsvData\x12\x1f\n" +
+	"\vimported_by\x18\x02 \x01(\x03R\n" +
+	"importedBy\"\xd2\x01\n" +
+	"\x16ProductImportRowResult\x12\x1d\n" +
+	"\n" +
+	"row_number\x18\x01 \x01(\x05R\trowNumber\x12!\n" +
+	"\fproduct_code\x18\x02 \x01(\tR\vproductCode\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12\x19\n" +
+	"\x05error\x18\x04 \x01(\tH\x00R\x05error\x88\x01\x01\x12+\n" +
+	"\aproduct\x18\x05 \x01(\v2\f.pos.ProductH\x01R\aproduct\x88\x01\x01B\b\n" +
+	"\x06_errorB\n" +
+	"\n" +
+	"\b_product\"\x95\x01\n" +
+	"\x16ImportProductsResponse\x125\n" +
+	"\aresults\x18\x01 \x03(\v2\x1b.pos.ProductImportRowResultR\aresults\x12#\n" +
+	"\rsuccess_count\x18\x02 \x01(\x05R\fsuccessCount\x12\x1f\n" +
+	"\verror_count\x18\x03 \x01(\x05R\n" +
+	"errorCount\"0\n" +
+	"\rProductIdList\x12\x1f\n" +
+	"\vproduct_ids\x18\x01 \x03(\x05R\n" +
+	"productIds\"\xae\x02\n" +
+	"\x17BulkUpdatePricesRequest\x125\n" +
+	"\vproduct_ids\x18\x01 \x01(\v2\x12.pos.ProductIdListH\x00R\n" +
+	"productIds\x12*\n" +
+	"\x10product_group_id\x18\x02 \x01(\x05H\x00R\x0eproductGroupId\x12#\n" +
+	"\fall_products\x18\x03 \x01(\bH\x00R\vallProducts\x121\n" +
+	"\toperation\x18\x04 \x01(\x0e2\x13.pos.PriceOperationR\toperation\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\tR\x05value\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\a \x01(\x03R\tchangedByB\n" +
+	"\n" +
+	"\bselector\"\x90\x01\n" +
+	"\x12ProductPriceChange\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x05R\tproductId\x12!\n" +
+	"\fproduct_code\x18\x02 \x01(\tR\vproductCode\x12\x1b\n" +
+	"\told_price\x18\x03 \x01(\tR\boldPrice\x12\x1b\n" +
+	"\tnew_price\x18\x04 \x01(\tR\bnewPrice\"\x98\x01\n" +
+	"\x18BulkUpdatePricesResponse\x12<\n" +
+	"\rprice_changes\x18\x01 \x03(\v2\x17.pos.ProductPriceChangeR\fpriceChanges\x12%\n" +
+	"\x0eaffected_count\x18\x02 \x01(\x05R\raffectedCount\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"\xc3\x01\n" +
 	"\x18ListProductGroupsRequest\x126\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x16.pos.PaginationRequestR\n" +
@@ -3824,17 +7413,19 @@ const file_pos_pos_service_proto_rawDesc = "" +
 	"\x0eproduct_groups\x18\x01 \x03(\v2\x11.pos.ProductGroupR\rproductGroups\x127\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x17.pos.PaginationResponseR\n" +
-	"pagination\"\xb1\x01\n" +
+	"pagination\"\xf3\x01\n" +
 	"\x14ListDiscountsRequest\x126\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x16.pos.PaginationRequestR\n" +
 	"pagination\x12 \n" +
 	"\tis_active\x18\x02 \x01(\bH\x00R\bisActive\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"product_id\x18\x03 \x01(\x05H\x01R\tproductId\x88\x01\x01B\f\n" +
+	"product_id\x18\x03 \x01(\x05H\x01R\tproductId\x88\x01\x01\x12,\n" +
+	"\x0finclude_deleted\x18\x04 \x01(\bH\x02R\x0eincludeDeleted\x88\x01\x01B\f\n" +
 	"\n" +
 	"_is_activeB\r\n" +
-	"\v_product_id\"}\n" +
+	"\v_product_idB\x12\n" +
+	"\x10_include_deleted\"}\n" +
 	"\x15ListDiscountsResponse\x12+\n" +
 	"\tdiscounts\x18\x01 \x03(\v2\r.pos.DiscountR\tdiscounts\x127\n" +
 	"\n" +
@@ -3875,8 +7466,13 @@ const file_pos_pos_service_proto_rawDesc = "" +
 	"\x19DISCOUNT_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18DISCOUNT_TYPE_PERCENTAGE\x10\x01\x12\x1e\n" +
 	"\x1aDISCOUNT_TYPE_FIXED_AMOUNT\x10\x02\x12\x1d\n" +
-	"\x19DISCOUNT_TYPE_BUY_X_GET_Y\x10\x032\xd8\n" +
-	"\n" +
+	"\x19DISCOUNT_TYPE_BUY_X_GET_Y\x10\x03*\xbb\x01\n" +
+	"\x0ePriceOperation\x12\x1f\n" +
+	"\x1bPRICE_OPERATION_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13PRICE_OPERATION_SET\x10\x01\x12$\n" +
+	" PRICE_OPERATION_INCREASE_PERCENT\x10\x02\x12$\n" +
+	" PRICE_OPERATION_DECREASE_PERCENT\x10\x03\x12#\n" +
+	"\x1fPRICE_OPERATION_SET_COST_MARGIN\x10\x042\xb5\x12\n" +
 	"\n" +
 	"POSService\x12=\n" +
 	"\n" +
@@ -3887,16 +7483,29 @@ const file_pos_pos_service_proto_rawDesc = "" +
 	"\rApplyDiscount\x12\x19.pos.ApplyDiscountRequest\x1a\x1a.pos.ApplyDiscountResponse\x12@\n" +
 	"\vCreateOrder\x12\x17.pos.CreateOrderRequest\x1a\x18.pos.CreateOrderResponse\x12X\n" +
 	"\x13CreateOrderFromCart\x12\x1f.pos.CreateOrderFromCartRequest\x1a .pos.CreateOrderFromCartResponse\x127\n" +
-	"\bGetOrder\x12\x14.pos.GetOrderRequest\x1a\x15.pos.GetOrderResponse\x12=\n" +
+	"\bGetOrder\x12\x14.pos.GetOrderRequest\x1a\x15.pos.GetOrderResponse\x12g\n" +
+	"\x18GetOrderByDocumentNumber\x12$.pos.GetOrderByDocumentNumberRequest\x1a%.pos.GetOrderByDocumentNumberResponse\x12I\n" +
+	"\x0eGetOrderStatus\x12\x1a.pos.GetOrderStatusRequest\x1a\x1b.pos.GetOrderStatusResponse\x12=\n" +
 	"\n" +
 	"ListOrders\x12\x16.pos.ListOrdersRequest\x1a\x17.pos.ListOrdersResponse\x12:\n" +
 	"\tVoidOrder\x12\x15.pos.VoidOrderRequest\x1a\x16.pos.VoidOrderResponse\x12@\n" +
-	"\vReturnOrder\x12\x17.pos.ReturnOrderRequest\x1a\x18.pos.ReturnOrderResponse\x12I\n" +
+	"\vReturnOrder\x12\x17.pos.ReturnOrderRequest\x1a\x18.pos.ReturnOrderResponse\x12E\n" +
+	"\x11StreamOrderEvents\x12\x1d.pos.StreamOrderEventsRequest\x1a\x0f.pos.OrderEvent0\x01\x12I\n" +
 	"\x0eProcessPayment\x12\x1a.pos.ProcessPaymentRequest\x1a\x1b.pos.ProcessPaymentResponse\x12=\n" +
 	"\n" +
+	"GetReceipt\x12\x16.pos.GetReceiptRequest\x1a\x17.pos.GetReceiptResponse\x12R\n" +
+	"\x11GetSalesByProduct\x12\x1d.pos.GetSalesByProductRequest\x1a\x1e.pos.GetSalesByProductResponse\x12R\n" +
+	"\x11GetSalesByCashier\x12\x1d.pos.GetSalesByCashierRequest\x1a\x1e.pos.GetSalesByCashierResponse\x12F\n" +
+	"\rCreateProduct\x12\x19.pos.CreateProductRequest\x1a\x1a.pos.CreateProductResponse\x12=\n" +
+	"\n" +
 	"GetProduct\x12\x16.pos.GetProductRequest\x1a\x17.pos.GetProductResponse\x12O\n" +
 	"\x10GetProductByCode\x12\x1c.pos.GetProductByCodeRequest\x1a\x1d.pos.GetProductByCodeResponse\x12C\n" +
-	"\fListProducts\x12\x18.pos.ListProductsRequest\x1a\x19.pos.ListProductsResponse\x12R\n" +
+	"\fListProducts\x12\x18.pos.ListProductsRequest\x1a\x19.pos.ListProductsResponse\x12a\n" +
+	"\x16GetProductPriceHistory\x12\".pos.GetProductPriceHistoryRequest\x1a#.pos.GetProductPriceHistoryResponse\x12X\n" +
+	"\x13SchedulePriceChange\x12\x1f.pos.SchedulePriceChangeRequest\x1a .pos.SchedulePriceChangeResponse\x12X\n" +
+	"\x13ListScheduledPrices\x12\x1f.pos.ListScheduledPricesRequest\x1a .pos.ListScheduledPricesResponse\x12I\n" +
+	"\x0eImportProducts\x12\x1a.pos.ImportProductsRequest\x1a\x1b.pos.ImportProductsResponse\x12O\n" +
+	"\x10BulkUpdatePrices\x12\x1c.pos.BulkUpdatePricesRequest\x1a\x1d.pos.BulkUpdatePricesResponse\x12R\n" +
 	"\x11ListProductGroups\x12\x1d.pos.ListProductGroupsRequest\x1a\x1e.pos.ListProductGroupsResponse\x12F\n" +
 	"\rListDiscounts\x12\x19.pos.ListDiscountsRequest\x1a\x1a.pos.ListDiscountsResponse\x12O\n" +
 	"\x10ValidateDiscount\x12\x1c.pos.ValidateDiscountRequest\x1a\x1d.pos.ValidateDiscountResponse\x12O\n" +
@@ -3914,171 +7523,283 @@ func file_pos_pos_service_proto_rawDescGZIP() []byte {
 	return file_pos_pos_service_proto_rawDescData
 }
 
-var file_pos_pos_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_pos_pos_service_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
+var file_pos_pos_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_pos_pos_service_proto_msgTypes = make([]protoimpl.MessageInfo, 89)
 var file_pos_pos_service_proto_goTypes = []any{
-	(DocumentType)(0),                   // 0: pos.DocumentType
-	(PaidStatus)(0),                     // 1: pos.PaidStatus
-	(DiscountType)(0),                   // 2: pos.DiscountType
-	(*PaginationRequest)(nil),           // 3: pos.PaginationRequest
-	(*PaginationResponse)(nil),          // 4: pos.PaginationResponse
-	(*DateRange)(nil),                   // 5: pos.DateRange
-	(*OrderDocument)(nil),               // 6: pos.OrderDocument
-	(*OrderItem)(nil),                   // 7: pos.OrderItem
-	(*PaymentType)(nil),                 // 8: pos.PaymentType
-	(*Discount)(nil),                    // 9: pos.Discount
-	(*Product)(nil),                     // 10: pos.Product
-	(*ProductGroup)(nil),                // 11: pos.ProductGroup
-	(*Cart)(nil),                        // 12: pos.Cart
-	(*CartItem)(nil),                    // 13: pos.CartItem
-	(*CreateCartRequest)(nil),           // 14: pos.CreateCartRequest
-	(*CreateCartResponse)(nil),          // 15: pos.CreateCartResponse
-	(*AddItemToCartRequest)(nil),        // 16: pos.AddItemToCartRequest
-	(*AddItemToCartResponse)(nil),       // 17: pos.AddItemToCartResponse
-	(*RemoveItemFromCartRequest)(nil),   // 18: pos.RemoveItemFromCartRequest
-	(*RemoveItemFromCartResponse)(nil),  // 19: pos.RemoveItemFromCartResponse
-	(*ApplyDiscountRequest)(nil),        // 20: pos.ApplyDiscountRequest
-	(*ApplyDiscountResponse)(nil),       // 21: pos.ApplyDiscountResponse
-	(*GetCartRequest)(nil),              // 22: pos.GetCartRequest
-	(*GetCartResponse)(nil),             // 23: pos.GetCartResponse
-	(*CreateOrderFromCartRequest)(nil),  // 24: pos.CreateOrderFromCartRequest
-	(*CreateOrderFromCartResponse)(nil), // 25: pos.CreateOrderFromCartResponse
-	(*CreateOrderRequest)(nil),          // 26: pos.CreateOrderRequest
-	(*CreateOrderItemRequest)(nil),      // 27: pos.CreateOrderItemRequest
-	(*CreateOrderResponse)(nil),         // 28: pos.CreateOrderResponse
-	(*GetOrderRequest)(nil),             // 29: pos.GetOrderRequest
-	(*GetOrderResponse)(nil),            // 30: pos.GetOrderResponse
-	(*ListOrdersRequest)(nil),           // 31: pos.ListOrdersRequest
-	(*ListOrdersResponse)(nil),          // 32: pos.ListOrdersResponse
-	(*ProcessPaymentRequest)(nil),       // 33: pos.ProcessPaymentRequest
-	(*ProcessPaymentResponse)(nil),      // 34: pos.ProcessPaymentResponse
-	(*VoidOrderRequest)(nil),            // 35: pos.VoidOrderRequest
-	(*VoidOrderResponse)(nil),           // 36: pos.VoidOrderResponse
-	(*ReturnOrderRequest)(nil),          // 37: pos.ReturnOrderRequest
-	(*ReturnOrderResponse)(nil),         // 38: pos.ReturnOrderResponse
-	(*GetProductRequest)(nil),           // 39: pos.GetProductRequest
-	(*GetProductResponse)(nil),          // 40: pos.GetProductResponse
-	(*GetProductByCodeRequest)(nil),     // 41: pos.GetProductByCodeRequest
-	(*GetProductByCodeResponse)(nil),    // 42: pos.GetProductByCodeResponse
-	(*ListProductsRequest)(nil),         // 43: pos.ListProductsRequest
-	(*ListProductsResponse)(nil),        // 44: pos.ListProductsResponse
-	(*ListProductGroupsRequest)(nil),    // 45: pos.ListProductGroupsRequest
-	(*ListProductGroupsResponse)(nil),   // 46: pos.ListProductGroupsResponse
-	(*ListDiscountsRequest)(nil),        // 47: pos.ListDiscountsRequest
-	(*ListDiscountsResponse)(nil),       // 48: pos.ListDiscountsResponse
-	(*ValidateDiscountRequest)(nil),     // 49: pos.ValidateDiscountRequest
-	(*ValidateDiscountResponse)(nil),    // 50: pos.ValidateDiscountResponse
-	(*ListPaymentTypesRequest)(nil),     // 51: pos.ListPaymentTypesRequest
-	(*ListPaymentTypesResponse)(nil),    // 52: pos.ListPaymentTypesResponse
-	(*timestamppb.Timestamp)(nil),       // 53: google.protobuf.Timestamp
+	(DocumentType)(0),                        // 0: pos.DocumentType
+	(PaidStatus)(0),                          // 1: pos.PaidStatus
+	(DiscountType)(0),                        // 2: pos.DiscountType
+	(PriceOperation)(0),                      // 3: pos.PriceOperation
+	(*PaginationRequest)(nil),                // 4: pos.PaginationRequest
+	(*PaginationResponse)(nil),               // 5: pos.PaginationResponse
+	(*DateRange)(nil),                        // 6: pos.DateRange
+	(*OrderDocument)(nil),                    // 7: pos.OrderDocument
+	(*OrderTaxLine)(nil),                     // 8: pos.OrderTaxLine
+	(*OrderItem)(nil),                        // 9: pos.OrderItem
+	(*ServingEmployeeSplit)(nil),             // 10: pos.ServingEmployeeSplit
+	(*PaymentType)(nil),                      // 11: pos.PaymentType
+	(*Discount)(nil),                         // 12: pos.Discount
+	(*Product)(nil),                          // 13: pos.Product
+	(*ProductPriceHistory)(nil),              // 14: pos.ProductPriceHistory
+	(*ProductScheduledPrice)(nil),            // 15: pos.ProductScheduledPrice
+	(*ProductGroup)(nil),                     // 16: pos.ProductGroup
+	(*Cart)(nil),                             // 17: pos.Cart
+	(*CartItem)(nil),                         // 18: pos.CartItem
+	(*CreateCartRequest)(nil),                // 19: pos.CreateCartRequest
+	(*CreateCartResponse)(nil),               // 20: pos.CreateCartResponse
+	(*AddItemToCartRequest)(nil),             // 21: pos.AddItemToCartRequest
+	(*AddItemToCartResponse)(nil),            // 22: pos.AddItemToCartResponse
+	(*RemoveItemFromCartRequest)(nil),        // 23: pos.RemoveItemFromCartRequest
+	(*RemoveItemFromCartResponse)(nil),       // 24: pos.RemoveItemFromCartResponse
+	(*ApplyDiscountRequest)(nil),             // 25: pos.ApplyDiscountRequest
+	(*ApplyDiscountResponse)(nil),            // 26: pos.ApplyDiscountResponse
+	(*GetCartRequest)(nil),                   // 27: pos.GetCartRequest
+	(*GetCartResponse)(nil),                  // 28: pos.GetCartResponse
+	(*CreateOrderFromCartRequest)(nil),       // 29: pos.CreateOrderFromCartRequest
+	(*CreateOrderFromCartResponse)(nil),      // 30: pos.CreateOrderFromCartResponse
+	(*CreateOrderRequest)(nil),               // 31: pos.CreateOrderRequest
+	(*CreateOrderItemRequest)(nil),           // 32: pos.CreateOrderItemRequest
+	(*CreateOrderResponse)(nil),              // 33: pos.CreateOrderResponse
+	(*GetOrderRequest)(nil),                  // 34: pos.GetOrderRequest
+	(*GetOrderResponse)(nil),                 // 35: pos.GetOrderResponse
+	(*GetOrderStatusRequest)(nil),            // 36: pos.GetOrderStatusRequest
+	(*GetOrderStatusResponse)(nil),           // 37: pos.GetOrderStatusResponse
+	(*GetOrderByDocumentNumberRequest)(nil),  // 38: pos.GetOrderByDocumentNumberRequest
+	(*GetOrderByDocumentNumberResponse)(nil), // 39: pos.GetOrderByDocumentNumberResponse
+	(*ListOrdersRequest)(nil),                // 40: pos.ListOrdersRequest
+	(*ListOrdersResponse)(nil),               // 41: pos.ListOrdersResponse
+	(*OrderEvent)(nil),                       // 42: pos.OrderEvent
+	(*StreamOrderEventsRequest)(nil),         // 43: pos.StreamOrderEventsRequest
+	(*StoreCredit)(nil),                      // 44: pos.StoreCredit
+	(*ProcessPaymentRequest)(nil),            // 45: pos.ProcessPaymentRequest
+	(*ProcessPaymentResponse)(nil),           // 46: pos.ProcessPaymentResponse
+	(*VoidOrderRequest)(nil),                 // 47: pos.VoidOrderRequest
+	(*VoidOrderResponse)(nil),                // 48: pos.VoidOrderResponse
+	(*ReturnOrderRequest)(nil),               // 49: pos.ReturnOrderRequest
+	(*ReturnOrderResponse)(nil),              // 50: pos.ReturnOrderResponse
+	(*GetSalesByProductRequest)(nil),         // 51: pos.GetSalesByProductRequest
+	(*ProductSales)(nil),                     // 52: pos.ProductSales
+	(*GetSalesByProductResponse)(nil),        // 53: pos.GetSalesByProductResponse
+	(*GetSalesByCashierRequest)(nil),         // 54: pos.GetSalesByCashierRequest
+	(*CashierSales)(nil),                     // 55: pos.CashierSales
+	(*GetSalesByCashierResponse)(nil),        // 56: pos.GetSalesByCashierResponse
+	(*Receipt)(nil),                          // 57: pos.Receipt
+	(*ReceiptLine)(nil),                      // 58: pos.ReceiptLine
+	(*ReceiptDiscountLine)(nil),              // 59: pos.ReceiptDiscountLine
+	(*ReceiptTender)(nil),                    // 60: pos.ReceiptTender
+	(*GetReceiptRequest)(nil),                // 61: pos.GetReceiptRequest
+	(*GetReceiptResponse)(nil),               // 62: pos.GetReceiptResponse
+	(*GetProductRequest)(nil),                // 63: pos.GetProductRequest
+	(*GetProductResponse)(nil),               // 64: pos.GetProductResponse
+	(*InventorySeed)(nil),                    // 65: pos.InventorySeed
+	(*CreateProductRequest)(nil),             // 66: pos.CreateProductRequest
+	(*CreateProductResponse)(nil),            // 67: pos.CreateProductResponse
+	(*GetProductByCodeRequest)(nil),          // 68: pos.GetProductByCodeRequest
+	(*GetProductByCodeResponse)(nil),         // 69: pos.GetProductByCodeResponse
+	(*ListProductsRequest)(nil),              // 70: pos.ListProductsRequest
+	(*ListProductsResponse)(nil),             // 71: pos.ListProductsResponse
+	(*GetProductPriceHistoryRequest)(nil),    // 72: pos.GetProductPriceHistoryRequest
+	(*GetProductPriceHistoryResponse)(nil),   // 73: pos.GetProductPriceHistoryResponse
+	(*SchedulePriceChangeRequest)(nil),       // 74: pos.SchedulePriceChangeRequest
+	(*SchedulePriceChangeResponse)(nil),      // 75: pos.SchedulePriceChangeResponse
+	(*ListScheduledPricesRequest)(nil),       // 76: pos.ListScheduledPricesRequest
+	(*ListScheduledPricesResponse)(nil),      // 77: pos.ListScheduledPricesResponse
+	(*ImportProductsRequest)(nil),            // 78: pos.ImportProductsRequest
+	(*ProductImportRowResult)(nil),           // 79: pos.ProductImportRowResult
+	(*ImportProductsResponse)(nil),           // 80: pos.ImportProductsResponse
+	(*ProductIdList)(nil),                    // 81: pos.ProductIdList
+	(*BulkUpdatePricesRequest)(nil),          // 82: pos.BulkUpdatePricesRequest
+	(*ProductPriceChange)(nil),               // 83: pos.ProductPriceChange
+	(*BulkUpdatePricesResponse)(nil),         // 84: pos.BulkUpdatePricesResponse
+	(*ListProductGroupsRequest)(nil),         // 85: pos.ListProductGroupsRequest
+	(*ListProductGroupsResponse)(nil),        // 86: pos.ListProductGroupsResponse
+	(*ListDiscountsRequest)(nil),             // 87: pos.ListDiscountsRequest
+	(*ListDiscountsResponse)(nil),            // 88: pos.ListDiscountsResponse
+	(*ValidateDiscountRequest)(nil),          // 89: pos.ValidateDiscountRequest
+	(*ValidateDiscountResponse)(nil),         // 90: pos.ValidateDiscountResponse
+	(*ListPaymentTypesRequest)(nil),          // 91: pos.ListPaymentTypesRequest
+	(*ListPaymentTypesResponse)(nil),         // 92: pos.ListPaymentTypesResponse
+	(*timestamppb.Timestamp)(nil),            // 93: google.protobuf.Timestamp
 }
 var file_pos_pos_service_proto_depIdxs = []int32{
-	53, // 0: pos.OrderDocument.orders_date:type_name -> google.protobuf.Timestamp
-	0,  // 1: pos.OrderDocument.document_type:type_name -> pos.DocumentType
-	1,  // 2: pos.OrderDocument.paid_status:type_name -> pos.PaidStatus
-	53, // 3: pos.OrderDocument.created_at:type_name -> google.protobuf.Timestamp
-	53, // 4: pos.OrderDocument.updated_at:type_name -> google.protobuf.Timestamp
-	7,  // 5: pos.OrderDocument.order_items:type_name -> pos.OrderItem
-	8,  // 6: pos.OrderDocument.payment_type:type_name -> pos.PaymentType
-	53, // 7: pos.OrderItem.created_at:type_name -> google.protobuf.Timestamp
-	10, // 8: pos.OrderItem.product:type_name -> pos.Product
-	9,  // 9: pos.OrderItem.discount:type_name -> pos.Discount
-	53, // 10: pos.PaymentType.created_at:type_name -> google.protobuf.Timestamp
-	53, // 11: pos.PaymentType.updated_at:type_name -> google.protobuf.Timestamp
-	2,  // 12: pos.Discount.discount_type:type_name -> pos.DiscountType
-	53, // 13: pos.Discount.valid_from:type_name -> google.protobuf.Timestamp
-	53, // 14: pos.Discount.valid_until:type_name -> google.protobuf.Timestamp
-	53, // 15: pos.Discount.created_at:type_name -> google.protobuf.Timestamp
-	53, // 16: pos.Discount.updated_at:type_name -> google.protobuf.Timestamp
-	10, // 17: pos.Discount.product:type_name -> pos.Product
-	11, // 18: pos.Discount.product_group:type_name -> pos.ProductGroup
-	53, // 19: pos.Product.created_at:type_name -> google.protobuf.Timestamp
-	53, // 20: pos.Product.updated_at:type_name -> google.protobuf.Timestamp
-	11, // 21: pos.Product.product_group:type_name -> pos.ProductGroup
-	53, // 22: pos.ProductGroup.created_at:type_name -> google.protobuf.Timestamp
-	53, // 23: pos.ProductGroup.updated_at:type_name -> google.protobuf.Timestamp
-	11, // 24: pos.ProductGroup.parent_group:type_name -> pos.ProductGroup
-	11, // 25: pos.ProductGroup.child_groups:type_name -> pos.ProductGroup
-	10, // 26: pos.ProductGroup.products:type_name -> pos.Product
-	13, // 27: pos.Cart.items:type_name -> pos.CartItem
-	53, // 28: pos.Cart.created_at:type_name -> google.protobuf.Timestamp
-	53, // 29: pos.Cart.updated_at:type_name -> google.protobuf.Timestamp
-	10, // 30: pos.CartItem.product:type_name -> pos.Product
-	9,  // 31: pos.CartItem.discount:type_name -> pos.Discount
-	12, // 32: pos.CreateCartResponse.cart:type_name -> pos.Cart
-	12, // 33: pos.AddItemToCartResponse.cart:type_name -> pos.Cart
-	12, // 34: pos.RemoveItemFromCartResponse.cart:type_name -> pos.Cart
-	12, // 35: pos.ApplyDiscountResponse.cart:type_name -> pos.Cart
-	12, // 36: pos.GetCartResponse.cart:type_name -> pos.Cart
-	6,  // 37: pos.CreateOrderFromCartResponse.order_document:type_name -> pos.OrderDocument
-	0,  // 38: pos.CreateOrderRequest.document_type:type_name -> pos.DocumentType
-	27, // 39: pos.CreateOrderRequest.order_items:type_name -> pos.CreateOrderItemRequest
-	6,  // 40: pos.CreateOrderResponse.order_document:type_name -> pos.OrderDocument
-	6,  // 41: pos.GetOrderResponse.order_document:type_name -> pos.OrderDocument
-	3,  // 42: pos.ListOrdersRequest.pagination:type_name -> pos.PaginationRequest
-	0,  // 43: pos.ListOrdersRequest.document_type:type_name -> pos.DocumentType
-	1,  // 44: pos.ListOrdersRequest.paid_status:type_name -> pos.PaidStatus
-	5,  // 45: pos.ListOrdersRequest.date_range:type_name -> pos.DateRange
-	6,  // 46: pos.ListOrdersResponse.order_documents:type_name -> pos.OrderDocument
-	4,  // 47: pos.ListOrdersResponse.pagination:type_name -> pos.PaginationResponse
-	6,  // 48: pos.ProcessPaymentResponse.order_document:type_name -> pos.OrderDocument
-	6,  // 49: pos.VoidOrderResponse.order_document:type_name -> pos.OrderDocument
-	6,  // 50: pos.ReturnOrderResponse.return_document:type_name -> pos.OrderDocument
-	10, // 51: pos.GetProductResponse.product:type_name -> pos.Product
-	10, // 52: pos.GetProductByCodeResponse.product:type_name -> pos.Product
-	3,  // 53: pos.ListProductsRequest.pagination:type_name -> pos.PaginationRequest
-	10, // 54: pos.ListProductsResponse.products:type_name -> pos.Product
-	4,  // 55: pos.ListProductsResponse.pagination:type_name -> pos.PaginationResponse
-	3,  // 56: pos.ListProductGroupsRequest.pagination:type_name -> pos.PaginationRequest
-	11, // 57: pos.ListProductGroupsResponse.product_groups:type_name -> pos.ProductGroup
-	4,  // 58: pos.ListProductGroupsResponse.pagination:type_name -> pos.PaginationResponse
-	3,  // 59: pos.ListDiscountsRequest.pagination:type_name -> pos.PaginationRequest
-	9,  // 60: pos.ListDiscountsResponse.discounts:type_name -> pos.Discount
-	4,  // 61: pos.ListDiscountsResponse.pagination:type_name -> pos.PaginationResponse
-	8,  // 62: pos.ListPaymentTypesResponse.payment_types:type_name -> pos.PaymentType
-	14, // 63: pos.POSService.CreateCart:input_type -> pos.CreateCartRequest
-	22, // 64: pos.POSService.GetCart:input_type -> pos.GetCartRequest
-	16, // 65: pos.POSService.AddItemToCart:input_type -> pos.AddItemToCartRequest
-	18, // 66: pos.POSService.RemoveItemFromCart:input_type -> pos.RemoveItemFromCartRequest
-	20, // 67: pos.POSService.ApplyDiscount:input_type -> pos.ApplyDiscountRequest
-	26, // 68: pos.POSService.CreateOrder:input_type -> pos.CreateOrderRequest
-	24, // 69: pos.POSService.CreateOrderFromCart:input_type -> pos.CreateOrderFromCartRequest
-	29, // 70: pos.POSService.GetOrder:input_type -> pos.GetOrderRequest
-	31, // 71: pos.POSService.ListOrders:input_type -> pos.ListOrdersRequest
-	35, // 72: pos.POSService.VoidOrder:input_type -> pos.VoidOrderRequest
-	37, // 73: pos.POSService.ReturnOrder:input_type -> pos.ReturnOrderRequest
-	33, // 74: pos.POSService.ProcessPayment:input_type -> pos.ProcessPaymentRequest
-	39, // 75: pos.POSService.GetProduct:input_type -> pos.GetProductRequest
-	41, // 76: pos.POSService.GetProductByCode:input_type -> pos.GetProductByCodeRequest
-	43, // 77: pos.POSService.ListProducts:input_type -> pos.ListProductsRequest
-	45, // 78: pos.POSService.ListProductGroups:input_type -> pos.ListProductGroupsRequest
-	47, // 79: pos.POSService.ListDiscounts:input_type -> pos.ListDiscountsRequest
-	49, // 80: pos.POSService.ValidateDiscount:input_type -> pos.ValidateDiscountRequest
-	51, // 81: pos.POSService.ListPaymentTypes:input_type -> pos.ListPaymentTypesRequest
-	15, // 82: pos.POSService.CreateCart:output_type -> pos.CreateCartResponse
-	23, // 83: pos.POSService.GetCart:output_type -> pos.GetCartResponse
-	17, // 84: pos.POSService.AddItemToCart:output_type -> pos.AddItemToCartResponse
-	19, // 85: pos.POSService.RemoveItemFromCart:output_type -> pos.RemoveItemFromCartResponse
-	21, // 86: pos.POSService.ApplyDiscount:output_type -> pos.ApplyDiscountResponse
-	28, // 87: pos.POSService.CreateOrder:output_type -> pos.CreateOrderResponse
-	25, // 88: pos.POSService.CreateOrderFromCart:output_type -> pos.CreateOrderFromCartResponse
-	30, // 89: pos.POSService.GetOrder:output_type -> pos.GetOrderResponse
-	32, // 90: pos.POSService.ListOrders:output_type -> pos.ListOrdersResponse
-	36, // 91: pos.POSService.VoidOrder:output_type -> pos.VoidOrderResponse
-	38, // 92: pos.POSService.ReturnOrder:output_type -> pos.ReturnOrderResponse
-	34, // 93: pos.POSService.ProcessPayment:output_type -> pos.ProcessPaymentResponse
-	40, // 94: pos.POSService.GetProduct:output_type -> pos.GetProductResponse
-	42, // 95: pos.POSService.GetProductByCode:output_type -> pos.GetProductByCodeResponse
-	44, // 96: pos.POSService.ListProducts:output_type -> pos.ListProductsResponse
-	46, // 97: pos.POSService.ListProductGroups:output_type -> pos.ListProductGroupsResponse
-	48, // 98: pos.POSService.ListDiscounts:output_type -> pos.ListDiscountsResponse
-	50, // 99: pos.POSService.ValidateDiscount:output_type -> pos.ValidateDiscountResponse
-	52, // 100: pos.POSService.ListPaymentTypes:output_type -> pos.ListPaymentTypesResponse
-	82, // [82:101] is the sub-list for method output_type
-	63, // [63:82] is the sub-list for method input_type
-	63, // [63:63] is the sub-list for extension type_name
-	63, // [63:63] is the sub-list for extension extendee
-	0,  // [0:63] is the sub-list for field type_name
+	93,  // 0: pos.OrderDocument.orders_date:type_name -> google.protobuf.Timestamp
+	0,   // 1: pos.OrderDocument.document_type:type_name -> pos.DocumentType
+	1,   // 2: pos.OrderDocument.paid_status:type_name -> pos.PaidStatus
+	93,  // 3: pos.OrderDocument.created_at:type_name -> google.protobuf.Timestamp
+	93,  // 4: pos.OrderDocument.updated_at:type_name -> google.protobuf.Timestamp
+	9,   // 5: pos.OrderDocument.order_items:type_name -> pos.OrderItem
+	11,  // 6: pos.OrderDocument.payment_type:type_name -> pos.PaymentType
+	8,   // 7: pos.OrderDocument.tax_lines:type_name -> pos.OrderTaxLine
+	93,  // 8: pos.OrderItem.created_at:type_name -> google.protobuf.Timestamp
+	13,  // 9: pos.OrderItem.product:type_name -> pos.Product
+	12,  // 10: pos.OrderItem.discount:type_name -> pos.Discount
+	10,  // 11: pos.OrderItem.serving_employee_splits:type_name -> pos.ServingEmployeeSplit
+	93,  // 12: pos.PaymentType.created_at:type_name -> google.protobuf.Timestamp
+	93,  // 13: pos.PaymentType.updated_at:type_name -> google.protobuf.Timestamp
+	2,   // 14: pos.Discount.discount_type:type_name -> pos.DiscountType
+	93,  // 15: pos.Discount.valid_from:type_name -> google.protobuf.Timestamp
+	93,  // 16: pos.Discount.valid_until:type_name -> google.protobuf.Timestamp
+	93,  // 17: pos.Discount.created_at:type_name -> google.protobuf.Timestamp
+	93,  // 18: pos.Discount.updated_at:type_name -> google.protobuf.Timestamp
+	13,  // 19: pos.Discount.product:type_name -> pos.Product
+	16,  // 20: pos.Discount.product_group:type_name -> pos.ProductGroup
+	93,  // 21: pos.Discount.deleted_at:type_name -> google.protobuf.Timestamp
+	93,  // 22: pos.Product.created_at:type_name -> google.protobuf.Timestamp
+	93,  // 23: pos.Product.updated_at:type_name -> google.protobuf.Timestamp
+	16,  // 24: pos.Product.product_group:type_name -> pos.ProductGroup
+	93,  // 25: pos.Product.deleted_at:type_name -> google.protobuf.Timestamp
+	93,  // 26: pos.ProductPriceHistory.changed_at:type_name -> google.protobuf.Timestamp
+	93,  // 27: pos.ProductScheduledPrice.effective_at:type_name -> google.protobuf.Timestamp
+	93,  // 28: pos.ProductScheduledPrice.applied_at:type_name -> google.protobuf.Timestamp
+	93,  // 29: pos.ProductScheduledPrice.created_at:type_name -> google.protobuf.Timestamp
+	93,  // 30: pos.ProductGroup.created_at:type_name -> google.protobuf.Timestamp
+	93,  // 31: pos.ProductGroup.updated_at:type_name -> google.protobuf.Timestamp
+	16,  // 32: pos.ProductGroup.parent_group:type_name -> pos.ProductGroup
+	16,  // 33: pos.ProductGroup.child_groups:type_name -> pos.ProductGroup
+	13,  // 34: pos.ProductGroup.products:type_name -> pos.Product
+	18,  // 35: pos.Cart.items:type_name -> pos.CartItem
+	93,  // 36: pos.Cart.created_at:type_name -> google.protobuf.Timestamp
+	93,  // 37: pos.Cart.updated_at:type_name -> google.protobuf.Timestamp
+	13,  // 38: pos.CartItem.product:type_name -> pos.Product
+	12,  // 39: pos.CartItem.discount:type_name -> pos.Discount
+	10,  // 40: pos.CartItem.serving_employee_splits:type_name -> pos.ServingEmployeeSplit
+	17,  // 41: pos.CreateCartResponse.cart:type_name -> pos.Cart
+	10,  // 42: pos.AddItemToCartRequest.serving_employee_splits:type_name -> pos.ServingEmployeeSplit
+	17,  // 43: pos.AddItemToCartResponse.cart:type_name -> pos.Cart
+	17,  // 44: pos.RemoveItemFromCartResponse.cart:type_name -> pos.Cart
+	17,  // 45: pos.ApplyDiscountResponse.cart:type_name -> pos.Cart
+	17,  // 46: pos.GetCartResponse.cart:type_name -> pos.Cart
+	7,   // 47: pos.CreateOrderFromCartResponse.order_document:type_name -> pos.OrderDocument
+	0,   // 48: pos.CreateOrderRequest.document_type:type_name -> pos.DocumentType
+	32,  // 49: pos.CreateOrderRequest.order_items:type_name -> pos.CreateOrderItemRequest
+	10,  // 50: pos.CreateOrderItemRequest.serving_employee_splits:type_name -> pos.ServingEmployeeSplit
+	7,   // 51: pos.CreateOrderResponse.order_document:type_name -> pos.OrderDocument
+	7,   // 52: pos.GetOrderResponse.order_document:type_name -> pos.OrderDocument
+	1,   // 53: pos.GetOrderStatusResponse.paid_status:type_name -> pos.PaidStatus
+	0,   // 54: pos.GetOrderStatusResponse.document_type:type_name -> pos.DocumentType
+	93,  // 55: pos.GetOrderStatusResponse.updated_at:type_name -> google.protobuf.Timestamp
+	7,   // 56: pos.GetOrderByDocumentNumberResponse.order_document:type_name -> pos.OrderDocument
+	4,   // 57: pos.ListOrdersRequest.pagination:type_name -> pos.PaginationRequest
+	0,   // 58: pos.ListOrdersRequest.document_type:type_name -> pos.DocumentType
+	1,   // 59: pos.ListOrdersRequest.paid_status:type_name -> pos.PaidStatus
+	6,   // 60: pos.ListOrdersRequest.date_range:type_name -> pos.DateRange
+	7,   // 61: pos.ListOrdersResponse.order_documents:type_name -> pos.OrderDocument
+	5,   // 62: pos.ListOrdersResponse.pagination:type_name -> pos.PaginationResponse
+	1,   // 63: pos.OrderEvent.paid_status:type_name -> pos.PaidStatus
+	93,  // 64: pos.OrderEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	93,  // 65: pos.StoreCredit.created_at:type_name -> google.protobuf.Timestamp
+	7,   // 66: pos.ProcessPaymentResponse.order_document:type_name -> pos.OrderDocument
+	44,  // 67: pos.ProcessPaymentResponse.store_credit:type_name -> pos.StoreCredit
+	7,   // 68: pos.VoidOrderResponse.order_document:type_name -> pos.OrderDocument
+	7,   // 69: pos.ReturnOrderResponse.return_document:type_name -> pos.OrderDocument
+	6,   // 70: pos.GetSalesByProductRequest.date_range:type_name -> pos.DateRange
+	4,   // 71: pos.GetSalesByProductRequest.pagination:type_name -> pos.PaginationRequest
+	52,  // 72: pos.GetSalesByProductResponse.product_sales:type_name -> pos.ProductSales
+	5,   // 73: pos.GetSalesByProductResponse.pagination:type_name -> pos.PaginationResponse
+	6,   // 74: pos.GetSalesByCashierRequest.date_range:type_name -> pos.DateRange
+	4,   // 75: pos.GetSalesByCashierRequest.pagination:type_name -> pos.PaginationRequest
+	55,  // 76: pos.GetSalesByCashierResponse.cashier_sales:type_name -> pos.CashierSales
+	5,   // 77: pos.GetSalesByCashierResponse.pagination:type_name -> pos.PaginationResponse
+	93,  // 78: pos.Receipt.orders_date:type_name -> google.protobuf.Timestamp
+	58,  // 79: pos.Receipt.lines:type_name -> pos.ReceiptLine
+	59,  // 80: pos.Receipt.discount_lines:type_name -> pos.ReceiptDiscountLine
+	60,  // 81: pos.Receipt.tenders:type_name -> pos.ReceiptTender
+	8,   // 82: pos.Receipt.tax_lines:type_name -> pos.OrderTaxLine
+	57,  // 83: pos.GetReceiptResponse.receipt:type_name -> pos.Receipt
+	13,  // 84: pos.GetProductResponse.product:type_name -> pos.Product
+	65,  // 85: pos.CreateProductRequest.inventory_seed:type_name -> pos.InventorySeed
+	13,  // 86: pos.CreateProductResponse.product:type_name -> pos.Product
+	13,  // 87: pos.GetProductByCodeResponse.product:type_name -> pos.Product
+	4,   // 88: pos.ListProductsRequest.pagination:type_name -> pos.PaginationRequest
+	13,  // 89: pos.ListProductsResponse.products:type_name -> pos.Product
+	5,   // 90: pos.ListProductsResponse.pagination:type_name -> pos.PaginationResponse
+	4,   // 91: pos.GetProductPriceHistoryRequest.pagination:type_name -> pos.PaginationRequest
+	14,  // 92: pos.GetProductPriceHistoryResponse.price_history:type_name -> pos.ProductPriceHistory
+	5,   // 93: pos.GetProductPriceHistoryResponse.pagination:type_name -> pos.PaginationResponse
+	93,  // 94: pos.SchedulePriceChangeRequest.effective_at:type_name -> google.protobuf.Timestamp
+	15,  // 95: pos.SchedulePriceChangeResponse.scheduled_price:type_name -> pos.ProductScheduledPrice
+	4,   // 96: pos.ListScheduledPricesRequest.pagination:type_name -> pos.PaginationRequest
+	15,  // 97: pos.ListScheduledPricesResponse.scheduled_prices:type_name -> pos.ProductScheduledPrice
+	5,   // 98: pos.ListScheduledPricesResponse.pagination:type_name -> pos.PaginationResponse
+	13,  // 99: pos.ProductImportRowResult.product:type_name -> pos.Product
+	79,  // 100: pos.ImportProductsResponse.results:type_name -> pos.ProductImportRowResult
+	81,  // 101: pos.BulkUpdatePricesRequest.product_ids:type_name -> pos.ProductIdList
+	3,   // 102: pos.BulkUpdatePricesRequest.operation:type_name -> pos.PriceOperation
+	83,  // 103: pos.BulkUpdatePricesResponse.price_changes:type_name -> pos.ProductPriceChange
+	4,   // 104: pos.ListProductGroupsRequest.pagination:type_name -> pos.PaginationRequest
+	16,  // 105: pos.ListProductGroupsResponse.product_groups:type_name -> pos.ProductGroup
+	5,   // 106: pos.ListProductGroupsResponse.pagination:type_name -> pos.PaginationResponse
+	4,   // 107: pos.ListDiscountsRequest.pagination:type_name -> pos.PaginationRequest
+	12,  // 108: pos.ListDiscountsResponse.discounts:type_name -> pos.Discount
+	5,   // 109: pos.ListDiscountsResponse.pagination:type_name -> pos.PaginationResponse
+	11,  // 110: pos.ListPaymentTypesResponse.payment_types:type_name -> pos.PaymentType
+	19,  // 111: pos.POSService.CreateCart:input_type -> pos.CreateCartRequest
+	27,  // 112: pos.POSService.GetCart:input_type -> pos.GetCartRequest
+	21,  // 113: pos.POSService.AddItemToCart:input_type -> pos.AddItemToCartRequest
+	23,  // 114: pos.POSService.RemoveItemFromCart:input_type -> pos.RemoveItemFromCartRequest
+	25,  // 115: pos.POSService.ApplyDiscount:input_type -> pos.ApplyDiscountRequest
+	31,  // 116: pos.POSService.CreateOrder:input_type -> pos.CreateOrderRequest
+	29,  // 117: pos.POSService.CreateOrderFromCart:input_type -> pos.CreateOrderFromCartRequest
+	34,  // 118: pos.POSService.GetOrder:input_type -> pos.GetOrderRequest
+	38,  // 119: pos.POSService.GetOrderByDocumentNumber:input_type -> pos.GetOrderByDocumentNumberRequest
+	36,  // 120: pos.POSService.GetOrderStatus:input_type -> pos.GetOrderStatusRequest
+	40,  // 121: pos.POSService.ListOrders:input_type -> pos.ListOrdersRequest
+	47,  // 122: pos.POSService.VoidOrder:input_type -> pos.VoidOrderRequest
+	49,  // 123: pos.POSService.ReturnOrder:input_type -> pos.ReturnOrderRequest
+	43,  // 124: pos.POSService.StreamOrderEvents:input_type -> pos.StreamOrderEventsRequest
+	45,  // 125: pos.POSService.ProcessPayment:input_type -> pos.ProcessPaymentRequest
+	61,  // 126: pos.POSService.GetReceipt:input_type -> pos.GetReceiptRequest
+	51,  // 127: pos.POSService.GetSalesByProduct:input_type -> pos.GetSalesByProductRequest
+	54,  // 128: pos.POSService.GetSalesByCashier:input_type -> pos.GetSalesByCashierRequest
+	66,  // 129: pos.POSService.CreateProduct:input_type -> pos.CreateProductRequest
+	63,  // 130: pos.POSService.GetProduct:input_type -> pos.GetProductRequest
+	68,  // 131: pos.POSService.GetProductByCode:input_type -> pos.GetProductByCodeRequest
+	70,  // 132: pos.POSService.ListProducts:input_type -> pos.ListProductsRequest
+	72,  // 133: pos.POSService.GetProductPriceHistory:input_type -> pos.GetProductPriceHistoryRequest
+	74,  // 134: pos.POSService.SchedulePriceChange:input_type -> pos.SchedulePriceChangeRequest
+	76,  // 135: pos.POSService.ListScheduledPrices:input_type -> pos.ListScheduledPricesRequest
+	78,  // 136: pos.POSService.ImportProducts:input_type -> pos.ImportProductsRequest
+	82,  // 137: pos.POSService.BulkUpdatePrices:input_type -> pos.BulkUpdatePricesRequest
+	85,  // 138: pos.POSService.ListProductGroups:input_type -> pos.ListProductGroupsRequest
+	87,  // 139: pos.POSService.ListDiscounts:input_type -> pos.ListDiscountsRequest
+	89,  // 140: pos.POSService.ValidateDiscount:input_type -> pos.ValidateDiscountRequest
+	91,  // 141: pos.POSService.ListPaymentTypes:input_type -> pos.ListPaymentTypesRequest
+	20,  // 142: pos.POSService.CreateCart:output_type -> pos.CreateCartResponse
+	28,  // 143: pos.POSService.GetCart:output_type -> pos.GetCartResponse
+	22,  // 144: pos.POSService.AddItemToCart:output_type -> pos.AddItemToCartResponse
+	24,  // 145: pos.POSService.RemoveItemFromCart:output_type -> pos.RemoveItemFromCartResponse
+	26,  // 146: pos.POSService.ApplyDiscount:output_type -> pos.ApplyDiscountResponse
+	33,  // 147: pos.POSService.CreateOrder:output_type -> pos.CreateOrderResponse
+	30,  // 148: pos.POSService.CreateOrderFromCart:output_type -> pos.CreateOrderFromCartResponse
+	35,  // 149: pos.POSService.GetOrder:output_type -> pos.GetOrderResponse
+	39,  // 150: pos.POSService.GetOrderByDocumentNumber:output_type -> pos.GetOrderByDocumentNumberResponse
+	37,  // 151: pos.POSService.GetOrderStatus:output_type -> pos.GetOrderStatusResponse
+	41,  // 152: pos.POSService.ListOrders:output_type -> pos.ListOrdersResponse
+	48,  // 153: pos.POSService.VoidOrder:output_type -> pos.VoidOrderResponse
+	50,  // 154: pos.POSService.ReturnOrder:output_type -> pos.ReturnOrderResponse
+	42,  // 155: pos.POSService.StreamOrderEvents:output_type -> pos.OrderEvent
+	46,  // 156: pos.POSService.ProcessPayment:output_type -> pos.ProcessPaymentResponse
+	62,  // 157: pos.POSService.GetReceipt:output_type -> pos.GetReceiptResponse
+	53,  // 158: pos.POSService.GetSalesByProduct:output_type -> pos.GetSalesByProductResponse
+	56,  // 159: pos.POSService.GetSalesByCashier:output_type -> pos.GetSalesByCashierResponse
+	67,  // 160: pos.POSService.CreateProduct:output_type -> pos.CreateProductResponse
+	64,  // 161: pos.POSService.GetProduct:output_type -> pos.GetProductResponse
+	69,  // 162: pos.POSService.GetProductByCode:output_type -> pos.GetProductByCodeResponse
+	71,  // 163: pos.POSService.ListProducts:output_type -> pos.ListProductsResponse
+	73,  // 164: pos.POSService.GetProductPriceHistory:output_type -> pos.GetProductPriceHistoryResponse
+	75,  // 165: pos.POSService.SchedulePriceChange:output_type -> pos.SchedulePriceChangeResponse
+	77,  // 166: pos.POSService.ListScheduledPrices:output_type -> pos.ListScheduledPricesResponse
+	80,  // 167: pos.POSService.ImportProducts:output_type -> pos.ImportProductsResponse
+	84,  // 168: pos.POSService.BulkUpdatePrices:output_type -> pos.BulkUpdatePricesResponse
+	86,  // 169: pos.POSService.ListProductGroups:output_type -> pos.ListProductGroupsResponse
+	88,  // 170: pos.POSService.ListDiscounts:output_type -> pos.ListDiscountsResponse
+	90,  // 171: pos.POSService.ValidateDiscount:output_type -> pos.ValidateDiscountResponse
+	92,  // 172: pos.POSService.ListPaymentTypes:output_type -> pos.ListPaymentTypesResponse
+	142, // [142:173] is the sub-list for method output_type
+	111, // [111:142] is the sub-list for method input_type
+	111, // [111:111] is the sub-list for extension type_name
+	111, // [111:111] is the sub-list for extension extendee
+	0,   // [0:111] is the sub-list for field type_name
 }
 
 func init() { file_pos_pos_service_proto_init() }
@@ -4086,32 +7807,56 @@ func file_pos_pos_service_proto_init() {
 	if File_pos_pos_service_proto != nil {
 		return
 	}
+	file_pos_pos_service_proto_msgTypes[2].OneofWrappers = []any{}
 	file_pos_pos_service_proto_msgTypes[3].OneofWrappers = []any{}
-	file_pos_pos_service_proto_msgTypes[4].OneofWrappers = []any{}
-	file_pos_pos_service_proto_msgTypes[6].OneofWrappers = []any{}
-	file_pos_pos_service_proto_msgTypes[7].OneofWrappers = []any{}
+	file_pos_pos_service_proto_msgTypes[5].OneofWrappers = []any{}
 	file_pos_pos_service_proto_msgTypes[8].OneofWrappers = []any{}
+	file_pos_pos_service_proto_msgTypes[9].OneofWrappers = []any{}
 	file_pos_pos_service_proto_msgTypes[10].OneofWrappers = []any{}
+	file_pos_pos_service_proto_msgTypes[11].OneofWrappers = []any{}
+	file_pos_pos_service_proto_msgTypes[12].OneofWrappers = []any{}
 	file_pos_pos_service_proto_msgTypes[13].OneofWrappers = []any{}
+	file_pos_pos_service_proto_msgTypes[14].OneofWrappers = []any{}
+	file_pos_pos_service_proto_msgTypes[15].OneofWrappers = []any{}
+	file_pos_pos_service_proto_msgTypes[17].OneofWrappers = []any{}
+	file_pos_pos_service_proto_msgTypes[19].OneofWrappers = []any{}
 	file_pos_pos_service_proto_msgTypes[21].OneofWrappers = []any{}
-	file_pos_pos_service_proto_msgTypes[23].OneofWrappers = []any{}
-	file_pos_pos_service_proto_msgTypes[24].OneofWrappers = []any{}
+	file_pos_pos_service_proto_msgTypes[25].OneofWrappers = []any{}
+	file_pos_pos_service_proto_msgTypes[27].OneofWrappers = []any{}
 	file_pos_pos_service_proto_msgTypes[28].OneofWrappers = []any{}
-	file_pos_pos_service_proto_msgTypes[30].OneofWrappers = []any{}
-	file_pos_pos_service_proto_msgTypes[34].OneofWrappers = []any{}
+	file_pos_pos_service_proto_msgTypes[36].OneofWrappers = []any{}
+	file_pos_pos_service_proto_msgTypes[39].OneofWrappers = []any{}
 	file_pos_pos_service_proto_msgTypes[40].OneofWrappers = []any{}
+	file_pos_pos_service_proto_msgTypes[41].OneofWrappers = []any{}
 	file_pos_pos_service_proto_msgTypes[42].OneofWrappers = []any{}
-	file_pos_pos_service_proto_msgTypes[44].OneofWrappers = []any{}
-	file_pos_pos_service_proto_msgTypes[46].OneofWrappers = []any{}
+	file_pos_pos_service_proto_msgTypes[45].OneofWrappers = []any{}
 	file_pos_pos_service_proto_msgTypes[47].OneofWrappers = []any{}
-	file_pos_pos_service_proto_msgTypes[48].OneofWrappers = []any{}
+	file_pos_pos_service_proto_msgTypes[50].OneofWrappers = []any{}
+	file_pos_pos_service_proto_msgTypes[53].OneofWrappers = []any{}
+	file_pos_pos_service_proto_msgTypes[56].OneofWrappers = []any{}
+	file_pos_pos_service_proto_msgTypes[61].OneofWrappers = []any{}
+	file_pos_pos_service_proto_msgTypes[62].OneofWrappers = []any{}
+	file_pos_pos_service_proto_msgTypes[63].OneofWrappers = []any{}
+	file_pos_pos_service_proto_msgTypes[66].OneofWrappers = []any{}
+	file_pos_pos_service_proto_msgTypes[72].OneofWrappers = []any{}
+	file_pos_pos_service_proto_msgTypes[75].OneofWrappers = []any{}
+	file_pos_pos_service_proto_msgTypes[78].OneofWrappers = []any{
+		(*BulkUpdatePricesRequest_ProductIds)(nil),
+		(*BulkUpdatePricesRequest_ProductGroupId)(nil),
+		(*BulkUpdatePricesRequest_AllProducts)(nil),
+	}
+	file_pos_pos_service_proto_msgTypes[81].OneofWrappers = []any{}
+	file_pos_pos_service_proto_msgTypes[83].OneofWrappers = []any{}
+	file_pos_pos_service_proto_msgTypes[85].OneofWrappers = []any{}
+	file_pos_pos_service_proto_msgTypes[86].OneofWrappers = []any{}
+	file_pos_pos_service_proto_msgTypes[87].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pos_pos_service_proto_rawDesc), len(file_pos_pos_service_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   50,
+			NumEnums:      4,
+			NumMessages:   89,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,25 +19,37 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	POSService_CreateCart_FullMethodName          = "/pos.POSService/CreateCart"
-	POSService_GetCart_FullMethodName             = "/pos.POSService/GetCart"
-	POSService_AddItemToCart_FullMethodName       = "/pos.POSService/AddItemToCart"
-	POSService_RemoveItemFromCart_FullMethodName  = "/pos.POSService/RemoveItemFromCart"
-	POSService_ApplyDiscount_FullMethodName       = "/pos.POSService/ApplyDiscount"
-	POSService_CreateOrder_FullMethodName         = "/pos.POSService/CreateOrder"
-	POSService_CreateOrderFromCart_FullMethodName = "/pos.POSService/CreateOrderFromCart"
-	POSService_GetOrder_FullMethodName            = "/pos.POSService/GetOrder"
-	POSService_ListOrders_FullMethodName          = "/pos.POSService/ListOrders"
-	POSService_VoidOrder_FullMethodName           = "/pos.POSService/VoidOrder"
-	POSService_ReturnOrder_FullMethodName         = "/pos.POSService/ReturnOrder"
-	POSService_ProcessPayment_FullMethodName      = "/pos.POSService/ProcessPayment"
-	POSService_GetProduct_FullMethodName          = "/pos.POSService/GetProduct"
-	POSService_GetProductByCode_FullMethodName    = "/pos.POSService/GetProductByCode"
-	POSService_ListProducts_FullMethodName        = "/pos.POSService/ListProducts"
-	POSService_ListProductGroups_FullMethodName   = "/pos.POSService/ListProductGroups"
-	POSService_ListDiscounts_FullMethodName       = "/pos.POSService/ListDiscounts"
-	POSService_ValidateDiscount_FullMethodName    = "/pos.POSService/ValidateDiscount"
-	POSService_ListPaymentTypes_FullMethodName    = "/pos.POSService/ListPaymentTypes"
+	POSService_CreateCart_FullMethodName               = "/pos.POSService/CreateCart"
+	POSService_GetCart_FullMethodName                  = "/pos.POSService/GetCart"
+	POSService_AddItemToCart_FullMethodName            = "/pos.POSService/AddItemToCart"
+	POSService_RemoveItemFromCart_FullMethodName       = "/pos.POSService/RemoveItemFromCart"
+	POSService_ApplyDiscount_FullMethodName            = "/pos.POSService/ApplyDiscount"
+	POSService_CreateOrder_FullMethodName              = "/pos.POSService/CreateOrder"
+	POSService_CreateOrderFromCart_FullMethodName      = "/pos.POSService/CreateOrderFromCart"
+	POSService_GetOrder_FullMethodName                 = "/pos.POSService/GetOrder"
+	POSService_GetOrderByDocumentNumber_FullMethodName = "/pos.POSService/GetOrderByDocumentNumber"
+	POSService_GetOrderStatus_FullMethodName           = "/pos.POSService/GetOrderStatus"
+	POSService_ListOrders_FullMethodName               = "/pos.POSService/ListOrders"
+	POSService_VoidOrder_FullMethodName                = "/pos.POSService/VoidOrder"
+	POSService_ReturnOrder_FullMethodName              = "/pos.POSService/ReturnOrder"
+	POSService_StreamOrderEvents_FullMethodName        = "/pos.POSService/StreamOrderEvents"
+	POSService_ProcessPayment_FullMethodName           = "/pos.POSService/ProcessPayment"
+	POSService_GetReceipt_FullMethodName               = "/pos.POSService/GetReceipt"
+	POSService_GetSalesByProduct_FullMethodName        = "/pos.POSService/GetSalesByProduct"
+	POSService_GetSalesByCashier_FullMethodName        = "/pos.POSService/GetSalesByCashier"
+	POSService_CreateProduct_FullMethodName            = "/pos.POSService/CreateProduct"
+	POSService_GetProduct_FullMethodName               = "/pos.POSService/GetProduct"
+	POSService_GetProductByCode_FullMethodName         = "/pos.POSService/GetProductByCode"
+	POSService_ListProducts_FullMethodName             = "/pos.POSService/ListProducts"
+	POSService_GetProductPriceHistory_FullMethodName   = "/pos.POSService/GetProductPriceHistory"
+	POSService_SchedulePriceChange_FullMethodName      = "/pos.POSService/SchedulePriceChange"
+	POSService_ListScheduledPrices_FullMethodName      = "/pos.POSService/ListScheduledPrices"
+	POSService_ImportProducts_FullMethodName           = "/pos.POSService/ImportProducts"
+	POSService_BulkUpdatePrices_FullMethodName         = "/pos.POSService/BulkUpdatePrices"
+	POSService_ListProductGroups_FullMethodName        = "/pos.POSService/ListProductGroups"
+	POSService_ListDiscounts_FullMethodName            = "/pos.POSService/ListDiscounts"
+	POSService_ValidateDiscount_FullMethodName         = "/pos.POSService/ValidateDiscount"
+	POSService_ListPaymentTypes_FullMethodName         = "/pos.POSService/ListPaymentTypes"
 )
 
 // POSServiceClient is the client API for POSService service.
@@ -54,15 +66,28 @@ type POSServiceClient interface {
 	CreateOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*CreateOrderResponse, error)
 	CreateOrderFromCart(ctx context.Context, in *CreateOrderFromCartRequest, opts ...grpc.CallOption) (*CreateOrderFromCartResponse, error)
 	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*GetOrderResponse, error)
+	GetOrderByDocumentNumber(ctx context.Context, in *GetOrderByDocumentNumberRequest, opts ...grpc.CallOption) (*GetOrderByDocumentNumberResponse, error)
+	GetOrderStatus(ctx context.Context, in *GetOrderStatusRequest, opts ...grpc.CallOption) (*GetOrderStatusResponse, error)
 	ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error)
 	VoidOrder(ctx context.Context, in *VoidOrderRequest, opts ...grpc.CallOption) (*VoidOrderResponse, error)
 	ReturnOrder(ctx context.Context, in *ReturnOrderRequest, opts ...grpc.CallOption) (*ReturnOrderResponse, error)
+	StreamOrderEvents(ctx context.Context, in *StreamOrderEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OrderEvent], error)
 	// Payment Processing
 	ProcessPayment(ctx context.Context, in *ProcessPaymentRequest, opts ...grpc.CallOption) (*ProcessPaymentResponse, error)
+	GetReceipt(ctx context.Context, in *GetReceiptRequest, opts ...grpc.CallOption) (*GetReceiptResponse, error)
+	// Sales Reports
+	GetSalesByProduct(ctx context.Context, in *GetSalesByProductRequest, opts ...grpc.CallOption) (*GetSalesByProductResponse, error)
+	GetSalesByCashier(ctx context.Context, in *GetSalesByCashierRequest, opts ...grpc.CallOption) (*GetSalesByCashierResponse, error)
 	// Product Operations
+	CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*CreateProductResponse, error)
 	GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductResponse, error)
 	GetProductByCode(ctx context.Context, in *GetProductByCodeRequest, opts ...grpc.CallOption) (*GetProductByCodeResponse, error)
 	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error)
+	GetProductPriceHistory(ctx context.Context, in *GetProductPriceHistoryRequest, opts ...grpc.CallOption) (*GetProductPriceHistoryResponse, error)
+	SchedulePriceChange(ctx context.Context, in *SchedulePriceChangeRequest, opts ...grpc.CallOption) (*SchedulePriceChangeResponse, error)
+	ListScheduledPrices(ctx context.Context, in *ListScheduledPricesRequest, opts ...grpc.CallOption) (*ListScheduledPricesResponse, error)
+	ImportProducts(ctx context.Context, in *ImportProductsRequest, opts ...grpc.CallOption) (*ImportProductsResponse, error)
+	BulkUpdatePrices(ctx context.Context, in *BulkUpdatePricesRequest, opts ...grpc.CallOption) (*BulkUpdatePricesResponse, error)
 	ListProductGroups(ctx context.Context, in *ListProductGroupsRequest, opts ...grpc.CallOption) (*ListProductGroupsResponse, error)
 	// Discount Operations
 	ListDiscounts(ctx context.Context, in *ListDiscountsRequest, opts ...grpc.CallOption) (*ListDiscountsResponse, error)
@@ -159,6 +184,26 @@ func (c *pOSServiceClient) GetOrder(ctx context.Context, in *GetOrderRequest, op
 	return out, nil
 }
 
+func (c *pOSServiceClient) GetOrderByDocumentNumber(ctx context.Context, in *GetOrderByDocumentNumberRequest, opts ...grpc.CallOption) (*GetOrderByDocumentNumberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrderByDocumentNumberResponse)
+	err := c.cc.Invoke(ctx, POSService_GetOrderByDocumentNumber_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pOSServiceClient) GetOrderStatus(ctx context.Context, in *GetOrderStatusRequest, opts ...grpc.CallOption) (*GetOrderStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrderStatusResponse)
+	err := c.cc.Invoke(ctx, POSService_GetOrderStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pOSServiceClient) ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListOrdersResponse)
@@ -189,6 +234,25 @@ func (c *pOSServiceClient) ReturnOrder(ctx context.Context, in *ReturnOrderReque
 	return out, nil
 }
 
+func (c *pOSServiceClient) StreamOrderEvents(ctx context.Context, in *StreamOrderEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OrderEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &POSService_ServiceDesc.Streams[0], POSService_StreamOrderEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamOrderEventsRequest, OrderEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type POSService_StreamOrderEventsClient = grpc.ServerStreamingClient[OrderEvent]
+
 func (c *pOSServiceClient) ProcessPayment(ctx context.Context, in *ProcessPaymentRequest, opts ...grpc.CallOption) (*ProcessPaymentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ProcessPaymentResponse)
@@ -199,6 +263,46 @@ func (c *pOSServiceClient) ProcessPayment(ctx context.Context, in *ProcessPaymen
 	return out, nil
 }
 
+func (c *pOSServiceClient) GetReceipt(ctx context.Context, in *GetReceiptRequest, opts ...grpc.CallOption) (*GetReceiptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReceiptResponse)
+	err := c.cc.Invoke(ctx, POSService_GetReceipt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pOSServiceClient) GetSalesByProduct(ctx context.Context, in *GetSalesByProductRequest, opts ...grpc.CallOption) (*GetSalesByProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSalesByProductResponse)
+	err := c.cc.Invoke(ctx, POSService_GetSalesByProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pOSServiceClient) GetSalesByCashier(ctx context.Context, in *GetSalesByCashierRequest, opts ...grpc.CallOption) (*GetSalesByCashierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSalesByCashierResponse)
+	err := c.cc.Invoke(ctx, POSService_GetSalesByCashier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pOSServiceClient) CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*CreateProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateProductResponse)
+	err := c.cc.Invoke(ctx, POSService_CreateProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pOSServiceClient) GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetProductResponse)
@@ -229,6 +333,56 @@ func (c *pOSServiceClient) ListProducts(ctx context.Context, in *ListProductsReq
 	return out, nil
 }
 
+func (c *pOSServiceClient) GetProductPriceHistory(ctx context.Context, in *GetProductPriceHistoryRequest, opts ...grpc.CallOption) (*GetProductPriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProductPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, POSService_GetProductPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pOSServiceClient) SchedulePriceChange(ctx context.Context, in *SchedulePriceChangeRequest, opts ...grpc.CallOption) (*SchedulePriceChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SchedulePriceChangeResponse)
+	err := c.cc.Invoke(ctx, POSService_SchedulePriceChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pOSServiceClient) ListScheduledPrices(ctx context.Context, in *ListScheduledPricesRequest, opts ...grpc.CallOption) (*ListScheduledPricesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScheduledPricesResponse)
+	err := c.cc.Invoke(ctx, POSService_ListScheduledPrices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pOSServiceClient) ImportProducts(ctx context.Context, in *ImportProductsRequest, opts ...grpc.CallOption) (*ImportProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportProductsResponse)
+	err := c.cc.Invoke(ctx, POSService_ImportProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pOSServiceClient) BulkUpdatePrices(ctx context.Context, in *BulkUpdatePricesRequest, opts ...grpc.CallOption) (*BulkUpdatePricesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkUpdatePricesResponse)
+	err := c.cc.Invoke(ctx, POSService_BulkUpdatePrices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pOSServiceClient) ListProductGroups(ctx context.Context, in *ListProductGroupsRequest, opts ...grpc.CallOption) (*ListProductGroupsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListProductGroupsResponse)
@@ -283,15 +437,28 @@ type POSServiceServer interface {
 	CreateOrder(context.Context, *CreateOrderRequest) (*CreateOrderResponse, error)
 	CreateOrderFromCart(context.Context, *CreateOrderFromCartRequest) (*CreateOrderFromCartResponse, error)
 	GetOrder(context.Context, *GetOrderRequest) (*GetOrderResponse, error)
+	GetOrderByDocumentNumber(context.Context, *GetOrderByDocumentNumberRequest) (*GetOrderByDocumentNumberResponse, error)
+	GetOrderStatus(context.Context, *GetOrderStatusRequest) (*GetOrderStatusResponse, error)
 	ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error)
 	VoidOrder(context.Context, *VoidOrderRequest) (*VoidOrderResponse, error)
 	ReturnOrder(context.Context, *ReturnOrderRequest) (*ReturnOrderResponse, error)
+	StreamOrderEvents(*StreamOrderEventsRequest, grpc.ServerStreamingServer[OrderEvent]) error
 	// Payment Processing
 	ProcessPayment(context.Context, *ProcessPaymentRequest) (*ProcessPaymentResponse, error)
+	GetReceipt(context.Context, *GetReceiptRequest) (*GetReceiptResponse, error)
+	// Sales Reports
+	GetSalesByProduct(context.Context, *GetSalesByProductRequest) (*GetSalesByProductResponse, error)
+	GetSalesByCashier(context.Context, *GetSalesByCashierRequest) (*GetSalesByCashierResponse, error)
 	// Product Operations
+	CreateProduct(context.Context, *CreateProductRequest) (*CreateProductResponse, error)
 	GetProduct(context.Context, *GetProductRequest) (*GetProductResponse, error)
 	GetProductByCode(context.Context, *GetProductByCodeRequest) (*GetProductByCodeResponse, error)
 	ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error)
+	GetProductPriceHistory(context.Context, *GetProductPriceHistoryRequest) (*GetProductPriceHistoryResponse, error)
+	SchedulePriceChange(context.Context, *SchedulePriceChangeRequest) (*SchedulePriceChangeResponse, error)
+	ListScheduledPrices(context.Context, *ListScheduledPricesRequest) (*ListScheduledPricesResponse, error)
+	ImportProducts(context.Context, *ImportProductsRequest) (*ImportProductsResponse, error)
+	BulkUpdatePrices(context.Context, *BulkUpdatePricesRequest) (*BulkUpdatePricesResponse, error)
 	ListProductGroups(context.Context, *ListProductGroupsRequest) (*ListProductGroupsResponse, error)
 	// Discount Operations
 	ListDiscounts(context.Context, *ListDiscountsRequest) (*ListDiscountsResponse, error)
@@ -332,6 +499,12 @@ func (UnimplementedPOSServiceServer) CreateOrderFromCart(context.Context, *Creat
 func (UnimplementedPOSServiceServer) GetOrder(context.Context, *GetOrderRequest) (*GetOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
 }
+func (UnimplementedPOSServiceServer) GetOrderByDocumentNumber(context.Context, *GetOrderByDocumentNumberRequest) (*GetOrderByDocumentNumberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderByDocumentNumber not implemented")
+}
+func (UnimplementedPOSServiceServer) GetOrderStatus(context.Context, *GetOrderStatusRequest) (*GetOrderStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderStatus not implemented")
+}
 func (UnimplementedPOSServiceServer) ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
 }
@@ -341,9 +514,24 @@ func (UnimplementedPOSServiceServer) VoidOrder(context.Context, *VoidOrderReques
 func (UnimplementedPOSServiceServer) ReturnOrder(context.Context, *ReturnOrderRequest) (*ReturnOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReturnOrder not implemented")
 }
+func (UnimplementedPOSServiceServer) StreamOrderEvents(*StreamOrderEventsRequest, grpc.ServerStreamingServer[OrderEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamOrderEvents not implemented")
+}
 func (UnimplementedPOSServiceServer) ProcessPayment(context.Context, *ProcessPaymentRequest) (*ProcessPaymentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessPayment not implemented")
 }
+func (UnimplementedPOSServiceServer) GetReceipt(context.Context, *GetReceiptRequest) (*GetReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReceipt not implemented")
+}
+func (UnimplementedPOSServiceServer) GetSalesByProduct(context.Context, *GetSalesByProductRequest) (*GetSalesByProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSalesByProduct not implemented")
+}
+func (UnimplementedPOSServiceServer) GetSalesByCashier(context.Context, *GetSalesByCashierRequest) (*GetSalesByCashierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSalesByCashier not implemented")
+}
+func (UnimplementedPOSServiceServer) CreateProduct(context.Context, *CreateProductRequest) (*CreateProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProduct not implemented")
+}
 func (UnimplementedPOSServiceServer) GetProduct(context.Context, *GetProductRequest) (*GetProductResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProduct not implemented")
 }
@@ -353,6 +541,21 @@ func (UnimplementedPOSServiceServer) GetProductByCode(context.Context, *GetProdu
 func (UnimplementedPOSServiceServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProducts not implemented")
 }
+func (UnimplementedPOSServiceServer) GetProductPriceHistory(context.Context, *GetProductPriceHistoryRequest) (*GetProductPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductPriceHistory not implemented")
+}
+func (UnimplementedPOSServiceServer) SchedulePriceChange(context.Context, *SchedulePriceChangeRequest) (*SchedulePriceChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulePriceChange not implemented")
+}
+func (UnimplementedPOSServiceServer) ListScheduledPrices(context.Context, *ListScheduledPricesRequest) (*ListScheduledPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledPrices not implemented")
+}
+func (UnimplementedPOSServiceServer) ImportProducts(context.Context, *ImportProductsRequest) (*ImportProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportProducts not implemented")
+}
+func (UnimplementedPOSServiceServer) BulkUpdatePrices(context.Context, *BulkUpdatePricesRequest) (*BulkUpdatePricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpdatePrices not implemented")
+}
 func (UnimplementedPOSServiceServer) ListProductGroups(context.Context, *ListProductGroupsRequest) (*ListProductGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProductGroups not implemented")
 }
@@ -530,6 +733,42 @@ func _POSService_GetOrder_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _POSService_GetOrderByDocumentNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderByDocumentNumberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(POSServiceServer).GetOrderByDocumentNumber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: POSService_GetOrderByDocumentNumber_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(POSServiceServer).GetOrderByDocumentNumber(ctx, req.(*GetOrderByDocumentNumberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _POSService_GetOrderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(POSServiceServer).GetOrderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: POSService_GetOrderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(POSServiceServer).GetOrderStatus(ctx, req.(*GetOrderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _POSService_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListOrdersRequest)
 	if err := dec(in); err != nil {
@@ -584,6 +823,17 @@ func _POSService_ReturnOrder_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _POSService_StreamOrderEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamOrderEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(POSServiceServer).StreamOrderEvents(m, &grpc.GenericServerStream[StreamOrderEventsRequest, OrderEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type POSService_StreamOrderEventsServer = grpc.ServerStreamingServer[OrderEvent]
+
 func _POSService_ProcessPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ProcessPaymentRequest)
 	if err := dec(in); err != nil {
@@ -602,6 +852,78 @@ func _POSService_ProcessPayment_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _POSService_GetReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(POSServiceServer).GetReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: POSService_GetReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(POSServiceServer).GetReceipt(ctx, req.(*GetReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _POSService_GetSalesByProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSalesByProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(POSServiceServer).GetSalesByProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: POSService_GetSalesByProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(POSServiceServer).GetSalesByProduct(ctx, req.(*GetSalesByProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _POSService_GetSalesByCashier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSalesByCashierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(POSServiceServer).GetSalesByCashier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: POSService_GetSalesByCashier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(POSServiceServer).GetSalesByCashier(ctx, req.(*GetSalesByCashierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _POSService_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(POSServiceServer).CreateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: POSService_CreateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(POSServiceServer).CreateProduct(ctx, req.(*CreateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _POSService_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetProductRequest)
 	if err := dec(in); err != nil {
@@ -656,6 +978,96 @@ func _POSService_ListProducts_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _POSService_GetProductPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(POSServiceServer).GetProductPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: POSService_GetProductPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(POSServiceServer).GetProductPriceHistory(ctx, req.(*GetProductPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _POSService_SchedulePriceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulePriceChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(POSServiceServer).SchedulePriceChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: POSService_SchedulePriceChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(POSServiceServer).SchedulePriceChange(ctx, req.(*SchedulePriceChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _POSService_ListScheduledPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(POSServiceServer).ListScheduledPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: POSService_ListScheduledPrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(POSServiceServer).ListScheduledPrices(ctx, req.(*ListScheduledPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _POSService_ImportProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(POSServiceServer).ImportProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: POSService_ImportProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(POSServiceServer).ImportProducts(ctx, req.(*ImportProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _POSService_BulkUpdatePrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpdatePricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(POSServiceServer).BulkUpdatePrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: POSService_BulkUpdatePrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(POSServiceServer).BulkUpdatePrices(ctx, req.(*BulkUpdatePricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _POSService_ListProductGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListProductGroupsRequest)
 	if err := dec(in); err != nil {
@@ -767,6 +1179,14 @@ var POSService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetOrder",
 			Handler:    _POSService_GetOrder_Handler,
 		},
+		{
+			MethodName: "GetOrderByDocumentNumber",
+			Handler:    _POSService_GetOrderByDocumentNumber_Handler,
+		},
+		{
+			MethodName: "GetOrderStatus",
+			Handler:    _POSService_GetOrderStatus_Handler,
+		},
 		{
 			MethodName: "ListOrders",
 			Handler:    _POSService_ListOrders_Handler,
@@ -783,6 +1203,22 @@ var POSService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProcessPayment",
 			Handler:    _POSService_ProcessPayment_Handler,
 		},
+		{
+			MethodName: "GetReceipt",
+			Handler:    _POSService_GetReceipt_Handler,
+		},
+		{
+			MethodName: "GetSalesByProduct",
+			Handler:    _POSService_GetSalesByProduct_Handler,
+		},
+		{
+			MethodName: "GetSalesByCashier",
+			Handler:    _POSService_GetSalesByCashier_Handler,
+		},
+		{
+			MethodName: "CreateProduct",
+			Handler:    _POSService_CreateProduct_Handler,
+		},
 		{
 			MethodName: "GetProduct",
 			Handler:    _POSService_GetProduct_Handler,
@@ -795,6 +1231,26 @@ var POSService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListProducts",
 			Handler:    _POSService_ListProducts_Handler,
 		},
+		{
+			MethodName: "GetProductPriceHistory",
+			Handler:    _POSService_GetProductPriceHistory_Handler,
+		},
+		{
+			MethodName: "SchedulePriceChange",
+			Handler:    _POSService_SchedulePriceChange_Handler,
+		},
+		{
+			MethodName: "ListScheduledPrices",
+			Handler:    _POSService_ListScheduledPrices_Handler,
+		},
+		{
+			MethodName: "ImportProducts",
+			Handler:    _POSService_ImportProducts_Handler,
+		},
+		{
+			MethodName: "BulkUpdatePrices",
+			Handler:    _POSService_BulkUpdatePrices_Handler,
+		},
 		{
 			MethodName: "ListProductGroups",
 			Handler:    _POSService_ListProductGroups_Handler,
@@ -812,6 +1268,12 @@ var POSService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _POSService_ListPaymentTypes_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamOrderEvents",
+			Handler:       _POSService_StreamOrderEvents_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "pos/pos_service.proto",
 }
@@ -315,21 +315,22 @@ func (x *Role) GetUpdatedAt() *timestamppb.Timestamp {
 }
 
 type User struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
-	Firstname     string                 `protobuf:"bytes,5,opt,name=firstname,proto3" json:"firstname,omitempty"`
-	Lastname      string                 `protobuf:"bytes,6,opt,name=lastname,proto3" json:"lastname,omitempty"`
-	RoleId        int32                  `protobuf:"varint,7,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
-	IsActive      bool                   `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	LastLogin     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_login,json=lastLogin,proto3,oneof" json:"last_login,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Role          *Role                  `protobuf:"bytes,12,opt,name=role,proto3,oneof" json:"role,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username           string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Email              string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Password           string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	Firstname          string                 `protobuf:"bytes,5,opt,name=firstname,proto3" json:"firstname,omitempty"`
+	Lastname           string                 `protobuf:"bytes,6,opt,name=lastname,proto3" json:"lastname,omitempty"`
+	RoleId             int32                  `protobuf:"varint,7,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	IsActive           bool                   `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	LastLogin          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_login,json=lastLogin,proto3,oneof" json:"last_login,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Role               *Role                  `protobuf:"bytes,12,opt,name=role,proto3,oneof" json:"role,omitempty"`
+	MustChangePassword bool                   `protobuf:"varint,13,opt,name=must_change_password,json=mustChangePassword,proto3" json:"must_change_password,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *User) Reset() {
@@ -446,6 +447,13 @@ func (x *User) GetRole() *Role {
 	return nil
 }
 
+func (x *User) GetMustChangePassword() bool {
+	if x != nil {
+		return x.MustChangePassword
+	}
+	return false
+}
+
 type Employee struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -462,8 +470,10 @@ type Employee struct {
 	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	CommissionTiers []*CommissionTier      `protobuf:"bytes,14,rep,name=commission_tiers,json=commissionTiers,proto3" json:"commission_tiers,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Login account for this employee; unique across employees.
+	UserId        *int64 `protobuf:"varint,15,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Employee) Reset() {
@@ -594,6 +604,13 @@ func (x *Employee) GetCommissionTiers() []*CommissionTier {
 	return nil
 }
 
+func (x *Employee) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
 type CommissionTier struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -798,6 +815,199 @@ func (x *AuthenticateResponse) GetExpiresAt() *timestamppb.Timestamp {
 	return nil
 }
 
+type RefreshTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
+	mi := &file_user_user_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRequest) ProtoMessage() {}
+
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RefreshTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type RefreshTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenResponse) Reset() {
+	*x = RefreshTokenResponse{}
+	mi := &file_user_user_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenResponse) ProtoMessage() {}
+
+func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
+func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RefreshTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RefreshTokenResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// Denylists the token's jti until it would have expired.
+type LogoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_user_user_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LogoutRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type LogoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       *string                `protobuf:"bytes,2,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutResponse) Reset() {
+	*x = LogoutResponse{}
+	mi := &file_user_user_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutResponse) ProtoMessage() {}
+
+func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LogoutResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LogoutResponse) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
 type CreateUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
@@ -812,7 +1022,7 @@ type CreateUserRequest struct {
 
 func (x *CreateUserRequest) Reset() {
 	*x = CreateUserRequest{}
-	mi := &file_user_user_service_proto_msgTypes[9]
+	mi := &file_user_user_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -824,7 +1034,7 @@ func (x *CreateUserRequest) String() string {
 func (*CreateUserRequest) ProtoMessage() {}
 
 func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_service_proto_msgTypes[9]
+	mi := &file_user_user_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -837,7 +1047,7 @@ func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
 func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_user_service_proto_rawDescGZIP(), []int{9}
+	return file_user_user_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CreateUserRequest) GetUsername() string {
@@ -891,7 +1101,7 @@ type CreateUserResponse struct {
 
 func (x *CreateUserResponse) Reset() {
 	*x = CreateUserResponse{}
-	mi := &file_user_user_service_proto_msgTypes[10]
+	mi := &file_user_user_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -903,7 +1113,7 @@ func (x *CreateUserResponse) String() string {
 func (*CreateUserResponse) ProtoMessage() {}
 
 func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_service_proto_msgTypes[10]
+	mi := &file_user_user_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -916,7 +1126,7 @@ func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
 func (*CreateUserResponse) Descriptor() ([]byte, []int) {
-	return file_user_user_service_proto_rawDescGZIP(), []int{10}
+	return file_user_user_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CreateUserResponse) GetUser() *User {
@@ -935,7 +1145,7 @@ type GetUserRequest struct {
 
 func (x *GetUserRequest) Reset() {
 	*x = GetUserRequest{}
-	mi := &file_user_user_service_proto_msgTypes[11]
+	mi := &file_user_user_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -947,7 +1157,7 @@ func (x *GetUserRequest) String() string {
 func (*GetUserRequest) ProtoMessage() {}
 
 func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_service_proto_msgTypes[11]
+	mi := &file_user_user_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -960,7 +1170,7 @@ func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
 func (*GetUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_user_service_proto_rawDescGZIP(), []int{11}
+	return file_user_user_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetUserRequest) GetId() int64 {
@@ -973,13 +1183,15 @@ func (x *GetUserRequest) GetId() int64 {
 type GetUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       *string                `protobuf:"bytes,3,opt,name=message,proto3,oneof" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetUserResponse) Reset() {
 	*x = GetUserResponse{}
-	mi := &file_user_user_service_proto_msgTypes[12]
+	mi := &file_user_user_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -991,7 +1203,7 @@ func (x *GetUserResponse) String() string {
 func (*GetUserResponse) ProtoMessage() {}
 
 func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_service_proto_msgTypes[12]
+	mi := &file_user_user_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1004,7 +1216,7 @@ func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
 func (*GetUserResponse) Descriptor() ([]byte, []int) {
-	return file_user_user_service_proto_rawDescGZIP(), []int{12}
+	return file_user_user_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetUserResponse) GetUser() *User {
@@ -1014,6 +1226,20 @@ func (x *GetUserResponse) GetUser() *User {
 	return nil
 }
 
+func (x *GetUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetUserResponse) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
 type UpdateUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1028,7 +1254,7 @@ type UpdateUserRequest struct {
 
 func (x *UpdateUserRequest) Reset() {
 	*x = UpdateUserRequest{}
-	mi := &file_user_user_service_proto_msgTypes[13]
+	mi := &file_user_user_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1040,7 +1266,7 @@ func (x *UpdateUserRequest) String() string {
 func (*UpdateUserRequest) ProtoMessage() {}
 
 func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_service_proto_msgTypes[13]
+	mi := &file_user_user_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1053,7 +1279,7 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_user_service_proto_rawDescGZIP(), []int{13}
+	return file_user_user_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *UpdateUserRequest) GetId() int64 {
@@ -1107,7 +1333,7 @@ type UpdateUserResponse struct {
 
 func (x *UpdateUserResponse) Reset() {
 	*x = UpdateUserResponse{}
-	mi := &file_user_user_service_proto_msgTypes[14]
+	mi := &file_user_user_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1119,7 +1345,7 @@ func (x *UpdateUserResponse) String() string {
 func (*UpdateUserResponse) ProtoMessage() {}
 
 func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_service_proto_msgTypes[14]
+	mi := &file_user_user_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1132,7 +1358,7 @@ func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserResponse.ProtoReflect.Descriptor instead.
 func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
-	return file_user_user_service_proto_rawDescGZIP(), []int{14}
+	return file_user_user_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UpdateUserResponse) GetUser() *User {
@@ -1142,30 +1368,30 @@ func (x *UpdateUserResponse) GetUser() *User {
 	return nil
 }
 
-type ListUsersRequest struct {
+type ChangePasswordRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pagination    *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	IsActive      *bool                  `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
-	RoleId        *int32                 `protobuf:"varint,3,opt,name=role_id,json=roleId,proto3,oneof" json:"role_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OldPassword   string                 `protobuf:"bytes,2,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUsersRequest) Reset() {
-	*x = ListUsersRequest{}
-	mi := &file_user_user_service_proto_msgTypes[15]
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_user_user_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUsersRequest) String() string {
+func (x *ChangePasswordRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUsersRequest) ProtoMessage() {}
+func (*ChangePasswordRequest) ProtoMessage() {}
 
-func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_service_proto_msgTypes[15]
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1176,55 +1402,55 @@ func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
-func (*ListUsersRequest) Descriptor() ([]byte, []int) {
-	return file_user_user_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ListUsersRequest) GetPagination() *PaginationRequest {
+func (x *ChangePasswordRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Pagination
+		return x.UserId
 	}
-	return nil
+	return 0
 }
 
-func (x *ListUsersRequest) GetIsActive() bool {
-	if x != nil && x.IsActive != nil {
-		return *x.IsActive
+func (x *ChangePasswordRequest) GetOldPassword() string {
+	if x != nil {
+		return x.OldPassword
 	}
-	return false
+	return ""
 }
 
-func (x *ListUsersRequest) GetRoleId() int32 {
-	if x != nil && x.RoleId != nil {
-		return *x.RoleId
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
 	}
-	return 0
+	return ""
 }
 
-type ListUsersResponse struct {
+type ChangePasswordResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       *string                `protobuf:"bytes,2,opt,name=message,proto3,oneof" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUsersResponse) Reset() {
-	*x = ListUsersResponse{}
-	mi := &file_user_user_service_proto_msgTypes[16]
+func (x *ChangePasswordResponse) Reset() {
+	*x = ChangePasswordResponse{}
+	mi := &file_user_user_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUsersResponse) String() string {
+func (x *ChangePasswordResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUsersResponse) ProtoMessage() {}
+func (*ChangePasswordResponse) ProtoMessage() {}
 
-func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_service_proto_msgTypes[16]
+func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1235,55 +1461,51 @@ func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
-func (*ListUsersResponse) Descriptor() ([]byte, []int) {
-	return file_user_user_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ListUsersResponse) GetUsers() []*User {
+func (x *ChangePasswordResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Users
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *ListUsersResponse) GetPagination() *PaginationResponse {
-	if x != nil {
-		return x.Pagination
+func (x *ChangePasswordResponse) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
 	}
-	return nil
+	return ""
 }
 
-type CreateEmployeeRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	EmployeeName   string                 `protobuf:"bytes,1,opt,name=employee_name,json=employeeName,proto3" json:"employee_name,omitempty"`
-	Position       *string                `protobuf:"bytes,2,opt,name=position,proto3,oneof" json:"position,omitempty"`
-	Phone          *string                `protobuf:"bytes,3,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
-	Email          *string                `protobuf:"bytes,4,opt,name=email,proto3,oneof" json:"email,omitempty"`
-	Address        *string                `protobuf:"bytes,5,opt,name=address,proto3,oneof" json:"address,omitempty"`
-	HireDate       *string                `protobuf:"bytes,6,opt,name=hire_date,json=hireDate,proto3,oneof" json:"hire_date,omitempty"`
-	BaseSalary     string                 `protobuf:"bytes,7,opt,name=base_salary,json=baseSalary,proto3" json:"base_salary,omitempty"`
-	CommissionRate string                 `protobuf:"bytes,8,opt,name=commission_rate,json=commissionRate,proto3" json:"commission_rate,omitempty"`
-	CommissionType CommissionType         `protobuf:"varint,9,opt,name=commission_type,json=commissionType,proto3,enum=user.CommissionType" json:"commission_type,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Admin-only; must_change_password forces a change on next login.
+type ResetPasswordRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NewPassword        string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	ResetBy            int64                  `protobuf:"varint,3,opt,name=reset_by,json=resetBy,proto3" json:"reset_by,omitempty"`
+	MustChangePassword *bool                  `protobuf:"varint,4,opt,name=must_change_password,json=mustChangePassword,proto3,oneof" json:"must_change_password,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *CreateEmployeeRequest) Reset() {
-	*x = CreateEmployeeRequest{}
-	mi := &file_user_user_service_proto_msgTypes[17]
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_user_user_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEmployeeRequest) String() string {
+func (x *ResetPasswordRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEmployeeRequest) ProtoMessage() {}
+func (*ResetPasswordRequest) ProtoMessage() {}
 
-func (x *CreateEmployeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_service_proto_msgTypes[17]
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1294,96 +1516,62 @@ func (x *CreateEmployeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEmployeeRequest.ProtoReflect.Descriptor instead.
-func (*CreateEmployeeRequest) Descriptor() ([]byte, []int) {
-	return file_user_user_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *CreateEmployeeRequest) GetEmployeeName() string {
+func (x *ResetPasswordRequest) GetUserId() int64 {
 	if x != nil {
-		return x.EmployeeName
-	}
-	return ""
-}
-
-func (x *CreateEmployeeRequest) GetPosition() string {
-	if x != nil && x.Position != nil {
-		return *x.Position
-	}
-	return ""
-}
-
-func (x *CreateEmployeeRequest) GetPhone() string {
-	if x != nil && x.Phone != nil {
-		return *x.Phone
-	}
-	return ""
-}
-
-func (x *CreateEmployeeRequest) GetEmail() string {
-	if x != nil && x.Email != nil {
-		return *x.Email
-	}
-	return ""
-}
-
-func (x *CreateEmployeeRequest) GetAddress() string {
-	if x != nil && x.Address != nil {
-		return *x.Address
-	}
-	return ""
-}
-
-func (x *CreateEmployeeRequest) GetHireDate() string {
-	if x != nil && x.HireDate != nil {
-		return *x.HireDate
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateEmployeeRequest) GetBaseSalary() string {
+func (x *ResetPasswordRequest) GetNewPassword() string {
 	if x != nil {
-		return x.BaseSalary
+		return x.NewPassword
 	}
 	return ""
 }
 
-func (x *CreateEmployeeRequest) GetCommissionRate() string {
+func (x *ResetPasswordRequest) GetResetBy() int64 {
 	if x != nil {
-		return x.CommissionRate
+		return x.ResetBy
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateEmployeeRequest) GetCommissionType() CommissionType {
-	if x != nil {
-		return x.CommissionType
+func (x *ResetPasswordRequest) GetMustChangePassword() bool {
+	if x != nil && x.MustChangePassword != nil {
+		return *x.MustChangePassword
 	}
-	return CommissionType_COMMISSION_TYPE_UNSPECIFIED
+	return false
 }
 
-type CreateEmployeeResponse struct {
+type ResetPasswordResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Employee      *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       *string                `protobuf:"bytes,2,opt,name=message,proto3,oneof" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateEmployeeResponse) Reset() {
-	*x = CreateEmployeeResponse{}
-	mi := &file_user_user_service_proto_msgTypes[18]
+func (x *ResetPasswordResponse) Reset() {
+	*x = ResetPasswordResponse{}
+	mi := &file_user_user_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEmployeeResponse) String() string {
+func (x *ResetPasswordResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEmployeeResponse) ProtoMessage() {}
+func (*ResetPasswordResponse) ProtoMessage() {}
 
-func (x *CreateEmployeeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_service_proto_msgTypes[18]
+func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1394,40 +1582,49 @@ func (x *CreateEmployeeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEmployeeResponse.ProtoReflect.Descriptor instead.
-func (*CreateEmployeeResponse) Descriptor() ([]byte, []int) {
-	return file_user_user_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ResetPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *CreateEmployeeResponse) GetEmployee() *Employee {
+func (x *ResetPasswordResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Employee
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type GetEmployeeRequest struct {
+func (x *ResetPasswordResponse) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+// Sets is_active=false and revokes the user's tokens.
+type DeactivateUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeactivatedBy int64                  `protobuf:"varint,2,opt,name=deactivated_by,json=deactivatedBy,proto3" json:"deactivated_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEmployeeRequest) Reset() {
-	*x = GetEmployeeRequest{}
-	mi := &file_user_user_service_proto_msgTypes[19]
+func (x *DeactivateUserRequest) Reset() {
+	*x = DeactivateUserRequest{}
+	mi := &file_user_user_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEmployeeRequest) String() string {
+func (x *DeactivateUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEmployeeRequest) ProtoMessage() {}
+func (*DeactivateUserRequest) ProtoMessage() {}
 
-func (x *GetEmployeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_service_proto_msgTypes[19]
+func (x *DeactivateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1438,40 +1635,47 @@ func (x *GetEmployeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEmployeeRequest.ProtoReflect.Descriptor instead.
-func (*GetEmployeeRequest) Descriptor() ([]byte, []int) {
-	return file_user_user_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use DeactivateUserRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetEmployeeRequest) GetId() int64 {
+func (x *DeactivateUserRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type GetEmployeeResponse struct {
+func (x *DeactivateUserRequest) GetDeactivatedBy() int64 {
+	if x != nil {
+		return x.DeactivatedBy
+	}
+	return 0
+}
+
+type DeactivateUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Employee      *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEmployeeResponse) Reset() {
-	*x = GetEmployeeResponse{}
-	mi := &file_user_user_service_proto_msgTypes[20]
+func (x *DeactivateUserResponse) Reset() {
+	*x = DeactivateUserResponse{}
+	mi := &file_user_user_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEmployeeResponse) String() string {
+func (x *DeactivateUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEmployeeResponse) ProtoMessage() {}
+func (*DeactivateUserResponse) ProtoMessage() {}
 
-func (x *GetEmployeeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_service_proto_msgTypes[20]
+func (x *DeactivateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1482,49 +1686,42 @@ func (x *GetEmployeeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEmployeeResponse.ProtoReflect.Descriptor instead.
-func (*GetEmployeeResponse) Descriptor() ([]byte, []int) {
-	return file_user_user_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use DeactivateUserResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetEmployeeResponse) GetEmployee() *Employee {
+func (x *DeactivateUserResponse) GetUser() *User {
 	if x != nil {
-		return x.Employee
+		return x.User
 	}
 	return nil
 }
 
-type UpdateEmployeeRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	EmployeeName   *string                `protobuf:"bytes,2,opt,name=employee_name,json=employeeName,proto3,oneof" json:"employee_name,omitempty"`
-	Position       *string                `protobuf:"bytes,3,opt,name=position,proto3,oneof" json:"position,omitempty"`
-	Phone          *string                `protobuf:"bytes,4,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
-	Email          *string                `protobuf:"bytes,5,opt,name=email,proto3,oneof" json:"email,omitempty"`
-	Address        *string                `protobuf:"bytes,6,opt,name=address,proto3,oneof" json:"address,omitempty"`
-	BaseSalary     *string                `protobuf:"bytes,7,opt,name=base_salary,json=baseSalary,proto3,oneof" json:"base_salary,omitempty"`
-	CommissionRate *string                `protobuf:"bytes,8,opt,name=commission_rate,json=commissionRate,proto3,oneof" json:"commission_rate,omitempty"`
-	CommissionType *CommissionType        `protobuf:"varint,9,opt,name=commission_type,json=commissionType,proto3,enum=user.CommissionType,oneof" json:"commission_type,omitempty"`
-	IsActive       *bool                  `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ListUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pagination    *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	IsActive      *bool                  `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	RoleId        *int32                 `protobuf:"varint,3,opt,name=role_id,json=roleId,proto3,oneof" json:"role_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEmployeeRequest) Reset() {
-	*x = UpdateEmployeeRequest{}
-	mi := &file_user_user_service_proto_msgTypes[21]
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_user_user_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEmployeeRequest) String() string {
+func (x *ListUsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEmployeeRequest) ProtoMessage() {}
+func (*ListUsersRequest) ProtoMessage() {}
 
-func (x *UpdateEmployeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_service_proto_msgTypes[21]
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1535,103 +1732,1042 @@ func (x *UpdateEmployeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEmployeeRequest.ProtoReflect.Descriptor instead.
-func (*UpdateEmployeeRequest) Descriptor() ([]byte, []int) {
-	return file_user_user_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *UpdateEmployeeRequest) GetId() int64 {
+func (x *ListUsersRequest) GetPagination() *PaginationRequest {
 	if x != nil {
-		return x.Id
+		return x.Pagination
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateEmployeeRequest) GetEmployeeName() string {
-	if x != nil && x.EmployeeName != nil {
-		return *x.EmployeeName
+func (x *ListUsersRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
 	}
-	return ""
+	return false
 }
 
-func (x *UpdateEmployeeRequest) GetPosition() string {
-	if x != nil && x.Position != nil {
-		return *x.Position
+func (x *ListUsersRequest) GetRoleId() int32 {
+	if x != nil && x.RoleId != nil {
+		return *x.RoleId
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateEmployeeRequest) GetPhone() string {
-	if x != nil && x.Phone != nil {
-		return *x.Phone
-	}
-	return ""
+type ListUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEmployeeRequest) GetEmail() string {
-	if x != nil && x.Email != nil {
-		return *x.Email
-	}
-	return ""
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_user_user_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEmployeeRequest) GetAddress() string {
-	if x != nil && x.Address != nil {
-		return *x.Address
-	}
-	return ""
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateEmployeeRequest) GetBaseSalary() string {
-	if x != nil && x.BaseSalary != nil {
-		return *x.BaseSalary
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateEmployeeRequest) GetCommissionRate() string {
-	if x != nil && x.CommissionRate != nil {
-		return *x.CommissionRate
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListUsersResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type CreateEmployeeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeName   string                 `protobuf:"bytes,1,opt,name=employee_name,json=employeeName,proto3" json:"employee_name,omitempty"`
+	Position       *string                `protobuf:"bytes,2,opt,name=position,proto3,oneof" json:"position,omitempty"`
+	Phone          *string                `protobuf:"bytes,3,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
+	Email          *string                `protobuf:"bytes,4,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	Address        *string                `protobuf:"bytes,5,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	HireDate       *string                `protobuf:"bytes,6,opt,name=hire_date,json=hireDate,proto3,oneof" json:"hire_date,omitempty"`
+	BaseSalary     string                 `protobuf:"bytes,7,opt,name=base_salary,json=baseSalary,proto3" json:"base_salary,omitempty"`
+	CommissionRate string                 `protobuf:"bytes,8,opt,name=commission_rate,json=commissionRate,proto3" json:"commission_rate,omitempty"`
+	CommissionType CommissionType         `protobuf:"varint,9,opt,name=commission_type,json=commissionType,proto3,enum=user.CommissionType" json:"commission_type,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateEmployeeRequest) Reset() {
+	*x = CreateEmployeeRequest{}
+	mi := &file_user_user_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmployeeRequest) ProtoMessage() {}
+
+func (x *CreateEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*CreateEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateEmployeeRequest) GetEmployeeName() string {
+	if x != nil {
+		return x.EmployeeName
+	}
+	return ""
+}
+
+func (x *CreateEmployeeRequest) GetPosition() string {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return ""
+}
+
+func (x *CreateEmployeeRequest) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
+	}
+	return ""
+}
+
+func (x *CreateEmployeeRequest) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *CreateEmployeeRequest) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+func (x *CreateEmployeeRequest) GetHireDate() string {
+	if x != nil && x.HireDate != nil {
+		return *x.HireDate
+	}
+	return ""
+}
+
+func (x *CreateEmployeeRequest) GetBaseSalary() string {
+	if x != nil {
+		return x.BaseSalary
+	}
+	return ""
+}
+
+func (x *CreateEmployeeRequest) GetCommissionRate() string {
+	if x != nil {
+		return x.CommissionRate
+	}
+	return ""
+}
+
+func (x *CreateEmployeeRequest) GetCommissionType() CommissionType {
+	if x != nil {
+		return x.CommissionType
+	}
+	return CommissionType_COMMISSION_TYPE_UNSPECIFIED
+}
+
+type CreateEmployeeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employee      *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEmployeeResponse) Reset() {
+	*x = CreateEmployeeResponse{}
+	mi := &file_user_user_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEmployeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmployeeResponse) ProtoMessage() {}
+
+func (x *CreateEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*CreateEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateEmployeeResponse) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+type GetEmployeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmployeeRequest) Reset() {
+	*x = GetEmployeeRequest{}
+	mi := &file_user_user_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmployeeRequest) ProtoMessage() {}
+
+func (x *GetEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*GetEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetEmployeeRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetEmployeeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employee      *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmployeeResponse) Reset() {
+	*x = GetEmployeeResponse{}
+	mi := &file_user_user_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmployeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmployeeResponse) ProtoMessage() {}
+
+func (x *GetEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*GetEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetEmployeeResponse) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+type UpdateEmployeeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EmployeeName   *string                `protobuf:"bytes,2,opt,name=employee_name,json=employeeName,proto3,oneof" json:"employee_name,omitempty"`
+	Position       *string                `protobuf:"bytes,3,opt,name=position,proto3,oneof" json:"position,omitempty"`
+	Phone          *string                `protobuf:"bytes,4,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
+	Email          *string                `protobuf:"bytes,5,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	Address        *string                `protobuf:"bytes,6,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	BaseSalary     *string                `protobuf:"bytes,7,opt,name=base_salary,json=baseSalary,proto3,oneof" json:"base_salary,omitempty"`
+	CommissionRate *string                `protobuf:"bytes,8,opt,name=commission_rate,json=commissionRate,proto3,oneof" json:"commission_rate,omitempty"`
+	CommissionType *CommissionType        `protobuf:"varint,9,opt,name=commission_type,json=commissionType,proto3,enum=user.CommissionType,oneof" json:"commission_type,omitempty"`
+	IsActive       *bool                  `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateEmployeeRequest) Reset() {
+	*x = UpdateEmployeeRequest{}
+	mi := &file_user_user_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEmployeeRequest) ProtoMessage() {}
+
+func (x *UpdateEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateEmployeeRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateEmployeeRequest) GetEmployeeName() string {
+	if x != nil && x.EmployeeName != nil {
+		return *x.EmployeeName
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeRequest) GetPosition() string {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeRequest) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeRequest) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeRequest) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeRequest) GetBaseSalary() string {
+	if x != nil && x.BaseSalary != nil {
+		return *x.BaseSalary
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeRequest) GetCommissionRate() string {
+	if x != nil && x.CommissionRate != nil {
+		return *x.CommissionRate
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeRequest) GetCommissionType() CommissionType {
+	if x != nil && x.CommissionType != nil {
+		return *x.CommissionType
+	}
+	return CommissionType_COMMISSION_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateEmployeeRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+type UpdateEmployeeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employee      *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEmployeeResponse) Reset() {
+	*x = UpdateEmployeeResponse{}
+	mi := &file_user_user_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEmployeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEmployeeResponse) ProtoMessage() {}
+
+func (x *UpdateEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateEmployeeResponse) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+type LinkEmployeeToUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId    int64                  `protobuf:"varint,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkEmployeeToUserRequest) Reset() {
+	*x = LinkEmployeeToUserRequest{}
+	mi := &file_user_user_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkEmployeeToUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkEmployeeToUserRequest) ProtoMessage() {}
+
+func (x *LinkEmployeeToUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkEmployeeToUserRequest.ProtoReflect.Descriptor instead.
+func (*LinkEmployeeToUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *LinkEmployeeToUserRequest) GetEmployeeId() int64 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *LinkEmployeeToUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type LinkEmployeeToUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employee      *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkEmployeeToUserResponse) Reset() {
+	*x = LinkEmployeeToUserResponse{}
+	mi := &file_user_user_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkEmployeeToUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkEmployeeToUserResponse) ProtoMessage() {}
+
+func (x *LinkEmployeeToUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkEmployeeToUserResponse.ProtoReflect.Descriptor instead.
+func (*LinkEmployeeToUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *LinkEmployeeToUserResponse) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+// Resolves the employee linked to the authenticated user.
+type GetMyEmployeeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Set by the gateway from the JWT subject; any client-supplied value
+	// is overwritten, so callers cannot look up another user's employee.
+	UserId        int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyEmployeeRequest) Reset() {
+	*x = GetMyEmployeeRequest{}
+	mi := &file_user_user_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyEmployeeRequest) ProtoMessage() {}
+
+func (x *GetMyEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*GetMyEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetMyEmployeeRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetMyEmployeeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employee      *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyEmployeeResponse) Reset() {
+	*x = GetMyEmployeeResponse{}
+	mi := &file_user_user_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyEmployeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyEmployeeResponse) ProtoMessage() {}
+
+func (x *GetMyEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*GetMyEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetMyEmployeeResponse) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+type ListEmployeesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pagination    *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	IsActive      *bool                  `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	Position      *string                `protobuf:"bytes,3,opt,name=position,proto3,oneof" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmployeesRequest) Reset() {
+	*x = ListEmployeesRequest{}
+	mi := &file_user_user_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmployeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmployeesRequest) ProtoMessage() {}
+
+func (x *ListEmployeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateEmployeeRequest) GetCommissionType() CommissionType {
-	if x != nil && x.CommissionType != nil {
-		return *x.CommissionType
+// Deprecated: Use ListEmployeesRequest.ProtoReflect.Descriptor instead.
+func (*ListEmployeesRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListEmployeesRequest) GetPagination() *PaginationRequest {
+	if x != nil {
+		return x.Pagination
 	}
-	return CommissionType_COMMISSION_TYPE_UNSPECIFIED
+	return nil
 }
 
-func (x *UpdateEmployeeRequest) GetIsActive() bool {
+func (x *ListEmployeesRequest) GetIsActive() bool {
 	if x != nil && x.IsActive != nil {
 		return *x.IsActive
 	}
 	return false
 }
 
-type UpdateEmployeeResponse struct {
+func (x *ListEmployeesRequest) GetPosition() string {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return ""
+}
+
+type ListEmployeesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Employee      *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	Employees     []*Employee            `protobuf:"bytes,1,rep,name=employees,proto3" json:"employees,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEmployeeResponse) Reset() {
-	*x = UpdateEmployeeResponse{}
-	mi := &file_user_user_service_proto_msgTypes[22]
+func (x *ListEmployeesResponse) Reset() {
+	*x = ListEmployeesResponse{}
+	mi := &file_user_user_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmployeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmployeesResponse) ProtoMessage() {}
+
+func (x *ListEmployeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmployeesResponse.ProtoReflect.Descriptor instead.
+func (*ListEmployeesResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListEmployeesResponse) GetEmployees() []*Employee {
+	if x != nil {
+		return x.Employees
+	}
+	return nil
+}
+
+func (x *ListEmployeesResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// Tiers must be contiguous and non-overlapping: each min_sales_amount
+// equals the previous tier's max_sales_amount, and only the top tier
+// may leave max_sales_amount unset.
+type AddCommissionTierRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId     int64                  `protobuf:"varint,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	MinSalesAmount string                 `protobuf:"bytes,2,opt,name=min_sales_amount,json=minSalesAmount,proto3" json:"min_sales_amount,omitempty"`
+	MaxSalesAmount *string                `protobuf:"bytes,3,opt,name=max_sales_amount,json=maxSalesAmount,proto3,oneof" json:"max_sales_amount,omitempty"`
+	CommissionRate string                 `protobuf:"bytes,4,opt,name=commission_rate,json=commissionRate,proto3" json:"commission_rate,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddCommissionTierRequest) Reset() {
+	*x = AddCommissionTierRequest{}
+	mi := &file_user_user_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCommissionTierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCommissionTierRequest) ProtoMessage() {}
+
+func (x *AddCommissionTierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCommissionTierRequest.ProtoReflect.Descriptor instead.
+func (*AddCommissionTierRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AddCommissionTierRequest) GetEmployeeId() int64 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *AddCommissionTierRequest) GetMinSalesAmount() string {
+	if x != nil {
+		return x.MinSalesAmount
+	}
+	return ""
+}
+
+func (x *AddCommissionTierRequest) GetMaxSalesAmount() string {
+	if x != nil && x.MaxSalesAmount != nil {
+		return *x.MaxSalesAmount
+	}
+	return ""
+}
+
+func (x *AddCommissionTierRequest) GetCommissionRate() string {
+	if x != nil {
+		return x.CommissionRate
+	}
+	return ""
+}
+
+type AddCommissionTierResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CommissionTier *CommissionTier        `protobuf:"bytes,1,opt,name=commission_tier,json=commissionTier,proto3" json:"commission_tier,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddCommissionTierResponse) Reset() {
+	*x = AddCommissionTierResponse{}
+	mi := &file_user_user_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCommissionTierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCommissionTierResponse) ProtoMessage() {}
+
+func (x *AddCommissionTierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCommissionTierResponse.ProtoReflect.Descriptor instead.
+func (*AddCommissionTierResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AddCommissionTierResponse) GetCommissionTier() *CommissionTier {
+	if x != nil {
+		return x.CommissionTier
+	}
+	return nil
+}
+
+type UpdateCommissionTierRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MinSalesAmount *string                `protobuf:"bytes,2,opt,name=min_sales_amount,json=minSalesAmount,proto3,oneof" json:"min_sales_amount,omitempty"`
+	MaxSalesAmount *string                `protobuf:"bytes,3,opt,name=max_sales_amount,json=maxSalesAmount,proto3,oneof" json:"max_sales_amount,omitempty"`
+	CommissionRate *string                `protobuf:"bytes,4,opt,name=commission_rate,json=commissionRate,proto3,oneof" json:"commission_rate,omitempty"`
+	// Unsets max_sales_amount so the tier becomes open-ended. Only the
+	// highest tier may be open-ended; ignored when max_sales_amount is set.
+	ClearMaxSalesAmount *bool `protobuf:"varint,5,opt,name=clear_max_sales_amount,json=clearMaxSalesAmount,proto3,oneof" json:"clear_max_sales_amount,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UpdateCommissionTierRequest) Reset() {
+	*x = UpdateCommissionTierRequest{}
+	mi := &file_user_user_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCommissionTierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCommissionTierRequest) ProtoMessage() {}
+
+func (x *UpdateCommissionTierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCommissionTierRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCommissionTierRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdateCommissionTierRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateCommissionTierRequest) GetMinSalesAmount() string {
+	if x != nil && x.MinSalesAmount != nil {
+		return *x.MinSalesAmount
+	}
+	return ""
+}
+
+func (x *UpdateCommissionTierRequest) GetMaxSalesAmount() string {
+	if x != nil && x.MaxSalesAmount != nil {
+		return *x.MaxSalesAmount
+	}
+	return ""
+}
+
+func (x *UpdateCommissionTierRequest) GetCommissionRate() string {
+	if x != nil && x.CommissionRate != nil {
+		return *x.CommissionRate
+	}
+	return ""
+}
+
+func (x *UpdateCommissionTierRequest) GetClearMaxSalesAmount() bool {
+	if x != nil && x.ClearMaxSalesAmount != nil {
+		return *x.ClearMaxSalesAmount
+	}
+	return false
+}
+
+type UpdateCommissionTierResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CommissionTier *CommissionTier        `protobuf:"bytes,1,opt,name=commission_tier,json=commissionTier,proto3" json:"commission_tier,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateCommissionTierResponse) Reset() {
+	*x = UpdateCommissionTierResponse{}
+	mi := &file_user_user_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCommissionTierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCommissionTierResponse) ProtoMessage() {}
+
+func (x *UpdateCommissionTierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCommissionTierResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCommissionTierResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UpdateCommissionTierResponse) GetCommissionTier() *CommissionTier {
+	if x != nil {
+		return x.CommissionTier
+	}
+	return nil
+}
+
+type DeleteCommissionTierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommissionTierRequest) Reset() {
+	*x = DeleteCommissionTierRequest{}
+	mi := &file_user_user_service_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEmployeeResponse) String() string {
+func (x *DeleteCommissionTierRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEmployeeResponse) ProtoMessage() {}
+func (*DeleteCommissionTierRequest) ProtoMessage() {}
 
-func (x *UpdateEmployeeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_service_proto_msgTypes[22]
+func (x *DeleteCommissionTierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1642,42 +2778,41 @@ func (x *UpdateEmployeeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEmployeeResponse.ProtoReflect.Descriptor instead.
-func (*UpdateEmployeeResponse) Descriptor() ([]byte, []int) {
-	return file_user_user_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use DeleteCommissionTierRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommissionTierRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *UpdateEmployeeResponse) GetEmployee() *Employee {
+func (x *DeleteCommissionTierRequest) GetId() int32 {
 	if x != nil {
-		return x.Employee
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type ListEmployeesRequest struct {
+type DeleteCommissionTierResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pagination    *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	IsActive      *bool                  `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
-	Position      *string                `protobuf:"bytes,3,opt,name=position,proto3,oneof" json:"position,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       *string                `protobuf:"bytes,2,opt,name=message,proto3,oneof" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEmployeesRequest) Reset() {
-	*x = ListEmployeesRequest{}
-	mi := &file_user_user_service_proto_msgTypes[23]
+func (x *DeleteCommissionTierResponse) Reset() {
+	*x = DeleteCommissionTierResponse{}
+	mi := &file_user_user_service_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEmployeesRequest) String() string {
+func (x *DeleteCommissionTierResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEmployeesRequest) ProtoMessage() {}
+func (*DeleteCommissionTierResponse) ProtoMessage() {}
 
-func (x *ListEmployeesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_service_proto_msgTypes[23]
+func (x *DeleteCommissionTierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1688,55 +2823,47 @@ func (x *ListEmployeesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEmployeesRequest.ProtoReflect.Descriptor instead.
-func (*ListEmployeesRequest) Descriptor() ([]byte, []int) {
-	return file_user_user_service_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use DeleteCommissionTierResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCommissionTierResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *ListEmployeesRequest) GetPagination() *PaginationRequest {
+func (x *DeleteCommissionTierResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Pagination
-	}
-	return nil
-}
-
-func (x *ListEmployeesRequest) GetIsActive() bool {
-	if x != nil && x.IsActive != nil {
-		return *x.IsActive
+		return x.Success
 	}
 	return false
 }
 
-func (x *ListEmployeesRequest) GetPosition() string {
-	if x != nil && x.Position != nil {
-		return *x.Position
+func (x *DeleteCommissionTierResponse) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
 	}
 	return ""
 }
 
-type ListEmployeesResponse struct {
+type ListCommissionTiersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Employees     []*Employee            `protobuf:"bytes,1,rep,name=employees,proto3" json:"employees,omitempty"`
-	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	EmployeeId    int64                  `protobuf:"varint,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEmployeesResponse) Reset() {
-	*x = ListEmployeesResponse{}
-	mi := &file_user_user_service_proto_msgTypes[24]
+func (x *ListCommissionTiersRequest) Reset() {
+	*x = ListCommissionTiersRequest{}
+	mi := &file_user_user_service_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEmployeesResponse) String() string {
+func (x *ListCommissionTiersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEmployeesResponse) ProtoMessage() {}
+func (*ListCommissionTiersRequest) ProtoMessage() {}
 
-func (x *ListEmployeesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_service_proto_msgTypes[24]
+func (x *ListCommissionTiersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1747,21 +2874,58 @@ func (x *ListEmployeesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEmployeesResponse.ProtoReflect.Descriptor instead.
-func (*ListEmployeesResponse) Descriptor() ([]byte, []int) {
-	return file_user_user_service_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ListCommissionTiersRequest.ProtoReflect.Descriptor instead.
+func (*ListCommissionTiersRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ListEmployeesResponse) GetEmployees() []*Employee {
+func (x *ListCommissionTiersRequest) GetEmployeeId() int64 {
 	if x != nil {
-		return x.Employees
+		return x.EmployeeId
 	}
-	return nil
+	return 0
 }
 
-func (x *ListEmployeesResponse) GetPagination() *PaginationResponse {
+type ListCommissionTiersResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CommissionTiers []*CommissionTier      `protobuf:"bytes,1,rep,name=commission_tiers,json=commissionTiers,proto3" json:"commission_tiers,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListCommissionTiersResponse) Reset() {
+	*x = ListCommissionTiersResponse{}
+	mi := &file_user_user_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommissionTiersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommissionTiersResponse) ProtoMessage() {}
+
+func (x *ListCommissionTiersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[46]
 	if x != nil {
-		return x.Pagination
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommissionTiersResponse.ProtoReflect.Descriptor instead.
+func (*ListCommissionTiersResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListCommissionTiersResponse) GetCommissionTiers() []*CommissionTier {
+	if x != nil {
+		return x.CommissionTiers
 	}
 	return nil
 }
@@ -1777,7 +2941,7 @@ type CreateRoleRequest struct {
 
 func (x *CreateRoleRequest) Reset() {
 	*x = CreateRoleRequest{}
-	mi := &file_user_user_service_proto_msgTypes[25]
+	mi := &file_user_user_service_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1789,7 +2953,7 @@ func (x *CreateRoleRequest) String() string {
 func (*CreateRoleRequest) ProtoMessage() {}
 
 func (x *CreateRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_service_proto_msgTypes[25]
+	mi := &file_user_user_service_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1802,7 +2966,7 @@ func (x *CreateRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRoleRequest.ProtoReflect.Descriptor instead.
 func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
-	return file_user_user_service_proto_rawDescGZIP(), []int{25}
+	return file_user_user_service_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *CreateRoleRequest) GetRoleName() string {
@@ -1835,7 +2999,7 @@ type CreateRoleResponse struct {
 
 func (x *CreateRoleResponse) Reset() {
 	*x = CreateRoleResponse{}
-	mi := &file_user_user_service_proto_msgTypes[26]
+	mi := &file_user_user_service_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1847,7 +3011,7 @@ func (x *CreateRoleResponse) String() string {
 func (*CreateRoleResponse) ProtoMessage() {}
 
 func (x *CreateRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_service_proto_msgTypes[26]
+	mi := &file_user_user_service_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1860,7 +3024,7 @@ func (x *CreateRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRoleResponse.ProtoReflect.Descriptor instead.
 func (*CreateRoleResponse) Descriptor() ([]byte, []int) {
-	return file_user_user_service_proto_rawDescGZIP(), []int{26}
+	return file_user_user_service_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *CreateRoleResponse) GetRole() *Role {
@@ -1870,6 +3034,120 @@ func (x *CreateRoleResponse) GetRole() *Role {
 	return nil
 }
 
+// permissions must be a JSON array of known permission strings; it is
+// normalized and de-duplicated before saving.
+type UpdateRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RoleName      *string                `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3,oneof" json:"role_name,omitempty"`
+	AccessLevel   *int32                 `protobuf:"varint,3,opt,name=access_level,json=accessLevel,proto3,oneof" json:"access_level,omitempty"`
+	Permissions   *string                `protobuf:"bytes,4,opt,name=permissions,proto3,oneof" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoleRequest) Reset() {
+	*x = UpdateRoleRequest{}
+	mi := &file_user_user_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleRequest) ProtoMessage() {}
+
+func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoleRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdateRoleRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateRoleRequest) GetRoleName() string {
+	if x != nil && x.RoleName != nil {
+		return *x.RoleName
+	}
+	return ""
+}
+
+func (x *UpdateRoleRequest) GetAccessLevel() int32 {
+	if x != nil && x.AccessLevel != nil {
+		return *x.AccessLevel
+	}
+	return 0
+}
+
+func (x *UpdateRoleRequest) GetPermissions() string {
+	if x != nil && x.Permissions != nil {
+		return *x.Permissions
+	}
+	return ""
+}
+
+type UpdateRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          *Role                  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoleResponse) Reset() {
+	*x = UpdateRoleResponse{}
+	mi := &file_user_user_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleResponse) ProtoMessage() {}
+
+func (x *UpdateRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRoleResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UpdateRoleResponse) GetRole() *Role {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
 type ListRolesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Pagination    *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -1879,7 +3157,7 @@ type ListRolesRequest struct {
 
 func (x *ListRolesRequest) Reset() {
 	*x = ListRolesRequest{}
-	mi := &file_user_user_service_proto_msgTypes[27]
+	mi := &file_user_user_service_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1891,7 +3169,7 @@ func (x *ListRolesRequest) String() string {
 func (*ListRolesRequest) ProtoMessage() {}
 
 func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_service_proto_msgTypes[27]
+	mi := &file_user_user_service_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1904,7 +3182,7 @@ func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRolesRequest.ProtoReflect.Descriptor instead.
 func (*ListRolesRequest) Descriptor() ([]byte, []int) {
-	return file_user_user_service_proto_rawDescGZIP(), []int{27}
+	return file_user_user_service_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *ListRolesRequest) GetPagination() *PaginationRequest {
@@ -1924,7 +3202,7 @@ type ListRolesResponse struct {
 
 func (x *ListRolesResponse) Reset() {
 	*x = ListRolesResponse{}
-	mi := &file_user_user_service_proto_msgTypes[28]
+	mi := &file_user_user_service_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1936,7 +3214,7 @@ func (x *ListRolesResponse) String() string {
 func (*ListRolesResponse) ProtoMessage() {}
 
 func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_service_proto_msgTypes[28]
+	mi := &file_user_user_service_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1949,7 +3227,7 @@ func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRolesResponse.ProtoReflect.Descriptor instead.
 func (*ListRolesResponse) Descriptor() ([]byte, []int) {
-	return file_user_user_service_proto_rawDescGZIP(), []int{28}
+	return file_user_user_service_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *ListRolesResponse) GetRoles() []*Role {
@@ -1966,6 +3244,103 @@ func (x *ListRolesResponse) GetPagination() *PaginationResponse {
 	return nil
 }
 
+// Refused with FailedPrecondition while any user still has the role.
+type DeleteRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleRequest) Reset() {
+	*x = DeleteRoleRequest{}
+	mi := &file_user_user_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleRequest) ProtoMessage() {}
+
+func (x *DeleteRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoleRequest) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteRoleRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       *string                `protobuf:"bytes,2,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleResponse) Reset() {
+	*x = DeleteRoleResponse{}
+	mi := &file_user_user_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleResponse) ProtoMessage() {}
+
+func (x *DeleteRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRoleResponse) Descriptor() ([]byte, []int) {
+	return file_user_user_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DeleteRoleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteRoleResponse) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
 var File_user_user_service_proto protoreflect.FileDescriptor
 
 const file_user_user_service_proto_rawDesc = "" +
@@ -1992,7 +3367,7 @@ const file_user_user_service_proto_rawDesc = "" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0e\n" +
-	"\f_permissions\"\xc7\x03\n" +
+	"\f_permissions\"\xf9\x03\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
@@ -2010,9 +3385,10 @@ const file_user_user_service_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12#\n" +
 	"\x04role\x18\f \x01(\v2\n" +
-	".user.RoleH\x01R\x04role\x88\x01\x01B\r\n" +
+	".user.RoleH\x01R\x04role\x88\x01\x01\x120\n" +
+	"\x14must_change_password\x18\r \x01(\bR\x12mustChangePasswordB\r\n" +
 	"\v_last_loginB\a\n" +
-	"\x05_role\"\xef\x04\n" +
+	"\x05_role\"\x99\x05\n" +
 	"\bEmployee\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12#\n" +
 	"\remployee_name\x18\x02 \x01(\tR\femployeeName\x12\x1f\n" +
@@ -2031,14 +3407,17 @@ const file_user_user_service_proto_rawDesc = "" +
 	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12?\n" +
-	"\x10commission_tiers\x18\x0e \x03(\v2\x14.user.CommissionTierR\x0fcommissionTiersB\v\n" +
+	"\x10commission_tiers\x18\x0e \x03(\v2\x14.user.CommissionTierR\x0fcommissionTiers\x12\x1c\n" +
+	"\auser_id\x18\x0f \x01(\x03H\x05R\x06userId\x88\x01\x01B\v\n" +
 	"\t_positionB\b\n" +
 	"\x06_phoneB\b\n" +
 	"\x06_emailB\n" +
 	"\n" +
 	"\b_addressB\f\n" +
 	"\n" +
-	"_hire_date\"\xce\x02\n" +
+	"_hire_dateB\n" +
+	"\n" +
+	"\b_user_id\"\xce\x02\n" +
 	"\x0eCommissionTier\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
 	"\vemployee_id\x18\x02 \x01(\x03R\n" +
@@ -2059,7 +3438,20 @@ const file_user_user_service_proto_rawDesc = "" +
 	".user.UserR\x04user\x12\x14\n" +
 	"\x05token\x18\x02 \x01(\tR\x05token\x129\n" +
 	"\n" +
-	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\xb4\x01\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"+\n" +
+	"\x13RefreshTokenRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"g\n" +
+	"\x14RefreshTokenResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"%\n" +
+	"\rLogoutRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"U\n" +
+	"\x0eLogoutResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1d\n" +
+	"\amessage\x18\x02 \x01(\tH\x00R\amessage\x88\x01\x01B\n" +
+	"\n" +
+	"\b_message\"\xb4\x01\n" +
 	"\x11CreateUserRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
@@ -2071,10 +3463,14 @@ const file_user_user_service_proto_rawDesc = "" +
 	"\x04user\x18\x01 \x01(\v2\n" +
 	".user.UserR\x04user\" \n" +
 	"\x0eGetUserRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"1\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"v\n" +
 	"\x0fGetUserResponse\x12\x1e\n" +
 	"\x04user\x18\x01 \x01(\v2\n" +
-	".user.UserR\x04user\"\x81\x02\n" +
+	".user.UserR\x04user\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x1d\n" +
+	"\amessage\x18\x03 \x01(\tH\x00R\amessage\x88\x01\x01B\n" +
+	"\n" +
+	"\b_message\"\x81\x02\n" +
 	"\x11UpdateUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
 	"\x05email\x18\x02 \x01(\tH\x00R\x05email\x88\x01\x01\x12!\n" +
@@ -2092,6 +3488,32 @@ const file_user_user_service_proto_rawDesc = "" +
 	"_is_active\"4\n" +
 	"\x12UpdateUserResponse\x12\x1e\n" +
 	"\x04user\x18\x01 \x01(\v2\n" +
+	".user.UserR\x04user\"v\n" +
+	"\x15ChangePasswordRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12!\n" +
+	"\fold_password\x18\x02 \x01(\tR\voldPassword\x12!\n" +
+	"\fnew_password\x18\x03 \x01(\tR\vnewPassword\"]\n" +
+	"\x16ChangePasswordResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1d\n" +
+	"\amessage\x18\x02 \x01(\tH\x00R\amessage\x88\x01\x01B\n" +
+	"\n" +
+	"\b_message\"\xbd\x01\n" +
+	"\x14ResetPasswordRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12!\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\x12\x19\n" +
+	"\breset_by\x18\x03 \x01(\x03R\aresetBy\x125\n" +
+	"\x14must_change_password\x18\x04 \x01(\bH\x00R\x12mustChangePassword\x88\x01\x01B\x17\n" +
+	"\x15_must_change_password\"\\\n" +
+	"\x15ResetPasswordResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1d\n" +
+	"\amessage\x18\x02 \x01(\tH\x00R\amessage\x88\x01\x01B\n" +
+	"\n" +
+	"\b_message\"N\n" +
+	"\x15DeactivateUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
+	"\x0edeactivated_by\x18\x02 \x01(\x03R\rdeactivatedBy\"8\n" +
+	"\x16DeactivateUserResponse\x12\x1e\n" +
+	"\x04user\x18\x01 \x01(\v2\n" +
 	".user.UserR\x04user\"\xa5\x01\n" +
 	"\x10ListUsersRequest\x127\n" +
 	"\n" +
@@ -2158,6 +3580,16 @@ const file_user_user_service_proto_rawDesc = "" +
 	"\n" +
 	"_is_active\"D\n" +
 	"\x16UpdateEmployeeResponse\x12*\n" +
+	"\bemployee\x18\x01 \x01(\v2\x0e.user.EmployeeR\bemployee\"U\n" +
+	"\x19LinkEmployeeToUserRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\x03R\n" +
+	"employeeId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"H\n" +
+	"\x1aLinkEmployeeToUserResponse\x12*\n" +
+	"\bemployee\x18\x01 \x01(\v2\x0e.user.EmployeeR\bemployee\"/\n" +
+	"\x14GetMyEmployeeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"C\n" +
+	"\x15GetMyEmployeeResponse\x12*\n" +
 	"\bemployee\x18\x01 \x01(\v2\x0e.user.EmployeeR\bemployee\"\xad\x01\n" +
 	"\x14ListEmployeesRequest\x127\n" +
 	"\n" +
@@ -2172,7 +3604,40 @@ const file_user_user_service_proto_rawDesc = "" +
 	"\temployees\x18\x01 \x03(\v2\x0e.user.EmployeeR\temployees\x128\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x18.user.PaginationResponseR\n" +
-	"pagination\"\x8a\x01\n" +
+	"pagination\"\xd2\x01\n" +
+	"\x18AddCommissionTierRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\x03R\n" +
+	"employeeId\x12(\n" +
+	"\x10min_sales_amount\x18\x02 \x01(\tR\x0eminSalesAmount\x12-\n" +
+	"\x10max_sales_amount\x18\x03 \x01(\tH\x00R\x0emaxSalesAmount\x88\x01\x01\x12'\n" +
+	"\x0fcommission_rate\x18\x04 \x01(\tR\x0ecommissionRateB\x13\n" +
+	"\x11_max_sales_amount\"Z\n" +
+	"\x19AddCommissionTierResponse\x12=\n" +
+	"\x0fcommission_tier\x18\x01 \x01(\v2\x14.user.CommissionTierR\x0ecommissionTier\"\xcc\x02\n" +
+	"\x1bUpdateCommissionTierRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12-\n" +
+	"\x10min_sales_amount\x18\x02 \x01(\tH\x00R\x0eminSalesAmount\x88\x01\x01\x12-\n" +
+	"\x10max_sales_amount\x18\x03 \x01(\tH\x01R\x0emaxSalesAmount\x88\x01\x01\x12,\n" +
+	"\x0fcommission_rate\x18\x04 \x01(\tH\x02R\x0ecommissionRate\x88\x01\x01\x128\n" +
+	"\x16clear_max_sales_amount\x18\x05 \x01(\bH\x03R\x13clearMaxSalesAmount\x88\x01\x01B\x13\n" +
+	"\x11_min_sales_amountB\x13\n" +
+	"\x11_max_sales_amountB\x12\n" +
+	"\x10_commission_rateB\x19\n" +
+	"\x17_clear_max_sales_amount\"]\n" +
+	"\x1cUpdateCommissionTierResponse\x12=\n" +
+	"\x0fcommission_tier\x18\x01 \x01(\v2\x14.user.CommissionTierR\x0ecommissionTier\"-\n" +
+	"\x1bDeleteCommissionTierRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"c\n" +
+	"\x1cDeleteCommissionTierResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1d\n" +
+	"\amessage\x18\x02 \x01(\tH\x00R\amessage\x88\x01\x01B\n" +
+	"\n" +
+	"\b_message\"=\n" +
+	"\x1aListCommissionTiersRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\x03R\n" +
+	"employeeId\"^\n" +
+	"\x1bListCommissionTiersResponse\x12?\n" +
+	"\x10commission_tiers\x18\x01 \x03(\v2\x14.user.CommissionTierR\x0fcommissionTiers\"\x8a\x01\n" +
 	"\x11CreateRoleRequest\x12\x1b\n" +
 	"\trole_name\x18\x01 \x01(\tR\broleName\x12!\n" +
 	"\faccess_level\x18\x02 \x01(\x05R\vaccessLevel\x12%\n" +
@@ -2180,6 +3645,18 @@ const file_user_user_service_proto_rawDesc = "" +
 	"\f_permissions\"4\n" +
 	"\x12CreateRoleResponse\x12\x1e\n" +
 	"\x04role\x18\x01 \x01(\v2\n" +
+	".user.RoleR\x04role\"\xc3\x01\n" +
+	"\x11UpdateRoleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12 \n" +
+	"\trole_name\x18\x02 \x01(\tH\x00R\broleName\x88\x01\x01\x12&\n" +
+	"\faccess_level\x18\x03 \x01(\x05H\x01R\vaccessLevel\x88\x01\x01\x12%\n" +
+	"\vpermissions\x18\x04 \x01(\tH\x02R\vpermissions\x88\x01\x01B\f\n" +
+	"\n" +
+	"_role_nameB\x0f\n" +
+	"\r_access_levelB\x0e\n" +
+	"\f_permissions\"4\n" +
+	"\x12UpdateRoleResponse\x12\x1e\n" +
+	"\x04role\x18\x01 \x01(\v2\n" +
 	".user.RoleR\x04role\"K\n" +
 	"\x10ListRolesRequest\x127\n" +
 	"\n" +
@@ -2190,7 +3667,14 @@ const file_user_user_service_proto_rawDesc = "" +
 	".user.RoleR\x05roles\x128\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x18.user.PaginationResponseR\n" +
-	"pagination*\x8f\x01\n" +
+	"pagination\"#\n" +
+	"\x11DeleteRoleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"Y\n" +
+	"\x12DeleteRoleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1d\n" +
+	"\amessage\x18\x02 \x01(\tH\x00R\amessage\x88\x01\x01B\n" +
+	"\n" +
+	"\b_message*\x8f\x01\n" +
 	"\x0eCommissionType\x12\x1f\n" +
 	"\x1bCOMMISSION_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n" +
 	"\x1aCOMMISSION_TYPE_PERCENTAGE\x10\x01\x12 \n" +
@@ -2210,78 +3694,112 @@ func file_user_user_service_proto_rawDescGZIP() []byte {
 }
 
 var file_user_user_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_user_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_user_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
 var file_user_user_service_proto_goTypes = []any{
-	(CommissionType)(0),            // 0: user.CommissionType
-	(*PaginationRequest)(nil),      // 1: user.PaginationRequest
-	(*PaginationResponse)(nil),     // 2: user.PaginationResponse
-	(*DateRange)(nil),              // 3: user.DateRange
-	(*Role)(nil),                   // 4: user.Role
-	(*User)(nil),                   // 5: user.User
-	(*Employee)(nil),               // 6: user.Employee
-	(*CommissionTier)(nil),         // 7: user.CommissionTier
-	(*AuthenticateRequest)(nil),    // 8: user.AuthenticateRequest
-	(*AuthenticateResponse)(nil),   // 9: user.AuthenticateResponse
-	(*CreateUserRequest)(nil),      // 10: user.CreateUserRequest
-	(*CreateUserResponse)(nil),     // 11: user.CreateUserResponse
-	(*GetUserRequest)(nil),         // 12: user.GetUserRequest
-	(*GetUserResponse)(nil),        // 13: user.GetUserResponse
-	(*UpdateUserRequest)(nil),      // 14: user.UpdateUserRequest
-	(*UpdateUserResponse)(nil),     // 15: user.UpdateUserResponse
-	(*ListUsersRequest)(nil),       // 16: user.ListUsersRequest
-	(*ListUsersResponse)(nil),      // 17: user.ListUsersResponse
-	(*CreateEmployeeRequest)(nil),  // 18: user.CreateEmployeeRequest
-	(*CreateEmployeeResponse)(nil), // 19: user.CreateEmployeeResponse
-	(*GetEmployeeRequest)(nil),     // 20: user.GetEmployeeRequest
-	(*GetEmployeeResponse)(nil),    // 21: user.GetEmployeeResponse
-	(*UpdateEmployeeRequest)(nil),  // 22: user.UpdateEmployeeRequest
-	(*UpdateEmployeeResponse)(nil), // 23: user.UpdateEmployeeResponse
-	(*ListEmployeesRequest)(nil),   // 24: user.ListEmployeesRequest
-	(*ListEmployeesResponse)(nil),  // 25: user.ListEmployeesResponse
-	(*CreateRoleRequest)(nil),      // 26: user.CreateRoleRequest
-	(*CreateRoleResponse)(nil),     // 27: user.CreateRoleResponse
-	(*ListRolesRequest)(nil),       // 28: user.ListRolesRequest
-	(*ListRolesResponse)(nil),      // 29: user.ListRolesResponse
-	(*timestamppb.Timestamp)(nil),  // 30: google.protobuf.Timestamp
+	(CommissionType)(0),                  // 0: user.CommissionType
+	(*PaginationRequest)(nil),            // 1: user.PaginationRequest
+	(*PaginationResponse)(nil),           // 2: user.PaginationResponse
+	(*DateRange)(nil),                    // 3: user.DateRange
+	(*Role)(nil),                         // 4: user.Role
+	(*User)(nil),                         // 5: user.User
+	(*Employee)(nil),                     // 6: user.Employee
+	(*CommissionTier)(nil),               // 7: user.CommissionTier
+	(*AuthenticateRequest)(nil),          // 8: user.AuthenticateRequest
+	(*AuthenticateResponse)(nil),         // 9: user.AuthenticateResponse
+	(*RefreshTokenRequest)(nil),          // 10: user.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),         // 11: user.RefreshTokenResponse
+	(*LogoutRequest)(nil),                // 12: user.LogoutRequest
+	(*LogoutResponse)(nil),               // 13: user.LogoutResponse
+	(*CreateUserRequest)(nil),            // 14: user.CreateUserRequest
+	(*CreateUserResponse)(nil),           // 15: user.CreateUserResponse
+	(*GetUserRequest)(nil),               // 16: user.GetUserRequest
+	(*GetUserResponse)(nil),              // 17: user.GetUserResponse
+	(*UpdateUserRequest)(nil),            // 18: user.UpdateUserRequest
+	(*UpdateUserResponse)(nil),           // 19: user.UpdateUserResponse
+	(*ChangePasswordRequest)(nil),        // 20: user.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),       // 21: user.ChangePasswordResponse
+	(*ResetPasswordRequest)(nil),         // 22: user.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil),        // 23: user.ResetPasswordResponse
+	(*DeactivateUserRequest)(nil),        // 24: user.DeactivateUserRequest
+	(*DeactivateUserResponse)(nil),       // 25: user.DeactivateUserResponse
+	(*ListUsersRequest)(nil),             // 26: user.ListUsersRequest
+	(*ListUsersResponse)(nil),            // 27: user.ListUsersResponse
+	(*CreateEmployeeRequest)(nil),        // 28: user.CreateEmployeeRequest
+	(*CreateEmployeeResponse)(nil),       // 29: user.CreateEmployeeResponse
+	(*GetEmployeeRequest)(nil),           // 30: user.GetEmployeeRequest
+	(*GetEmployeeResponse)(nil),          // 31: user.GetEmployeeResponse
+	(*UpdateEmployeeRequest)(nil),        // 32: user.UpdateEmployeeRequest
+	(*UpdateEmployeeResponse)(nil),       // 33: user.UpdateEmployeeResponse
+	(*LinkEmployeeToUserRequest)(nil),    // 34: user.LinkEmployeeToUserRequest
+	(*LinkEmployeeToUserResponse)(nil),   // 35: user.LinkEmployeeToUserResponse
+	(*GetMyEmployeeRequest)(nil),         // 36: user.GetMyEmployeeRequest
+	(*GetMyEmployeeResponse)(nil),        // 37: user.GetMyEmployeeResponse
+	(*ListEmployeesRequest)(nil),         // 38: user.ListEmployeesRequest
+	(*ListEmployeesResponse)(nil),        // 39: user.ListEmployeesResponse
+	(*AddCommissionTierRequest)(nil),     // 40: user.AddCommissionTierRequest
+	(*AddCommissionTierResponse)(nil),    // 41: user.AddCommissionTierResponse
+	(*UpdateCommissionTierRequest)(nil),  // 42: user.UpdateCommissionTierRequest
+	(*UpdateCommissionTierResponse)(nil), // 43: user.UpdateCommissionTierResponse
+	(*DeleteCommissionTierRequest)(nil),  // 44: user.DeleteCommissionTierRequest
+	(*DeleteCommissionTierResponse)(nil), // 45: user.DeleteCommissionTierResponse
+	(*ListCommissionTiersRequest)(nil),   // 46: user.ListCommissionTiersRequest
+	(*ListCommissionTiersResponse)(nil),  // 47: user.ListCommissionTiersResponse
+	(*CreateRoleRequest)(nil),            // 48: user.CreateRoleRequest
+	(*CreateRoleResponse)(nil),           // 49: user.CreateRoleResponse
+	(*UpdateRoleRequest)(nil),            // 50: user.UpdateRoleRequest
+	(*UpdateRoleResponse)(nil),           // 51: user.UpdateRoleResponse
+	(*ListRolesRequest)(nil),             // 52: user.ListRolesRequest
+	(*ListRolesResponse)(nil),            // 53: user.ListRolesResponse
+	(*DeleteRoleRequest)(nil),            // 54: user.DeleteRoleRequest
+	(*DeleteRoleResponse)(nil),           // 55: user.DeleteRoleResponse
+	(*timestamppb.Timestamp)(nil),        // 56: google.protobuf.Timestamp
 }
 var file_user_user_service_proto_depIdxs = []int32{
-	30, // 0: user.Role.created_at:type_name -> google.protobuf.Timestamp
-	30, // 1: user.Role.updated_at:type_name -> google.protobuf.Timestamp
-	30, // 2: user.User.last_login:type_name -> google.protobuf.Timestamp
-	30, // 3: user.User.created_at:type_name -> google.protobuf.Timestamp
-	30, // 4: user.User.updated_at:type_name -> google.protobuf.Timestamp
+	56, // 0: user.Role.created_at:type_name -> google.protobuf.Timestamp
+	56, // 1: user.Role.updated_at:type_name -> google.protobuf.Timestamp
+	56, // 2: user.User.last_login:type_name -> google.protobuf.Timestamp
+	56, // 3: user.User.created_at:type_name -> google.protobuf.Timestamp
+	56, // 4: user.User.updated_at:type_name -> google.protobuf.Timestamp
 	4,  // 5: user.User.role:type_name -> user.Role
 	0,  // 6: user.Employee.commission_type:type_name -> user.CommissionType
-	30, // 7: user.Employee.created_at:type_name -> google.protobuf.Timestamp
-	30, // 8: user.Employee.updated_at:type_name -> google.protobuf.Timestamp
+	56, // 7: user.Employee.created_at:type_name -> google.protobuf.Timestamp
+	56, // 8: user.Employee.updated_at:type_name -> google.protobuf.Timestamp
 	7,  // 9: user.Employee.commission_tiers:type_name -> user.CommissionTier
-	30, // 10: user.CommissionTier.created_at:type_name -> google.protobuf.Timestamp
-	30, // 11: user.CommissionTier.updated_at:type_name -> google.protobuf.Timestamp
+	56, // 10: user.CommissionTier.created_at:type_name -> google.protobuf.Timestamp
+	56, // 11: user.CommissionTier.updated_at:type_name -> google.protobuf.Timestamp
 	5,  // 12: user.AuthenticateResponse.user:type_name -> user.User
-	30, // 13: user.AuthenticateResponse.expires_at:type_name -> google.protobuf.Timestamp
-	5,  // 14: user.CreateUserResponse.user:type_name -> user.User
-	5,  // 15: user.GetUserResponse.user:type_name -> user.User
-	5,  // 16: user.UpdateUserResponse.user:type_name -> user.User
-	1,  // 17: user.ListUsersRequest.pagination:type_name -> user.PaginationRequest
-	5,  // 18: user.ListUsersResponse.users:type_name -> user.User
-	2,  // 19: user.ListUsersResponse.pagination:type_name -> user.PaginationResponse
-	0,  // 20: user.CreateEmployeeRequest.commission_type:type_name -> user.CommissionType
-	6,  // 21: user.CreateEmployeeResponse.employee:type_name -> user.Employee
-	6,  // 22: user.GetEmployeeResponse.employee:type_name -> user.Employee
-	0,  // 23: user.UpdateEmployeeRequest.commission_type:type_name -> user.CommissionType
-	6,  // 24: user.UpdateEmployeeResponse.employee:type_name -> user.Employee
-	1,  // 25: user.ListEmployeesRequest.pagination:type_name -> user.PaginationRequest
-	6,  // 26: user.ListEmployeesResponse.employees:type_name -> user.Employee
-	2,  // 27: user.ListEmployeesResponse.pagination:type_name -> user.PaginationResponse
-	4,  // 28: user.CreateRoleResponse.role:type_name -> user.Role
-	1,  // 29: user.ListRolesRequest.pagination:type_name -> user.PaginationRequest
-	4,  // 30: user.ListRolesResponse.roles:type_name -> user.Role
-	2,  // 31: user.ListRolesResponse.pagination:type_name -> user.PaginationResponse
-	32, // [32:32] is the sub-list for method output_type
-	32, // [32:32] is the sub-list for method input_type
-	32, // [32:32] is the sub-list for extension type_name
-	32, // [32:32] is the sub-list for extension extendee
-	0,  // [0:32] is the sub-list for field type_name
+	56, // 13: user.AuthenticateResponse.expires_at:type_name -> google.protobuf.Timestamp
+	56, // 14: user.RefreshTokenResponse.expires_at:type_name -> google.protobuf.Timestamp
+	5,  // 15: user.CreateUserResponse.user:type_name -> user.User
+	5,  // 16: user.GetUserResponse.user:type_name -> user.User
+	5,  // 17: user.UpdateUserResponse.user:type_name -> user.User
+	5,  // 18: user.DeactivateUserResponse.user:type_name -> user.User
+	1,  // 19: user.ListUsersRequest.pagination:type_name -> user.PaginationRequest
+	5,  // 20: user.ListUsersResponse.users:type_name -> user.User
+	2,  // 21: user.ListUsersResponse.pagination:type_name -> user.PaginationResponse
+	0,  // 22: user.CreateEmployeeRequest.commission_type:type_name -> user.CommissionType
+	6,  // 23: user.CreateEmployeeResponse.employee:type_name -> user.Employee
+	6,  // 24: user.GetEmployeeResponse.employee:type_name -> user.Employee
+	0,  // 25: user.UpdateEmployeeRequest.commission_type:type_name -> user.CommissionType
+	6,  // 26: user.UpdateEmployeeResponse.employee:type_name -> user.Employee
+	6,  // 27: user.LinkEmployeeToUserResponse.employee:type_name -> user.Employee
+	6,  // 28: user.GetMyEmployeeResponse.employee:type_name -> user.Employee
+	1,  // 29: user.ListEmployeesRequest.pagination:type_name -> user.PaginationRequest
+	6,  // 30: user.ListEmployeesResponse.employees:type_name -> user.Employee
+	2,  // 31: user.ListEmployeesResponse.pagination:type_name -> user.PaginationResponse
+	7,  // 32: user.AddCommissionTierResponse.commission_tier:type_name -> user.CommissionTier
+	7,  // 33: user.UpdateCommissionTierResponse.commission_tier:type_name -> user.CommissionTier
+	7,  // 34: user.ListCommissionTiersResponse.commission_tiers:type_name -> user.CommissionTier
+	4,  // 35: user.CreateRoleResponse.role:type_name -> user.Role
+	4,  // 36: user.UpdateRoleResponse.role:type_name -> user.Role
+	1,  // 37: user.ListRolesRequest.pagination:type_name -> user.PaginationRequest
+	4,  // 38: user.ListRolesResponse.roles:type_name -> user.Role
+	2,  // 39: user.ListRolesResponse.pagination:type_name -> user.PaginationResponse
+	40, // [40:40] is the sub-list for method output_type
+	40, // [40:40] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_user_user_service_proto_init() }
@@ -2293,19 +3811,29 @@ func file_user_user_service_proto_init() {
 	file_user_user_service_proto_msgTypes[4].OneofWrappers = []any{}
 	file_user_user_service_proto_msgTypes[5].OneofWrappers = []any{}
 	file_user_user_service_proto_msgTypes[6].OneofWrappers = []any{}
-	file_user_user_service_proto_msgTypes[13].OneofWrappers = []any{}
-	file_user_user_service_proto_msgTypes[15].OneofWrappers = []any{}
+	file_user_user_service_proto_msgTypes[12].OneofWrappers = []any{}
+	file_user_user_service_proto_msgTypes[16].OneofWrappers = []any{}
 	file_user_user_service_proto_msgTypes[17].OneofWrappers = []any{}
+	file_user_user_service_proto_msgTypes[20].OneofWrappers = []any{}
 	file_user_user_service_proto_msgTypes[21].OneofWrappers = []any{}
-	file_user_user_service_proto_msgTypes[23].OneofWrappers = []any{}
+	file_user_user_service_proto_msgTypes[22].OneofWrappers = []any{}
 	file_user_user_service_proto_msgTypes[25].OneofWrappers = []any{}
+	file_user_user_service_proto_msgTypes[27].OneofWrappers = []any{}
+	file_user_user_service_proto_msgTypes[31].OneofWrappers = []any{}
+	file_user_user_service_proto_msgTypes[37].OneofWrappers = []any{}
+	file_user_user_service_proto_msgTypes[39].OneofWrappers = []any{}
+	file_user_user_service_proto_msgTypes[41].OneofWrappers = []any{}
+	file_user_user_service_proto_msgTypes[44].OneofWrappers = []any{}
+	file_user_user_service_proto_msgTypes[47].OneofWrappers = []any{}
+	file_user_user_service_proto_msgTypes[49].OneofWrappers = []any{}
+	file_user_user_service_proto_msgTypes[54].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_user_service_proto_rawDesc), len(file_user_user_service_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   29,
+			NumMessages:   55,
 			NumExtensions: 0,
 			NumServices:   0,
 		},